@@ -524,7 +524,10 @@ func (builder *builder) addConstraintExist(a, b expr.Term, k constraint.Element)
 				// compute n, the coefficient for the output wire
 				q2, ok = builder.cs.Inverse(q2)
 				if !ok {
-					panic("div by 0") // shouldn't happen
+					// the recorded addition has zero coefficient for b (for
+					// example when multiplying a variable by constant zero),
+					// we cannot deduce n and need a new constraint.
+					return expr.Term{}, false
 				}
 				q2 = builder.cs.Mul(q2, q4)
 				return expr.NewTerm(int(c.XC), q2), true
@@ -594,7 +597,9 @@ func (builder *builder) mulConstraintExist(a, b expr.Term) (expr.Term, bool) {
 			N := builder.cs.GetCoefficient(int(c.QM))
 			N, ok := builder.cs.Inverse(N)
 			if !ok {
-				panic("div by 0") // sanity check.
+				// the recorded product has zero coefficient (for example when
+				// multiplying by constant zero), we need a new constraint.
+				return expr.Term{}, false
 			}
 			N = builder.cs.Mul(N, qM)
 
@@ -92,3 +92,67 @@ func TestDuplicateMul(t *testing.T) {
 	_, err = ccs.Solve(w)
 	assert.NoError(err, "solving failed")
 }
+
+// circuitDupAddZero and circuitDupMulZero record constraints with zero
+// coefficients by multiplying variables by constant zero. Re-using such a
+// constraint requires dividing by the zero coefficient, so the builder must
+// add a new constraint instead.
+type circuitDupAddZero struct {
+	A, B frontend.Variable
+	R    frontend.Variable
+}
+
+func (c *circuitDupAddZero) Define(api frontend.API) error {
+	f := api.Add(api.Mul(c.A, 0), api.Mul(c.B, 0)) // 0a + 0b
+	g := api.Add(c.A, api.Mul(c.B, 0))             // a + 0b can't reuse f
+	api.AssertIsEqual(f, 0)
+	api.AssertIsEqual(g, c.R)
+	return nil
+}
+
+func TestDuplicateAddZero(t *testing.T) {
+	assert := require.New(t)
+
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &circuitDupAddZero{})
+	assert.NoError(err)
+
+	w, err := frontend.NewWitness(&circuitDupAddZero{
+		A: 13,
+		B: 42,
+		R: 13,
+	}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	_, err = ccs.Solve(w)
+	assert.NoError(err, "solving failed")
+}
+
+type circuitDupMulZero struct {
+	A, B frontend.Variable
+	R    frontend.Variable
+}
+
+func (c *circuitDupMulZero) Define(api frontend.API) error {
+	f := api.Mul(api.Mul(c.A, 0), c.B) // 0ab
+	g := api.Mul(c.A, c.B)             // ab can't reuse f
+	api.AssertIsEqual(f, 0)
+	api.AssertIsEqual(g, c.R)
+	return nil
+}
+
+func TestDuplicateMulZero(t *testing.T) {
+	assert := require.New(t)
+
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &circuitDupMulZero{})
+	assert.NoError(err)
+
+	w, err := frontend.NewWitness(&circuitDupMulZero{
+		A: 13,
+		B: 42,
+		R: 13 * 42,
+	}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	_, err = ccs.Solve(w)
+	assert.NoError(err, "solving failed")
+}
@@ -0,0 +1,109 @@
+package mmr
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+)
+
+// Accumulator is a native Merkle Mountain Range used for generating the
+// witnesses of the circuit functions in this package.
+//
+// The hash function must be a SNARK-friendly hash (for example MiMC) which
+// hashes field elements encoded as big-endian byte slices of length
+// h.Size(). The leaves must be given in the same encoding.
+type Accumulator struct {
+	h        hash.Hash
+	nbPeaks  int
+	size     uint64
+	mountain [][][]byte // mountain[k] are the nodes at height k in order
+}
+
+// NewAccumulator returns a new empty [Accumulator] with at most nbPeaks peaks,
+// so that it holds at most 2^nbPeaks-1 leaves.
+func NewAccumulator(h hash.Hash, nbPeaks int) (*Accumulator, error) {
+	if nbPeaks < 1 || nbPeaks > 63 {
+		return nil, fmt.Errorf("number of peaks %d not in [1, 63]", nbPeaks)
+	}
+	return &Accumulator{
+		h:        h,
+		nbPeaks:  nbPeaks,
+		mountain: make([][][]byte, nbPeaks),
+	}, nil
+}
+
+func (a *Accumulator) sum(data ...[]byte) []byte {
+	a.h.Reset()
+	for i := range data {
+		a.h.Write(data[i])
+	}
+	return a.h.Sum(nil)
+}
+
+// Append appends leaf to the accumulator. It returns an error if the
+// accumulator is full.
+func (a *Accumulator) Append(leaf []byte) error {
+	if a.size+1 >= 1<<a.nbPeaks {
+		return errors.New("accumulator is full")
+	}
+	node := a.sum(leaf)
+	a.mountain[0] = append(a.mountain[0], node)
+	for k := 0; a.size>>k&1 == 1; k++ {
+		// the new node completes a pair at height k
+		nodes := a.mountain[k]
+		node = a.sum(nodes[len(nodes)-2], nodes[len(nodes)-1])
+		a.mountain[k+1] = append(a.mountain[k+1], node)
+	}
+	a.size++
+	return nil
+}
+
+// Size returns the number of leaves in the accumulator.
+func (a *Accumulator) Size() uint64 {
+	return a.size
+}
+
+// Peaks returns the peaks of the accumulator indexed by their height. The
+// absent peaks are zero.
+func (a *Accumulator) Peaks() [][]byte {
+	res := make([][]byte, a.nbPeaks)
+	for k := range res {
+		if a.size>>k&1 == 1 {
+			res[k] = a.mountain[k][len(a.mountain[k])-1]
+		} else {
+			res[k] = make([]byte, a.h.Size())
+		}
+	}
+	return res
+}
+
+// Root returns the hash of the size and peaks of the accumulator as computed
+// by [Peaks.Root].
+func (a *Accumulator) Root() []byte {
+	size := make([]byte, a.h.Size())
+	new(big.Int).SetUint64(a.size).FillBytes(size)
+	return a.sum(append([][]byte{size}, a.Peaks()...)...)
+}
+
+// Prove returns the inclusion proof of the leaf at index. The returned path
+// has the length of the number of peaks, the unused siblings are zero.
+func (a *Accumulator) Prove(index uint64) ([][]byte, error) {
+	if index >= a.size {
+		return nil, fmt.Errorf("index %d out of range", index)
+	}
+	res := make([][]byte, a.nbPeaks)
+	// the leaf belongs to the mountain of height given by the most significant
+	// differing bit of the index and the size.
+	height := 63
+	for ; (index^a.size)>>height&1 == 0; height-- {
+	}
+	for k := range res {
+		if k < height {
+			res[k] = a.mountain[k][(index>>k)^1]
+		} else {
+			res[k] = make([]byte, a.h.Size())
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,191 @@
+// Package mmr provides ZKP-circuit functions to verify inclusion proofs and
+// appends in a Merkle Mountain Range (MMR).
+//
+// An MMR is an append-only accumulator consisting of a list of perfect binary
+// Merkle trees (the mountains) of strictly decreasing heights. With n leaves,
+// there is a mountain of height h if and only if the bit h of n is set. The
+// roots of the mountains are the peaks of the MMR. The leaves are assigned to
+// the mountains from the highest to the lowest one.
+//
+// In circuit, the MMR is represented by [Peaks], where the peaks are indexed
+// by their height. The maximal number of peaks is fixed at circuit compile
+// time and bounds the number of leaves, but the actual number of leaves is a
+// variable. The absent peaks must be zero.
+//
+// The hashes are computed as in package
+// [github.com/consensys/gnark/std/accumulator/merkle]: a leaf is hashed as
+// H(leaf) and an inner node as H(left, right). The MMR can be bagged into a
+// single root H(n, peaks[0], ..., peaks[k-1]) with [Peaks.Root].
+//
+// The witnesses are generated using the native [Accumulator].
+package mmr
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/selector"
+)
+
+// Peaks stores the peaks of a Merkle Mountain Range.
+type Peaks struct {
+	// Size is the number of leaves in the MMR. It must be less than
+	// 2^len(Peaks).
+	Size frontend.Variable
+
+	// Peaks are the roots of the mountains indexed by their height. The peak
+	// of height h is present if and only if the bit h of Size is set. The
+	// absent peaks must be zero.
+	Peaks []frontend.Variable
+}
+
+// Proof stores an inclusion proof of a leaf in a Merkle Mountain Range.
+type Proof struct {
+	// Index is the index of the leaf in the MMR.
+	Index frontend.Variable
+
+	// Path are the siblings of the nodes on the path from the leaf to the
+	// peak of its mountain. The number of siblings equals the maximal number
+	// of peaks and only the first h siblings are used, where h is the height
+	// of the mountain.
+	Path []frontend.Variable
+}
+
+// leafSum returns the hash created from data inserted to form a leaf.
+func leafSum(h hash.FieldHasher, data frontend.Variable) frontend.Variable {
+	h.Reset()
+	h.Write(data)
+	return h.Sum()
+}
+
+// nodeSum returns the hash created from two nodes to form an inner node.
+func nodeSum(h hash.FieldHasher, a, b frontend.Variable) frontend.Variable {
+	h.Reset()
+	h.Write(a, b)
+	return h.Sum()
+}
+
+// sizeBits returns the binary decomposition of the size of the MMR and asserts
+// that the absent peaks are zero.
+func (p *Peaks) sizeBits(api frontend.API) []frontend.Variable {
+	sBits := api.ToBinary(p.Size, len(p.Peaks))
+	for i := range p.Peaks {
+		api.AssertIsEqual(api.Mul(api.Sub(1, sBits[i]), p.Peaks[i]), 0)
+	}
+	return sBits
+}
+
+// Root returns the hash of the MMR size and peaks H(Size, Peaks...).
+func (p *Peaks) Root(api frontend.API, h hash.FieldHasher) frontend.Variable {
+	p.sizeBits(api)
+	h.Reset()
+	h.Write(p.Size)
+	h.Write(p.Peaks...)
+	return h.Sum()
+}
+
+// VerifyInclusion asserts that leaf is the leaf at index proof.Index in the
+// MMR. It asserts that the index is less than the size of the MMR.
+//
+// The mountain containing the leaf is the one of height h, where h is the most
+// significant bit at which the index and the size differ. The corresponding
+// peak is selected dynamically using [selector.Mux].
+func (p *Peaks) VerifyInclusion(api frontend.API, h hash.FieldHasher, leaf frontend.Variable, proof *Proof) error {
+	nbPeaks := len(p.Peaks)
+	if len(proof.Path) != nbPeaks {
+		return fmt.Errorf("path length %d does not match the number of peaks %d", len(proof.Path), nbPeaks)
+	}
+	sBits := p.sizeBits(api)
+	iBits := api.ToBinary(proof.Index, nbPeaks)
+
+	// above[k] = 1 iff the index and size differ at some position j > k
+	above := make([]frontend.Variable, nbPeaks)
+	var acc frontend.Variable = 0
+	for k := nbPeaks - 1; k >= 0; k-- {
+		above[k] = acc
+		acc = api.Or(acc, api.Xor(iBits[k], sBits[k]))
+	}
+	// the index and size differ at the most significant position h, where the
+	// bit of the size is set (index < size).
+	var height, check frontend.Variable = 0, 0
+	for k := 0; k < nbPeaks; k++ {
+		isTop := api.Mul(api.Xor(iBits[k], sBits[k]), api.Sub(1, above[k]))
+		height = api.Add(height, api.Mul(isTop, k))
+		check = api.Add(check, api.Mul(isTop, sBits[k]))
+	}
+	api.AssertIsEqual(check, 1)
+
+	// compute the root of the mountain. The levels at or above h are skipped.
+	sum := leafSum(h, leaf)
+	for k := 0; k < nbPeaks; k++ {
+		d1 := api.Select(iBits[k], proof.Path[k], sum)
+		d2 := api.Select(iBits[k], sum, proof.Path[k])
+		sum = api.Select(above[k], nodeSum(h, d1, d2), sum)
+	}
+	api.AssertIsEqual(sum, selector.Mux(api, height, p.Peaks...))
+	return nil
+}
+
+// Append returns the peaks of the MMR obtained by appending leaf. It asserts
+// that the new size fits into the number of peaks.
+func (p *Peaks) Append(api frontend.API, h hash.FieldHasher, leaf frontend.Variable) *Peaks {
+	res, carry := p.append(api, h, leaf)
+	api.AssertIsEqual(carry, 0)
+	return res
+}
+
+// append returns the peaks of the MMR obtained by appending leaf and the
+// carry, which is 1 if the new size doesn't fit into the number of peaks.
+func (p *Peaks) append(api frontend.API, h hash.FieldHasher, leaf frontend.Variable) (*Peaks, frontend.Variable) {
+	sBits := p.sizeBits(api)
+	res := &Peaks{
+		Size:  api.Add(p.Size, 1),
+		Peaks: make([]frontend.Variable, len(p.Peaks)),
+	}
+	// the new leaf is merged with the peaks of increasing height as long as
+	// they are present, similarly to the carry propagation in binary addition.
+	var carry frontend.Variable = 1
+	sum := leafSum(h, leaf)
+	for k := range p.Peaks {
+		merge := api.Mul(carry, sBits[k])
+		place := api.Sub(carry, merge)
+		// merged peaks become absent, the carry is placed in the first absent
+		// peak and the other peaks are kept.
+		res.Peaks[k] = api.Add(api.Mul(place, sum), api.Mul(api.Sub(1, carry), p.Peaks[k]))
+		sum = api.Select(merge, nodeSum(h, p.Peaks[k], sum), sum)
+		carry = merge
+	}
+	return res, carry
+}
+
+// VerifyAppend asserts that next is obtained from p by appending the first
+// nbLeaves leaves of leaves, where nbLeaves is at most len(leaves). The number
+// of peaks of p and next must be equal.
+func (p *Peaks) VerifyAppend(api frontend.API, h hash.FieldHasher, leaves []frontend.Variable, nbLeaves frontend.Variable, next *Peaks) error {
+	if len(p.Peaks) != len(next.Peaks) {
+		return fmt.Errorf("number of peaks differ: %d != %d", len(p.Peaks), len(next.Peaks))
+	}
+	// active[i] = 1 iff i < nbLeaves
+	done := selector.Decoder(api, len(leaves)+1, nbLeaves)
+	var active frontend.Variable = 1
+	cur := p
+	for i := range leaves {
+		active = api.Sub(active, done[i])
+		appended, carry := cur.append(api, h, leaves[i])
+		api.AssertIsEqual(api.Mul(active, carry), 0)
+		sel := &Peaks{
+			Size:  api.Select(active, appended.Size, cur.Size),
+			Peaks: make([]frontend.Variable, len(cur.Peaks)),
+		}
+		for k := range sel.Peaks {
+			sel.Peaks[k] = api.Select(active, appended.Peaks[k], cur.Peaks[k])
+		}
+		cur = sel
+	}
+	api.AssertIsEqual(cur.Size, next.Size)
+	for k := range cur.Peaks {
+		api.AssertIsEqual(cur.Peaks[k], next.Peaks[k])
+	}
+	return nil
+}
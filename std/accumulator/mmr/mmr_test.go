@@ -0,0 +1,180 @@
+package mmr
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/hash"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/test"
+)
+
+const nbPeaks = 4
+
+func randomLeaves(assert *test.Assert, n int) [][]byte {
+	res := make([][]byte, n)
+	for i := range res {
+		var e fr.Element
+		_, err := e.SetRandom()
+		assert.NoError(err)
+		b := e.Bytes()
+		res[i] = b[:]
+	}
+	return res
+}
+
+func newAccumulator(assert *test.Assert, leaves [][]byte) *Accumulator {
+	acc, err := NewAccumulator(hash.MIMC_BN254.New(), nbPeaks)
+	assert.NoError(err)
+	for i := range leaves {
+		assert.NoError(acc.Append(leaves[i]))
+	}
+	return acc
+}
+
+func assignPeaks(acc *Accumulator) Peaks {
+	peaks := acc.Peaks()
+	res := Peaks{Size: acc.Size(), Peaks: make([]frontend.Variable, len(peaks))}
+	for i := range peaks {
+		res.Peaks[i] = peaks[i]
+	}
+	return res
+}
+
+type inclusionCircuit struct {
+	Peaks Peaks
+	Root  frontend.Variable
+	Proof Proof
+	Leaf  frontend.Variable
+}
+
+func (c *inclusionCircuit) Define(api frontend.API) error {
+	h, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(c.Peaks.Root(api, &h), c.Root)
+	return c.Peaks.VerifyInclusion(api, &h, c.Leaf, &c.Proof)
+}
+
+func TestVerifyInclusion(t *testing.T) {
+	assert := test.NewAssert(t)
+	leaves := randomLeaves(assert, 11)
+	acc := newAccumulator(assert, leaves)
+	circuit := inclusionCircuit{
+		Peaks: Peaks{Peaks: make([]frontend.Variable, nbPeaks)},
+		Proof: Proof{Path: make([]frontend.Variable, nbPeaks)},
+	}
+	for i := range leaves {
+		path, err := acc.Prove(uint64(i))
+		assert.NoError(err)
+		witness := inclusionCircuit{
+			Peaks: assignPeaks(acc),
+			Root:  acc.Root(),
+			Proof: Proof{Index: i, Path: make([]frontend.Variable, nbPeaks)},
+			Leaf:  leaves[i],
+		}
+		for k := range path {
+			witness.Proof.Path[k] = path[k]
+		}
+		err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, "index %d", i)
+
+		// the leaf is not at another index
+		witness.Proof.Index = (i + 1) % len(leaves)
+		err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.Error(err, "index %d", i)
+	}
+
+	// the index must be less than the size
+	path, err := acc.Prove(10)
+	assert.NoError(err)
+	acc10 := newAccumulator(assert, leaves[:10])
+	witness := inclusionCircuit{
+		Peaks: assignPeaks(acc10),
+		Root:  acc10.Root(),
+		Proof: Proof{Index: 10, Path: make([]frontend.Variable, nbPeaks)},
+		Leaf:  leaves[10],
+	}
+	for k := range path {
+		witness.Proof.Path[k] = path[k]
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type appendCircuit struct {
+	Old      Peaks
+	New      Peaks
+	Leaves   []frontend.Variable
+	NbLeaves frontend.Variable
+}
+
+func (c *appendCircuit) Define(api frontend.API) error {
+	h, err := mimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	return c.Old.VerifyAppend(api, &h, c.Leaves, c.NbLeaves, &c.New)
+}
+
+func TestVerifyAppend(t *testing.T) {
+	assert := test.NewAssert(t)
+	const maxLeaves = 5
+	leaves := randomLeaves(assert, 15)
+	circuit := appendCircuit{
+		Old:    Peaks{Peaks: make([]frontend.Variable, nbPeaks)},
+		New:    Peaks{Peaks: make([]frontend.Variable, nbPeaks)},
+		Leaves: make([]frontend.Variable, maxLeaves),
+	}
+	for _, tc := range []struct{ oldSize, nbLeaves int }{
+		{0, 5}, {5, 3}, {7, 1}, {11, 0}, {12, 3}, {15, 0},
+	} {
+		oldAcc := newAccumulator(assert, leaves[:tc.oldSize])
+		newAcc := newAccumulator(assert, leaves[:tc.oldSize+tc.nbLeaves])
+		witness := appendCircuit{
+			Old:      assignPeaks(oldAcc),
+			New:      assignPeaks(newAcc),
+			Leaves:   make([]frontend.Variable, maxLeaves),
+			NbLeaves: tc.nbLeaves,
+		}
+		for i := range witness.Leaves {
+			witness.Leaves[i] = 0
+			if tc.oldSize+i < len(leaves) {
+				witness.Leaves[i] = leaves[tc.oldSize+i]
+			}
+		}
+		err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, "old size %d, appended %d", tc.oldSize, tc.nbLeaves)
+
+		if tc.nbLeaves > 0 {
+			witness.Leaves[0] = 1
+			err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+			assert.Error(err, "old size %d, appended %d", tc.oldSize, tc.nbLeaves)
+		}
+	}
+
+	// the accumulator is full
+	oldAcc := newAccumulator(assert, leaves[:14])
+	witness := appendCircuit{
+		Old:      assignPeaks(oldAcc),
+		New:      assignPeaks(oldAcc),
+		Leaves:   []frontend.Variable{1, 2, 3, 4, 5},
+		NbLeaves: 2,
+	}
+	witness.New.Size = 16
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestAccumulatorFull(t *testing.T) {
+	assert := test.NewAssert(t)
+	acc := newAccumulator(assert, randomLeaves(assert, 15))
+	leaf := make([]byte, fr.Bytes)
+	_, err := rand.Read(leaf[1:])
+	assert.NoError(err)
+	assert.Error(acc.Append(leaf))
+}
@@ -0,0 +1,104 @@
+package algebra
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	emulated_bls12377 "github.com/consensys/gnark/std/algebra/emulated/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// GetCurve returns the [Curve] implementation corresponding to the G1 and
+// scalar type parameters. The method allows to have a fully generic
+// implementation without taking into consideration the initialization
+// differences of different curves.
+func GetCurve[G1El any, S any](api frontend.API) (Curve[G1El, S], error) {
+	var ret Curve[G1El, S]
+	switch s := any(&ret).(type) {
+	case *Curve[sw_bn254.G1Affine, sw_bn254.Scalar]:
+		c, err := sw_emulated.New[emulated.BN254Fp, emulated.BN254Fr](api, sw_emulated.GetBN254Params())
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
+	case *Curve[sw_bls12381.G1Affine, sw_bls12381.Scalar]:
+		c, err := sw_emulated.New[emulated.BLS12381Fp, emulated.BLS12381Fr](api, sw_emulated.GetBLS12381Params())
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
+	case *Curve[sw_bw6761.G1Affine, sw_bw6761.Scalar]:
+		c, err := sw_emulated.New[emulated.BW6761Fp, emulated.BW6761Fr](api, sw_emulated.GetBW6761Params())
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
+	case *Curve[emulated_bls12377.G1Affine, emulated_bls12377.Scalar]:
+		c, err := sw_emulated.New[emulated.BLS12377Fp, emulated.BLS12377Fr](api, sw_emulated.GetBLS12377Params())
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
+	case *Curve[sw_bls12377.G1Affine, sw_bls12377.Scalar]:
+		c, err := sw_bls12377.NewCurve(api)
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
+	case *Curve[sw_bls24315.G1Affine, sw_bls24315.Scalar]:
+		c, err := sw_bls24315.NewCurve(api)
+		if err != nil {
+			return ret, fmt.Errorf("new curve: %w", err)
+		}
+		*s = c
+	default:
+		return ret, fmt.Errorf("unknown type parametrization")
+	}
+	return ret, nil
+}
+
+// GetPairing returns the [Pairing] implementation corresponding to the groups
+// type parameters. The method allows to have a fully generic implementation
+// without taking into consideration the initialization differences.
+func GetPairing[G1El any, G2El any, GtEl any](api frontend.API) (Pairing[G1El, G2El, GtEl], error) {
+	var ret Pairing[G1El, G2El, GtEl]
+	switch s := any(&ret).(type) {
+	case *Pairing[sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]:
+		p, err := sw_bn254.NewPairing(api)
+		if err != nil {
+			return ret, fmt.Errorf("new pairing: %w", err)
+		}
+		*s = p
+	case *Pairing[sw_bls12381.G1Affine, sw_bls12381.G2Affine, sw_bls12381.GTEl]:
+		p, err := sw_bls12381.NewPairing(api)
+		if err != nil {
+			return ret, fmt.Errorf("new pairing: %w", err)
+		}
+		*s = p
+	case *Pairing[sw_bw6761.G1Affine, sw_bw6761.G2Affine, sw_bw6761.GTEl]:
+		p, err := sw_bw6761.NewPairing(api)
+		if err != nil {
+			return ret, fmt.Errorf("new pairing: %w", err)
+		}
+		*s = p
+	case *Pairing[emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]:
+		p, err := emulated_bls12377.NewPairing(api)
+		if err != nil {
+			return ret, fmt.Errorf("new pairing: %w", err)
+		}
+		*s = p
+	case *Pairing[sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]:
+		*s = sw_bls12377.NewPairing(api)
+	case *Pairing[sw_bls24315.G1Affine, sw_bls24315.G2Affine, sw_bls24315.GT]:
+		*s = sw_bls24315.NewPairing(api)
+	default:
+		return ret, fmt.Errorf("unknown type parametrization")
+	}
+	return ret, nil
+}
@@ -0,0 +1,313 @@
+package fields_bls12377
+
+import (
+	"math/big"
+
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type curveF = emulated.Field[emulated.BLS12377Fp]
+type baseEl = emulated.Element[emulated.BLS12377Fp]
+
+type E2 struct {
+	A0, A1 baseEl
+}
+
+type Ext2 struct {
+	api         frontend.API
+	fp          *curveF
+	nonResidues map[int]map[int]*baseEl
+}
+
+func NewExt2(api frontend.API) *Ext2 {
+	fp, err := emulated.NewField[emulated.BLS12377Fp](api)
+	if err != nil {
+		panic(err)
+	}
+	// all the powers u^(k*(p^i-1)/6) of the non-residue u are in 𝔽p.
+	pwrs := map[int]map[int]string{
+		1: {
+			1: "92949345220277864758624960506473182677953048909283248980960104381795901929519566951595905490535835115111760994353",
+			2: "80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410946",
+			3: "216465761340224619389371505802605247630151569547285782856803747159100223055385581585702401816380679166954762214499",
+			4: "80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410945",
+			5: "123516416119946754630746545296132064952198520638002533875843642777304321125866014634106496325844844051843001220146",
+		},
+		2: {
+			1: "80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410946",
+			2: "80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410945",
+			3: "258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458176",
+			4: "258664426012969093929703085429980814127835149614277183275038967946009968870203535512256352201271898244626862047231",
+			5: "258664426012969093929703085429980814127835149614277183275038967946009968870203535512256352201271898244626862047232",
+		},
+	}
+	nonResidues := make(map[int]map[int]*baseEl)
+	for pwr, v := range pwrs {
+		for coeff, v := range v {
+			el := emulated.ValueOf[emulated.BLS12377Fp](v)
+			if nonResidues[pwr] == nil {
+				nonResidues[pwr] = make(map[int]*baseEl)
+			}
+			nonResidues[pwr][coeff] = &el
+		}
+	}
+	return &Ext2{api: api, fp: fp, nonResidues: nonResidues}
+}
+
+func (e Ext2) MulByElement(x *E2, y *baseEl) *E2 {
+	z0 := e.fp.MulMod(&x.A0, y)
+	z1 := e.fp.MulMod(&x.A1, y)
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) MulByConstElement(x *E2, y *big.Int) *E2 {
+	z0 := e.fp.MulConst(&x.A0, y)
+	z1 := e.fp.MulConst(&x.A1, y)
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) Conjugate(x *E2) *E2 {
+	z0 := x.A0
+	z1 := e.fp.Neg(&x.A1)
+	return &E2{
+		A0: z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) MulByNonResidueGeneric(x *E2, power, coef int) *E2 {
+	y := e.nonResidues[power][coef]
+	return e.MulByElement(x, y)
+}
+
+// MulByNonResidue returns x*u
+func (e Ext2) MulByNonResidue(x *E2) *E2 {
+	a := e.fp.MulConst(&x.A1, big.NewInt(5))
+	a = e.fp.Neg(a)
+	return &E2{
+		A0: *a,
+		A1: x.A0,
+	}
+}
+
+// MulByNonResidue1Power1 returns x*u^(1*(p^1-1)/6)
+func (e Ext2) MulByNonResidue1Power1(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 1, 1)
+}
+
+// MulByNonResidue1Power2 returns x*u^(2*(p^1-1)/6)
+func (e Ext2) MulByNonResidue1Power2(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 1, 2)
+}
+
+// MulByNonResidue1Power3 returns x*u^(3*(p^1-1)/6)
+func (e Ext2) MulByNonResidue1Power3(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 1, 3)
+}
+
+// MulByNonResidue1Power4 returns x*u^(4*(p^1-1)/6)
+func (e Ext2) MulByNonResidue1Power4(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 1, 4)
+}
+
+// MulByNonResidue1Power5 returns x*u^(5*(p^1-1)/6)
+func (e Ext2) MulByNonResidue1Power5(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 1, 5)
+}
+
+// MulByNonResidue2Power1 returns x*u^(1*(p^2-1)/6)
+func (e Ext2) MulByNonResidue2Power1(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 2, 1)
+}
+
+// MulByNonResidue2Power2 returns x*u^(2*(p^2-1)/6)
+func (e Ext2) MulByNonResidue2Power2(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 2, 2)
+}
+
+// MulByNonResidue2Power3 returns x*u^(3*(p^2-1)/6)
+func (e Ext2) MulByNonResidue2Power3(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 2, 3)
+}
+
+// MulByNonResidue2Power4 returns x*u^(4*(p^2-1)/6)
+func (e Ext2) MulByNonResidue2Power4(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 2, 4)
+}
+
+// MulByNonResidue2Power5 returns x*u^(5*(p^2-1)/6)
+func (e Ext2) MulByNonResidue2Power5(x *E2) *E2 {
+	return e.MulByNonResidueGeneric(x, 2, 5)
+}
+
+func (e Ext2) Mul(x, y *E2) *E2 {
+	a := e.fp.Add(&x.A0, &x.A1)
+	b := e.fp.Add(&y.A0, &y.A1)
+	a = e.fp.MulMod(a, b)
+	b = e.fp.MulMod(&x.A0, &y.A0)
+	c := e.fp.MulMod(&x.A1, &y.A1)
+	z1 := e.fp.Sub(a, b)
+	z1 = e.fp.Sub(z1, c)
+	// u² = -5
+	c = e.fp.MulConst(c, big.NewInt(5))
+	z0 := e.fp.Sub(b, c)
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) Add(x, y *E2) *E2 {
+	z0 := e.fp.Add(&x.A0, &y.A0)
+	z1 := e.fp.Add(&x.A1, &y.A1)
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) Sub(x, y *E2) *E2 {
+	z0 := e.fp.Sub(&x.A0, &y.A0)
+	z1 := e.fp.Sub(&x.A1, &y.A1)
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) Neg(x *E2) *E2 {
+	z0 := e.fp.Neg(&x.A0)
+	z1 := e.fp.Neg(&x.A1)
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) One() *E2 {
+	z0 := e.fp.One()
+	z1 := e.fp.Zero()
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) Zero() *E2 {
+	z0 := e.fp.Zero()
+	z1 := e.fp.Zero()
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+func (e Ext2) IsZero(z *E2) frontend.Variable {
+	a0 := e.fp.IsZero(&z.A0)
+	a1 := e.fp.IsZero(&z.A1)
+	return e.api.And(a0, a1)
+}
+
+// returns u
+func (e Ext2) NonResidue() *E2 {
+	return &E2{
+		A0: *e.fp.Zero(),
+		A1: *e.fp.One(),
+	}
+}
+
+func (e Ext2) Square(x *E2) *E2 {
+	// (x0+x1)(x0-5x1) + 4x0x1 = x0²-5x1²
+	c := e.fp.MulMod(&x.A0, &x.A1)
+	a := e.fp.Add(&x.A0, &x.A1)
+	b := e.fp.MulConst(&x.A1, big.NewInt(5))
+	b = e.fp.Sub(&x.A0, b)
+	a = e.fp.MulMod(a, b)
+	a = e.fp.Add(a, e.fp.MulConst(c, big.NewInt(4)))
+	c = e.fp.MulConst(c, big.NewInt(2))
+	return &E2{
+		A0: *a,
+		A1: *c,
+	}
+}
+
+func (e Ext2) Double(x *E2) *E2 {
+	two := big.NewInt(2)
+	z0 := e.fp.MulConst(&x.A0, two)
+	z1 := e.fp.MulConst(&x.A1, two)
+	return &E2{
+		A0: *z0,
+		A1: *z1,
+	}
+}
+
+func (e Ext2) AssertIsEqual(x, y *E2) {
+	e.fp.AssertIsEqual(&x.A0, &y.A0)
+	e.fp.AssertIsEqual(&x.A1, &y.A1)
+}
+
+func FromE2(y *bls12377.E2) E2 {
+	return E2{
+		A0: emulated.ValueOf[emulated.BLS12377Fp](y.A0),
+		A1: emulated.ValueOf[emulated.BLS12377Fp](y.A1),
+	}
+}
+
+func (e Ext2) Inverse(x *E2) *E2 {
+	res, err := e.fp.NewHint(inverseE2Hint, 2, &x.A0, &x.A1)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+
+	inv := E2{
+		A0: *res[0],
+		A1: *res[1],
+	}
+	one := e.One()
+
+	// 1 == inv * x
+	_one := e.Mul(&inv, x)
+	e.AssertIsEqual(one, _one)
+
+	return &inv
+
+}
+
+func (e Ext2) DivUnchecked(x, y *E2) *E2 {
+	res, err := e.fp.NewHint(divE2Hint, 2, &x.A0, &x.A1, &y.A0, &y.A1)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+
+	div := E2{
+		A0: *res[0],
+		A1: *res[1],
+	}
+
+	// x == div * y
+	_x := e.Mul(&div, y)
+	e.AssertIsEqual(x, _x)
+
+	return &div
+}
+
+func (e Ext2) Select(selector frontend.Variable, z1, z0 *E2) *E2 {
+	a0 := e.fp.Select(selector, &z1.A0, &z0.A0)
+	a1 := e.fp.Select(selector, &z1.A1, &z0.A1)
+	return &E2{A0: *a0, A1: *a1}
+}
+
+func (e Ext2) Lookup2(s1, s2 frontend.Variable, a, b, c, d *E2) *E2 {
+	a0 := e.fp.Lookup2(s1, s2, &a.A0, &b.A0, &c.A0, &d.A0)
+	a1 := e.fp.Lookup2(s1, s2, &a.A1, &b.A1, &c.A1, &d.A1)
+	return &E2{A0: *a0, A1: *a1}
+}
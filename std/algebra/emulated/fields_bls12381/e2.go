@@ -5,6 +5,7 @@ import (
 
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
 	"github.com/consensys/gnark/std/math/emulated"
 )
 
@@ -248,6 +249,27 @@ func (e Ext2) IsZero(z *E2) frontend.Variable {
 	return e.api.And(a0, a1)
 }
 
+// Sgn0 returns the sign of z as defined in RFC 9380 Section 4.1, that is the
+// parity of the first non-zero coordinate of z. The coordinates of z are
+// asserted to be in canonical form.
+func (e Ext2) Sgn0(z *E2) frontend.Variable {
+	a0 := e.fp.Reduce(&z.A0)
+	e.fp.AssertIsInRange(a0)
+	a1 := e.fp.Reduce(&z.A1)
+	e.fp.AssertIsInRange(a1)
+	// a0 is canonical, so it is zero iff all its limbs are zero.
+	zero0 := e.api.IsZero(a0.Limbs[0])
+	for i := 1; i < len(a0.Limbs); i++ {
+		zero0 = e.api.Mul(zero0, e.api.IsZero(a0.Limbs[i]))
+	}
+	// the limbs of the reduced elements are range checked, so the parity of
+	// the element is the parity of its least significant limb.
+	sign0 := bits.ToBinary(e.api, a0.Limbs[0], bits.WithNbDigits(64))[0]
+	sign1 := bits.ToBinary(e.api, a1.Limbs[0], bits.WithNbDigits(64))[0]
+	// sign = sign0 OR (zero0 AND sign1)
+	return e.api.Or(sign0, e.api.And(zero0, sign1))
+}
+
 // returns 1+u
 func (e Ext2) NonResidue() *E2 {
 	one := e.fp.One()
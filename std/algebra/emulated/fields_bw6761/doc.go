@@ -0,0 +1,6 @@
+// Package fields_bw6761 implements the fields arithmetic of the Fp6 tower
+// used to compute the pairing over the BW6-761 curve.
+//
+//	𝔽p³[v] = 𝔽p/v³+4
+//	𝔽p⁶[w] = 𝔽p³/w²-v
+package fields_bw6761
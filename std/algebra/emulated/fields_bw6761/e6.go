@@ -0,0 +1,161 @@
+package fields_bw6761
+
+import (
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	"github.com/consensys/gnark/frontend"
+)
+
+type E6 struct {
+	B0, B1 E3
+}
+
+type Ext6 struct {
+	*Ext3
+}
+
+func NewExt6(api frontend.API) *Ext6 {
+	return &Ext6{Ext3: NewExt3(api)}
+}
+
+func (e Ext6) Add(x, y *E6) *E6 {
+	z0 := e.Ext3.Add(&x.B0, &y.B0)
+	z1 := e.Ext3.Add(&x.B1, &y.B1)
+	return &E6{
+		B0: *z0,
+		B1: *z1,
+	}
+}
+
+func (e Ext6) Sub(x, y *E6) *E6 {
+	z0 := e.Ext3.Sub(&x.B0, &y.B0)
+	z1 := e.Ext3.Sub(&x.B1, &y.B1)
+	return &E6{
+		B0: *z0,
+		B1: *z1,
+	}
+}
+
+func (e Ext6) Conjugate(x *E6) *E6 {
+	z1 := e.Ext3.Neg(&x.B1)
+	return &E6{
+		B0: x.B0,
+		B1: *z1,
+	}
+}
+
+func (e Ext6) Mul(x, y *E6) *E6 {
+	a := e.Ext3.Add(&x.B0, &x.B1)
+	b := e.Ext3.Add(&y.B0, &y.B1)
+	a = e.Ext3.Mul(a, b)
+	b = e.Ext3.Mul(&x.B0, &y.B0)
+	c := e.Ext3.Mul(&x.B1, &y.B1)
+	z1 := e.Ext3.Sub(a, b)
+	z1 = e.Ext3.Sub(z1, c)
+	z0 := e.Ext3.MulByNonResidue(c)
+	z0 = e.Ext3.Add(z0, b)
+	return &E6{
+		B0: *z0,
+		B1: *z1,
+	}
+}
+
+func (e Ext6) Zero() *E6 {
+	zero := e.fp.Zero()
+	return &E6{
+		B0: E3{A0: *zero, A1: *zero, A2: *zero},
+		B1: E3{A0: *zero, A1: *zero, A2: *zero},
+	}
+}
+
+func (e Ext6) One() *E6 {
+	z000 := e.fp.One()
+	zero := e.fp.Zero()
+	return &E6{
+		B0: E3{A0: *z000, A1: *zero, A2: *zero},
+		B1: E3{A0: *zero, A1: *zero, A2: *zero},
+	}
+}
+
+func (e Ext6) IsZero(z *E6) frontend.Variable {
+	b0 := e.Ext3.IsZero(&z.B0)
+	b1 := e.Ext3.IsZero(&z.B1)
+	return e.api.And(b0, b1)
+}
+
+func (e Ext6) Square(x *E6) *E6 {
+	// Algorithm 22 from https://eprint.iacr.org/2010/354.pdf
+	c0 := e.Ext3.Sub(&x.B0, &x.B1)
+	c3 := e.Ext3.MulByNonResidue(&x.B1)
+	c3 = e.Ext3.Neg(c3)
+	c3 = e.Ext3.Add(&x.B0, c3)
+	c2 := e.Ext3.Mul(&x.B0, &x.B1)
+	c0 = e.Ext3.Mul(c0, c3)
+	c0 = e.Ext3.Add(c0, c2)
+	z1 := e.Ext3.Double(c2)
+	c2 = e.Ext3.MulByNonResidue(c2)
+	z0 := e.Ext3.Add(c0, c2)
+	return &E6{
+		B0: *z0,
+		B1: *z1,
+	}
+}
+
+func (e Ext6) AssertIsEqual(x, y *E6) {
+	e.Ext3.AssertIsEqual(&x.B0, &y.B0)
+	e.Ext3.AssertIsEqual(&x.B1, &y.B1)
+}
+
+func FromE6(y *bw6761.E6) E6 {
+	return E6{
+		B0: FromE3(&y.B0),
+		B1: FromE3(&y.B1),
+	}
+
+}
+
+func (e Ext6) Inverse(x *E6) *E6 {
+	res, err := e.fp.NewHint(inverseE6Hint, 6, &x.B0.A0, &x.B0.A1, &x.B0.A2, &x.B1.A0, &x.B1.A1, &x.B1.A2)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+
+	inv := E6{
+		B0: E3{A0: *res[0], A1: *res[1], A2: *res[2]},
+		B1: E3{A0: *res[3], A1: *res[4], A2: *res[5]},
+	}
+
+	one := e.One()
+
+	// 1 == inv * x
+	_one := e.Mul(&inv, x)
+	e.AssertIsEqual(one, _one)
+
+	return &inv
+
+}
+
+func (e Ext6) DivUnchecked(x, y *E6) *E6 {
+	res, err := e.fp.NewHint(divE6Hint, 6, &x.B0.A0, &x.B0.A1, &x.B0.A2, &x.B1.A0, &x.B1.A1, &x.B1.A2, &y.B0.A0, &y.B0.A1, &y.B0.A2, &y.B1.A0, &y.B1.A1, &y.B1.A2)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+
+	div := E6{
+		B0: E3{A0: *res[0], A1: *res[1], A2: *res[2]},
+		B1: E3{A0: *res[3], A1: *res[4], A2: *res[5]},
+	}
+
+	// x == div * y
+	_x := e.Mul(&div, y)
+	e.AssertIsEqual(x, _x)
+
+	return &div
+}
+
+func (e Ext6) Select(selector frontend.Variable, z1, z0 *E6) *E6 {
+	b0 := e.Ext3.Select(selector, &z1.B0, &z0.B0)
+	b1 := e.Ext3.Select(selector, &z1.B1, &z0.B1)
+	return &E6{B0: *b0, B1: *b1}
+}
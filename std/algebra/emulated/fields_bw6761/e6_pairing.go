@@ -0,0 +1,188 @@
+package fields_bw6761
+
+import "github.com/consensys/gnark/std/math/emulated"
+
+func (e Ext6) nSquareTorus(z *E3, n int) *E3 {
+	for i := 0; i < n; i++ {
+		z = e.SquareTorus(z)
+	}
+	return z
+}
+
+// ExptTorus set z to xᵗ in E3 and return z
+// const t uint64 = 9586122913090633729
+func (e Ext6) ExptTorus(x *E3) *E3 {
+	// tAbsVal in binary: 1000010100001000110000000000000000000000000000000000000000000001
+	// drop the low 46 bits (all 0 except the least significant bit): 100001010000100011 = 136227
+	// Shortest addition chains can be found at https://wwwhomes.uni-bielefeld.de/achim/addition_chain.html
+
+	// a shortest addition chain for 136227
+	z := e.nSquareTorus(x, 5)
+	z = e.MulTorus(z, x)
+	x33 := z
+	z = e.nSquareTorus(z, 7)
+	z = e.MulTorus(z, x33)
+	z = e.nSquareTorus(z, 4)
+	z = e.MulTorus(z, x)
+	z = e.SquareTorus(z)
+	z = e.MulTorus(z, x)
+
+	// the remaining 46 bits
+	z = e.nSquareTorus(z, 46)
+	z = e.MulTorus(z, x)
+
+	return z
+}
+
+// Expc2Torus set z to x^c2 in E3 and return z
+// ht, hy = 13, 9
+// c2 = ht+hy = 22 (10110)
+func (e Ext6) Expc2Torus(x *E3) *E3 {
+	z := e.SquareTorus(x)
+	z = e.SquareTorus(z)
+	z = e.MulTorus(z, x)
+	z = e.SquareTorus(z)
+	z = e.MulTorus(z, x)
+	z = e.SquareTorus(z)
+
+	return z
+}
+
+// Expc1Torus set z to x^c1 in E3 and return z
+// ht, hy = 13, 9
+// c1 = ht**2+3*hy**2 = 412 (110011100)
+func (e Ext6) Expc1Torus(x *E3) *E3 {
+	z := e.SquareTorus(x)
+	z = e.MulTorus(z, x)
+	z = e.nSquareTorus(z, 3)
+	z = e.MulTorus(z, x)
+	z = e.SquareTorus(z)
+	z = e.MulTorus(z, x)
+	z = e.SquareTorus(z)
+	z = e.MulTorus(z, x)
+	z = e.nSquareTorus(z, 2)
+
+	return z
+}
+
+// MulBy034 multiplies z by an E6 sparse element of the form
+//
+//	E6{
+//		B0: E3{A0: 1, A1: 0, A2: 0},
+//		B1: E3{A0: c3, A1: c4, A2: 0},
+//	}
+func (e *Ext6) MulBy034(z *E6, c3, c4 *baseEl) *E6 {
+
+	a := z.B0
+	b := e.Ext3.MulBy01(&z.B1, c3, c4)
+
+	one := e.fp.One()
+	d0 := e.fp.Add(c3, one)
+
+	zB1 := e.Ext3.Add(&z.B1, &z.B0)
+	zB1 = e.Ext3.MulBy01(zB1, d0, c4)
+	zB1 = e.Ext3.Sub(zB1, &a)
+	zB1 = e.Ext3.Sub(zB1, b)
+	zB0 := e.Ext3.MulByNonResidue(b)
+	zB0 = e.Ext3.Add(zB0, &a)
+
+	return &E6{
+		B0: *zB0,
+		B1: *zB1,
+	}
+}
+
+// Torus-based arithmetic:
+//
+// After the easy part of the final exponentiation the elements are in a proper
+// subgroup of Fpk (E6) that coincides with some algebraic tori. The elements
+// are in the torus Tk(Fp) and thus in each torus Tk/d(Fp^d) for d|k, d≠k.  We
+// take d=3. So the elements are in T2(Fp3).
+// Let G_{q,2} = {m ∈ Fq^2 | m^(q+1) = 1} where q = p^3.
+// When m.B1 = 0, then m.B0 must be 1 or −1.
+//
+// We recall the tower construction:
+//
+//	𝔽p³[v] = 𝔽p/v³+4
+//	𝔽p⁶[w] = 𝔽p³/w²-v
+
+// CompressTorus compresses x ∈ E6 to (x.B0 + 1)/x.B1 ∈ E3
+func (e Ext6) CompressTorus(x *E6) *E3 {
+	// x ∈ G_{q,2} \ {-1,1}
+	y := e.Ext3.Add(&x.B0, e.Ext3.One())
+	y = e.Ext3.DivUnchecked(y, &x.B1)
+	return y
+}
+
+// DecompressTorus decompresses y ∈ E3 to (y+w)/(y-w) ∈ E6
+func (e Ext6) DecompressTorus(y *E3) *E6 {
+	var n, d E6
+	one := e.Ext3.One()
+	n.B0 = *y
+	n.B1 = *one
+	d.B0 = *y
+	d.B1 = *e.Ext3.Neg(one)
+
+	x := e.DivUnchecked(&n, &d)
+	return x
+}
+
+// MulTorus multiplies two compressed elements y1, y2 ∈ E3
+// and returns (y1 * y2 + v)/(y1 + y2)
+// N.B.: we use MulTorus in the final exponentiation throughout y1 ≠ -y2 always.
+func (e Ext6) MulTorus(y1, y2 *E3) *E3 {
+	n := e.Ext3.Mul(y1, y2)
+	n.A1 = *e.fp.Add(&n.A1, e.fp.One())
+	d := e.Ext3.Add(y1, y2)
+	y3 := e.Ext3.DivUnchecked(n, d)
+	return y3
+}
+
+// InverseTorus inverses a compressed elements y ∈ E3
+// and returns -y
+func (e Ext6) InverseTorus(y *E3) *E3 {
+	return e.Ext3.Neg(y)
+}
+
+// SquareTorus squares a compressed elements y ∈ E3
+// and returns (y + v/y)/2
+//
+// It uses a hint to verify that (2x-y)y = v saving one E3 AssertIsEqual.
+func (e Ext6) SquareTorus(y *E3) *E3 {
+	res, err := e.fp.NewHint(squareTorusHint, 3, &y.A0, &y.A1, &y.A2)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+
+	sq := E3{
+		A0: *res[0],
+		A1: *res[1],
+		A2: *res[2],
+	}
+
+	// v = (2x-y)y
+	v := e.Ext3.Double(&sq)
+	v = e.Ext3.Sub(v, y)
+	v = e.Ext3.Mul(v, y)
+
+	_v := E3{A0: *e.fp.Zero(), A1: *e.fp.One(), A2: *e.fp.Zero()}
+	e.Ext3.AssertIsEqual(v, &_v)
+
+	return &sq
+
+}
+
+// FrobeniusTorus raises a compressed elements y ∈ E3 to the modulus p
+// and returns y^p / v^((p-1)/2)
+func (e Ext6) FrobeniusTorus(y *E3) *E3 {
+	// v^((p-1)/2) = (-4)^((p-1)/6) ∈ 𝔽p and the Frobenius coefficients of
+	// E3 are absorbed in the constants below.
+	c0 := emulated.ValueOf[emulated.BW6761Fp]("1968985824090209297278610739700577151397666382303825728450741611566800370218827257750865013421937292370006175842381275743914023380727582819905021229583192207421122272650305267822868639090213645505120388400344940985710520836292651")
+	c1 := emulated.ValueOf[emulated.BW6761Fp]("4922464560225523242118178942575080391082002530232324381063048548642823052024664478336818169867474395270858391911405337707247735739826664939444490469542109391530482826728203582549674992333383150446779312029624171857054392282775649")
+	t0 := e.fp.MulMod(&y.A0, &c0)
+	t1 := e.fp.MulMod(&y.A1, &c1)
+	t2 := e.fp.Neg(&y.A2)
+
+	return &E3{A0: *t0, A1: *t1, A2: *t2}
+}
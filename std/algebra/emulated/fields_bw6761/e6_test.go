@@ -0,0 +1,619 @@
+package fields_bw6761
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	"github.com/consensys/gnark-crypto/ecc/bw6-761/fp"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type e6Add struct {
+	A, B, C E6
+}
+
+func (circuit *e6Add) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.Add(&circuit.A, &circuit.B)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestAddFp6(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, b, c bw6761.E6
+	_, _ = a.SetRandom()
+	_, _ = b.SetRandom()
+	c.Add(&a, &b)
+
+	witness := e6Add{
+		A: FromE6(&a),
+		B: FromE6(&b),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Add{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+}
+
+type e6Sub struct {
+	A, B, C E6
+}
+
+func (circuit *e6Sub) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.Sub(&circuit.A, &circuit.B)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestSubFp6(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, b, c bw6761.E6
+	_, _ = a.SetRandom()
+	_, _ = b.SetRandom()
+	c.Sub(&a, &b)
+
+	witness := e6Sub{
+		A: FromE6(&a),
+		B: FromE6(&b),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Sub{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+}
+
+type e6Mul struct {
+	A, B, C E6
+}
+
+func (circuit *e6Mul) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.Mul(&circuit.A, &circuit.B)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestMulFp6(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, b, c bw6761.E6
+	_, _ = a.SetRandom()
+	_, _ = b.SetRandom()
+	c.Mul(&a, &b)
+
+	witness := e6Mul{
+		A: FromE6(&a),
+		B: FromE6(&b),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Mul{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+}
+
+type e6Square struct {
+	A, C E6
+}
+
+func (circuit *e6Square) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.Square(&circuit.A)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestSquareFp6(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+	c.Square(&a)
+
+	witness := e6Square{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Square{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+}
+
+type e6Div struct {
+	A, B, C E6
+}
+
+func (circuit *e6Div) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.DivUnchecked(&circuit.A, &circuit.B)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestDivFp6(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, b, c bw6761.E6
+	_, _ = a.SetRandom()
+	_, _ = b.SetRandom()
+	c.Inverse(&b).Mul(&c, &a)
+
+	witness := e6Div{
+		A: FromE6(&a),
+		B: FromE6(&b),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Div{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+}
+
+type e6Conjugate struct {
+	A, C E6
+}
+
+func (circuit *e6Conjugate) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.Conjugate(&circuit.A)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestConjugateFp6(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+	c.Conjugate(&a)
+
+	witness := e6Conjugate{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Conjugate{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+}
+
+type e6Inverse struct {
+	A, C E6
+}
+
+func (circuit *e6Inverse) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.Inverse(&circuit.A)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestInverseFp6(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+	c.Inverse(&a)
+
+	witness := e6Inverse{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Inverse{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+}
+
+type e6MulBy034 struct {
+	A    E6 `gnark:",public"`
+	W    E6
+	B, C baseEl
+}
+
+func (circuit *e6MulBy034) Define(api frontend.API) error {
+	e := NewExt6(api)
+	res := e.MulBy034(&circuit.A, &circuit.B, &circuit.C)
+	e.AssertIsEqual(res, &circuit.W)
+	return nil
+}
+
+func TestFp6MulBy034(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, w bw6761.E6
+	_, _ = a.SetRandom()
+	var one, b, c fp.Element
+	one.SetOne()
+	_, _ = b.SetRandom()
+	_, _ = c.SetRandom()
+	w.Set(&a)
+	w.MulBy034(&one, &b, &c)
+
+	witness := e6MulBy034{
+		A: FromE6(&a),
+		B: emulated.ValueOf[emulated.BW6761Fp](b),
+		C: emulated.ValueOf[emulated.BW6761Fp](c),
+		W: FromE6(&w),
+	}
+
+	err := test.IsSolved(&e6MulBy034{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+}
+
+// Torus-based arithmetic
+type torusCompress struct {
+	A E6
+	C E3 `gnark:",public"`
+}
+
+func (circuit *torusCompress) Define(api frontend.API) error {
+	e := NewExt6(api)
+	expected := e.CompressTorus(&circuit.A)
+	e.Ext3.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestTorusCompress(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c, _ := a.CompressTorus()
+
+	witness := torusCompress{
+		A: FromE6(&a),
+		C: FromE3(&c),
+	}
+
+	err := test.IsSolved(&torusCompress{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type torusMul struct {
+	A E6
+	B E6
+	C E6 `gnark:",public"`
+}
+
+func (circuit *torusMul) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressedA := e.CompressTorus(&circuit.A)
+	compressedB := e.CompressTorus(&circuit.B)
+	compressedAB := e.MulTorus(compressedA, compressedB)
+	expected := e.DecompressTorus(compressedAB)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestTorusMul(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, b, c, tmp bw6761.E6
+	_, _ = a.SetRandom()
+	_, _ = b.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+	// put b in the cyclotomic subgroup
+	tmp.Conjugate(&b)
+	b.Inverse(&b)
+	tmp.Mul(&tmp, &b)
+	b.Frobenius(&tmp).Mul(&b, &tmp)
+
+	// uncompressed mul
+	c.Mul(&a, &b)
+
+	witness := torusMul{
+		A: FromE6(&a),
+		B: FromE6(&b),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&torusMul{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type torusDecompress struct {
+	A E6
+	C E6 `gnark:",public"`
+}
+
+func (circuit *torusDecompress) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressed := e.CompressTorus(&circuit.A)
+	expected := e.DecompressTorus(compressed)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestTorusDecompress(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c.Set(&a)
+
+	witness := torusDecompress{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&torusDecompress{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type torusInverse struct {
+	A E6
+	C E6 `gnark:",public"`
+}
+
+func (circuit *torusInverse) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressed := e.CompressTorus(&circuit.A)
+	compressed = e.InverseTorus(compressed)
+	expected := e.DecompressTorus(compressed)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestTorusInverse(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c.Inverse(&a)
+
+	witness := torusInverse{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&torusInverse{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type torusFrobenius struct {
+	A E6
+	C E6 `gnark:",public"`
+}
+
+func (circuit *torusFrobenius) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressed := e.CompressTorus(&circuit.A)
+	compressed = e.FrobeniusTorus(compressed)
+	expected := e.DecompressTorus(compressed)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestTorusFrobenius(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c.Frobenius(&a)
+
+	witness := torusFrobenius{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&torusFrobenius{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type torusSquare struct {
+	A E6
+	C E6 `gnark:",public"`
+}
+
+func (circuit *torusSquare) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressed := e.CompressTorus(&circuit.A)
+	compressed = e.SquareTorus(compressed)
+	expected := e.DecompressTorus(compressed)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestTorusSquare(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c.Square(&a)
+
+	witness := torusSquare{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&torusSquare{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type e6ExptTorus struct {
+	A E6
+	C E6 `gnark:",public"`
+}
+
+func (circuit *e6ExptTorus) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressed := e.CompressTorus(&circuit.A)
+	compressed = e.ExptTorus(compressed)
+	expected := e.DecompressTorus(compressed)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestFp6ExptTorus(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c.Expt(&a)
+
+	witness := e6ExptTorus{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6ExptTorus{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type e6Expc1Torus struct {
+	A E6
+	C E6 `gnark:",public"`
+}
+
+func (circuit *e6Expc1Torus) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressed := e.CompressTorus(&circuit.A)
+	compressed = e.Expc1Torus(compressed)
+	expected := e.DecompressTorus(compressed)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestFp6Expc1Torus(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c.Expc1(&a)
+
+	witness := e6Expc1Torus{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Expc1Torus{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type e6Expc2Torus struct {
+	A E6
+	C E6 `gnark:",public"`
+}
+
+func (circuit *e6Expc2Torus) Define(api frontend.API) error {
+	e := NewExt6(api)
+	compressed := e.CompressTorus(&circuit.A)
+	compressed = e.Expc2Torus(compressed)
+	expected := e.DecompressTorus(compressed)
+	e.AssertIsEqual(expected, &circuit.C)
+	return nil
+}
+
+func TestFp6Expc2Torus(t *testing.T) {
+
+	assert := test.NewAssert(t)
+	// witness values
+	var a, c bw6761.E6
+	_, _ = a.SetRandom()
+
+	// put a in the cyclotomic subgroup
+	var tmp bw6761.E6
+	tmp.Conjugate(&a)
+	a.Inverse(&a)
+	tmp.Mul(&tmp, &a)
+	a.Frobenius(&tmp).Mul(&a, &tmp)
+
+	c.Expc2(&a)
+
+	witness := e6Expc2Torus{
+		A: FromE6(&a),
+		C: FromE6(&c),
+	}
+
+	err := test.IsSolved(&e6Expc2Torus{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
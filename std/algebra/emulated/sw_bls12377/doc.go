@@ -0,0 +1,6 @@
+// Package sw_bls12377 implements G1 and G2 arithmetics and pairing computation over BLS12-377 curve.
+//
+// The implementation follows [Housni22]: "Pairings in Rank-1 Constraint Systems".
+//
+// [Housni22]: https://eprint.iacr.org/2022/1162
+package sw_bls12377
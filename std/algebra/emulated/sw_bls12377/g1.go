@@ -0,0 +1,57 @@
+package sw_bls12377
+
+import (
+	"fmt"
+
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type G1Affine = sw_emulated.AffinePoint[emulated.BLS12377Fp]
+
+func NewG1Affine(v bls12377.G1Affine) G1Affine {
+	return G1Affine{
+		X: emulated.ValueOf[emulated.BLS12377Fp](v.X),
+		Y: emulated.ValueOf[emulated.BLS12377Fp](v.Y),
+	}
+}
+
+// Scalar is the scalar in the groups. For G1 and G2 the scalar field is the same.
+type Scalar = emulated.Element[ScalarField]
+
+// ScalarField is the [emulated.FieldParams] implementation of the curve scalar field.
+type ScalarField = emulated.BLS12377Fr
+
+// NewScalar allocates a witness from the native scalar and returns it.
+func NewScalar(v fr_bls12377.Element) Scalar {
+	return emulated.ValueOf[ScalarField](v)
+}
+
+type G1 struct {
+	curveF *emulated.Field[emulated.BLS12377Fp]
+	w      *emulated.Element[emulated.BLS12377Fp]
+}
+
+func NewG1(api frontend.API) (*G1, error) {
+	ba, err := emulated.NewField[emulated.BLS12377Fp](api)
+	if err != nil {
+		return nil, fmt.Errorf("new base api: %w", err)
+	}
+	w := emulated.ValueOf[emulated.BLS12377Fp]("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410945")
+	return &G1{
+		curveF: ba,
+		w:      &w,
+	}, nil
+}
+
+func (g1 *G1) phi(q *G1Affine) *G1Affine {
+	x := g1.curveF.Mul(&q.X, g1.w)
+
+	return &G1Affine{
+		X: *x,
+		Y: q.Y,
+	}
+}
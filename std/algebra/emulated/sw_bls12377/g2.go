@@ -0,0 +1,146 @@
+package sw_bls12377
+
+import (
+	"math/big"
+
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bls12377"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type G2 struct {
+	*fields_bls12377.Ext2
+	u, v *emulated.Element[emulated.BLS12377Fp]
+}
+
+type G2Affine struct {
+	X, Y fields_bls12377.E2
+}
+
+func NewG2(api frontend.API) *G2 {
+	u := emulated.ValueOf[emulated.BLS12377Fp]("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410946")
+	v := emulated.ValueOf[emulated.BLS12377Fp]("216465761340224619389371505802605247630151569547285782856803747159100223055385581585702401816380679166954762214499")
+	return &G2{
+		Ext2: fields_bls12377.NewExt2(api),
+		u:    &u,
+		v:    &v,
+	}
+}
+
+func NewG2Affine(v bls12377.G2Affine) G2Affine {
+	return G2Affine{
+		X: fields_bls12377.E2{
+			A0: emulated.ValueOf[emulated.BLS12377Fp](v.X.A0),
+			A1: emulated.ValueOf[emulated.BLS12377Fp](v.X.A1),
+		},
+		Y: fields_bls12377.E2{
+			A0: emulated.ValueOf[emulated.BLS12377Fp](v.Y.A0),
+			A1: emulated.ValueOf[emulated.BLS12377Fp](v.Y.A1),
+		},
+	}
+}
+
+func (g2 *G2) psi(q *G2Affine) *G2Affine {
+	x := g2.Ext2.Conjugate(&q.X)
+	x = g2.Ext2.MulByElement(x, g2.u)
+	y := g2.Ext2.Conjugate(&q.Y)
+	y = g2.Ext2.MulByElement(y, g2.v)
+
+	return &G2Affine{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// scalarMulBySeed computes [x₀]q where x₀=9586122913090633729 is the seed of
+// the curve.
+func (g2 *G2) scalarMulBySeed(q *G2Affine) *G2Affine {
+	// x₀ = 2⁶³ + 2⁵⁸ + 2⁵⁶ + 2⁵¹ + 2⁴⁷ + 2⁴⁶ + 1
+	z := g2.doubleN(q, 4)
+	z = g2.doubleAndAdd(z, q)
+	z = g2.double(z)
+	z = g2.doubleAndAdd(z, q)
+	z = g2.doubleN(z, 4)
+	z = g2.doubleAndAdd(z, q)
+	z = g2.doubleN(z, 3)
+	z = g2.doubleAndAdd(z, q)
+	z = g2.doubleAndAdd(z, q)
+	z = g2.doubleN(z, 45)
+	z = g2.doubleAndAdd(z, q)
+
+	return z
+}
+
+func (g2 *G2) double(p *G2Affine) *G2Affine {
+	// compute λ = (3p.x²)/2*p.y
+	xx3a := g2.Square(&p.X)
+	xx3a = g2.MulByConstElement(xx3a, big.NewInt(3))
+	y2 := g2.Double(&p.Y)
+	λ := g2.DivUnchecked(xx3a, y2)
+
+	// xr = λ²-2p.x
+	x2 := g2.Double(&p.X)
+	λλ := g2.Square(λ)
+	xr := g2.Sub(λλ, x2)
+
+	// yr = λ(p-xr) - p.y
+	pxrx := g2.Sub(&p.X, xr)
+	λpxrx := g2.Mul(λ, pxrx)
+	yr := g2.Sub(λpxrx, &p.Y)
+
+	return &G2Affine{
+		X: *xr,
+		Y: *yr,
+	}
+}
+
+func (g2 *G2) doubleN(p *G2Affine, n int) *G2Affine {
+	pn := p
+	for s := 0; s < n; s++ {
+		pn = g2.double(pn)
+	}
+	return pn
+}
+
+func (g2 G2) doubleAndAdd(p, q *G2Affine) *G2Affine {
+
+	// compute λ1 = (q.y-p.y)/(q.x-p.x)
+	yqyp := g2.Ext2.Sub(&q.Y, &p.Y)
+	xqxp := g2.Ext2.Sub(&q.X, &p.X)
+	λ1 := g2.Ext2.DivUnchecked(yqyp, xqxp)
+
+	// compute x2 = λ1²-p.x-q.x
+	λ1λ1 := g2.Ext2.Square(λ1)
+	xqxp = g2.Ext2.Add(&p.X, &q.X)
+	x2 := g2.Ext2.Sub(λ1λ1, xqxp)
+
+	// ommit y2 computation
+	// compute λ2 = -λ1-2*p.y/(x2-p.x)
+	ypyp := g2.Ext2.Add(&p.Y, &p.Y)
+	x2xp := g2.Ext2.Sub(x2, &p.X)
+	λ2 := g2.Ext2.DivUnchecked(ypyp, x2xp)
+	λ2 = g2.Ext2.Add(λ1, λ2)
+	λ2 = g2.Ext2.Neg(λ2)
+
+	// compute x3 =λ2²-p.x-x3
+	λ2λ2 := g2.Ext2.Square(λ2)
+	x3 := g2.Ext2.Sub(λ2λ2, &p.X)
+	x3 = g2.Ext2.Sub(x3, x2)
+
+	// compute y3 = λ2*(p.x - x3)-p.y
+	y3 := g2.Ext2.Sub(&p.X, x3)
+	y3 = g2.Ext2.Mul(λ2, y3)
+	y3 = g2.Ext2.Sub(y3, &p.Y)
+
+	return &G2Affine{
+		X: *x3,
+		Y: *y3,
+	}
+}
+
+// AssertIsEqual asserts that p and q are the same point.
+func (g2 *G2) AssertIsEqual(p, q *G2Affine) {
+	g2.Ext2.AssertIsEqual(&p.X, &q.X)
+	g2.Ext2.AssertIsEqual(&p.Y, &q.Y)
+}
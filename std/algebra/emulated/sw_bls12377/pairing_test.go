@@ -0,0 +1,302 @@
+package sw_bls12377
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+func randomG1G2Affines(assert *test.Assert) (bls12377.G1Affine, bls12377.G2Affine) {
+	_, _, G1AffGen, G2AffGen := bls12377.Generators()
+	mod := bls12377.ID.ScalarField()
+	s1, err := rand.Int(rand.Reader, mod)
+	assert.NoError(err)
+	s2, err := rand.Int(rand.Reader, mod)
+	assert.NoError(err)
+	var p bls12377.G1Affine
+	p.ScalarMultiplication(&G1AffGen, s1)
+	var q bls12377.G2Affine
+	q.ScalarMultiplication(&G2AffGen, s2)
+	return p, q
+}
+
+type FinalExponentiationCircuit struct {
+	InGt GTEl
+	Res  GTEl
+}
+
+func (c *FinalExponentiationCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	res1 := pairing.FinalExponentiation(&c.InGt)
+	pairing.AssertIsEqual(res1, &c.Res)
+	res2 := pairing.FinalExponentiationUnsafe(&c.InGt)
+	pairing.AssertIsEqual(res2, &c.Res)
+	return nil
+}
+
+func TestFinalExponentiationTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	var gt bls12377.GT
+	gt.SetRandom()
+	res := bls12377.FinalExponentiation(&gt)
+	witness := FinalExponentiationCircuit{
+		InGt: NewGTEl(gt),
+		Res:  NewGTEl(res),
+	}
+	err := test.IsSolved(&FinalExponentiationCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type PairCircuit struct {
+	InG1 G1Affine
+	InG2 G2Affine
+	Res  GTEl
+}
+
+func (c *PairCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnCurve(&c.InG1)
+	pairing.AssertIsOnTwist(&c.InG2)
+	res, err := pairing.Pair([]*G1Affine{&c.InG1}, []*G2Affine{&c.InG2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	pairing.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestPairTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, q := randomG1G2Affines(assert)
+	res, err := bls12377.Pair([]bls12377.G1Affine{p}, []bls12377.G2Affine{q})
+	assert.NoError(err)
+	witness := PairCircuit{
+		InG1: NewG1Affine(p),
+		InG2: NewG2Affine(q),
+		Res:  NewGTEl(res),
+	}
+	err = test.IsSolved(&PairCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type MultiPairCircuit struct {
+	In1G1 G1Affine
+	In2G1 G1Affine
+	In1G2 G2Affine
+	In2G2 G2Affine
+	Res   GTEl
+}
+
+func (c *MultiPairCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnCurve(&c.In1G1)
+	pairing.AssertIsOnCurve(&c.In2G1)
+	pairing.AssertIsOnTwist(&c.In1G2)
+	pairing.AssertIsOnTwist(&c.In2G2)
+	res, err := pairing.Pair([]*G1Affine{&c.In1G1, &c.In1G1, &c.In2G1, &c.In2G1}, []*G2Affine{&c.In1G2, &c.In2G2, &c.In1G2, &c.In2G2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	pairing.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestMultiPairTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p1, q1 := randomG1G2Affines(assert)
+	p2, q2 := randomG1G2Affines(assert)
+	res, err := bls12377.Pair([]bls12377.G1Affine{p1, p1, p2, p2}, []bls12377.G2Affine{q1, q2, q1, q2})
+	assert.NoError(err)
+	witness := MultiPairCircuit{
+		In1G1: NewG1Affine(p1),
+		In1G2: NewG2Affine(q1),
+		In2G1: NewG1Affine(p2),
+		In2G2: NewG2Affine(q2),
+		Res:   NewGTEl(res),
+	}
+	err = test.IsSolved(&MultiPairCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type PairingCheckCircuit struct {
+	In1G1 G1Affine
+	In2G1 G1Affine
+	In1G2 G2Affine
+	In2G2 G2Affine
+}
+
+func (c *PairingCheckCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	err = pairing.PairingCheck([]*G1Affine{&c.In1G1, &c.In1G1, &c.In2G1, &c.In2G1}, []*G2Affine{&c.In1G2, &c.In2G2, &c.In1G2, &c.In2G2})
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	return nil
+}
+
+func TestPairingCheckTestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p1, q1 := randomG1G2Affines(assert)
+	_, q2 := randomG1G2Affines(assert)
+	var p2 bls12377.G1Affine
+	p2.Neg(&p1)
+	witness := PairingCheckCircuit{
+		In1G1: NewG1Affine(p1),
+		In1G2: NewG2Affine(q1),
+		In2G1: NewG1Affine(p2),
+		In2G2: NewG2Affine(q2),
+	}
+	err := test.IsSolved(&PairingCheckCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type FinalExponentiationSafeCircuit struct {
+	P1, P2 G1Affine
+	Q1, Q2 G2Affine
+}
+
+func (c *FinalExponentiationSafeCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return err
+	}
+	res, err := pairing.MillerLoop([]*G1Affine{&c.P1, &c.P2}, []*G2Affine{&c.Q1, &c.Q2})
+	if err != nil {
+		return err
+	}
+	res2 := pairing.FinalExponentiation(res)
+	one := pairing.Ext12.One()
+	pairing.AssertIsEqual(one, res2)
+	return nil
+}
+
+func TestFinalExponentiationSafeCircuit(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, p1, q1 := bls12377.Generators()
+	var p2 bls12377.G1Affine
+	var q2 bls12377.G2Affine
+	p2.Neg(&p1)
+	q2.Set(&q1)
+	err := test.IsSolved(&FinalExponentiationSafeCircuit{}, &FinalExponentiationSafeCircuit{
+		P1: NewG1Affine(p1),
+		P2: NewG1Affine(p2),
+		Q1: NewG2Affine(q1),
+		Q2: NewG2Affine(q2),
+	}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type GroupMembershipCircuit struct {
+	InG1 G1Affine
+	InG2 G2Affine
+}
+
+func (c *GroupMembershipCircuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnG1(&c.InG1)
+	pairing.AssertIsOnG2(&c.InG2)
+	return nil
+}
+
+func TestGroupMembershipSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, q := randomG1G2Affines(assert)
+	witness := GroupMembershipCircuit{
+		InG1: NewG1Affine(p),
+		InG2: NewG2Affine(q),
+	}
+	err := test.IsSolved(&GroupMembershipCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type IsOnG1Circuit struct {
+	InG1 G1Affine
+}
+
+func (c *IsOnG1Circuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnG1(&c.InG1)
+	return nil
+}
+
+func TestIsOnG1Failure(t *testing.T) {
+	assert := test.NewAssert(t)
+	// find a point on the curve which is not in the prime order subgroup.
+	var p bls12377.G1Affine
+	var b fp.Element
+	b.SetOne()
+	for x := uint64(1); ; x++ {
+		var y2 fp.Element
+		p.X.SetUint64(x)
+		y2.Square(&p.X).Mul(&y2, &p.X).Add(&y2, &b)
+		if p.Y.Sqrt(&y2) != nil {
+			break
+		}
+	}
+	assert.True(p.IsOnCurve())
+	assert.False(p.IsInSubGroup())
+	err := test.IsSolved(&IsOnG1Circuit{}, &IsOnG1Circuit{InG1: NewG1Affine(p)}, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type IsOnG2Circuit struct {
+	InG2 G2Affine
+}
+
+func (c *IsOnG2Circuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnG2(&c.InG2)
+	return nil
+}
+
+func TestIsOnG2Failure(t *testing.T) {
+	assert := test.NewAssert(t)
+	// find a point on the twist which is not in the prime order subgroup.
+	_, _, _, g2 := bls12377.Generators()
+	// b' = y² - x³
+	var b, x3 bls12377.E2
+	b.Square(&g2.Y)
+	x3.Square(&g2.X).Mul(&x3, &g2.X)
+	b.Sub(&b, &x3)
+	var q bls12377.G2Affine
+	for x := uint64(1); ; x++ {
+		var y2 bls12377.E2
+		q.X.A0.SetUint64(x)
+		y2.Square(&q.X).Mul(&y2, &q.X).Add(&y2, &b)
+		if y2.Legendre() == 1 {
+			q.Y.Sqrt(&y2)
+			break
+		}
+	}
+	assert.True(q.IsOnCurve())
+	assert.False(q.IsInSubGroup())
+	err := test.IsSolved(&IsOnG2Circuit{}, &IsOnG2Circuit{InG2: NewG2Affine(q)}, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
 	"github.com/consensys/gnark/std/math/emulated"
@@ -18,6 +19,17 @@ func NewG1Affine(v bls12381.G1Affine) G1Affine {
 	}
 }
 
+// Scalar is the scalar in the groups. For G1 and G2 the scalar field is the same.
+type Scalar = emulated.Element[ScalarField]
+
+// ScalarField is the [emulated.FieldParams] implementation of the curve scalar field.
+type ScalarField = emulated.BLS12381Fr
+
+// NewScalar allocates a witness from the native scalar and returns it.
+func NewScalar(v fr_bls12381.Element) Scalar {
+	return emulated.ValueOf[ScalarField](v)
+}
+
 type G1 struct {
 	curveF *emulated.Field[emulated.BLS12381Fp]
 	w      *emulated.Element[emulated.BLS12381Fp]
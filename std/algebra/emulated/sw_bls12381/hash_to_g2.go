@@ -0,0 +1,202 @@
+package sw_bls12381
+
+import (
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bls12381"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// constants of the simplified SWU map to the curve E2' isogenous to G2 and of
+// the 3-isogeny E2' → E2 as defined in RFC 9380 Section 8.8.2 and Appendix
+// E.3. The isogeny coefficients are given in increasing degree order.
+var (
+	sswuA = [2]string{"0", "240"}
+	sswuB = [2]string{"1012", "1012"}
+	sswuZ = [2]string{
+		"4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559785",
+		"4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559786",
+	}
+
+	isoXNum = [][2]string{
+		{"889424345604814976315064405719089812568196182208668418962679585805340366775741747653930584250892369786198727235542", "889424345604814976315064405719089812568196182208668418962679585805340366775741747653930584250892369786198727235542"},
+		{"0", "2668273036814444928945193217157269437704588546626005256888038757416021100327225242961791752752677109358596181706522"},
+		{"2668273036814444928945193217157269437704588546626005256888038757416021100327225242961791752752677109358596181706526", "1334136518407222464472596608578634718852294273313002628444019378708010550163612621480895876376338554679298090853261"},
+		{"3557697382419259905260257622876359250272784728834673675850718343221361467102966990615722337003569479144794908942033", "0"},
+	}
+	isoXDen = [][2]string{
+		{"0", "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559715"},
+		{"12", "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559775"},
+	}
+	isoYNum = [][2]string{
+		{"3261222600550988246488569487636662646083386001431784202863158481286248011511053074731078808919938689216061999863558", "3261222600550988246488569487636662646083386001431784202863158481286248011511053074731078808919938689216061999863558"},
+		{"0", "889424345604814976315064405719089812568196182208668418962679585805340366775741747653930584250892369786198727235518"},
+		{"2668273036814444928945193217157269437704588546626005256888038757416021100327225242961791752752677109358596181706524", "1334136518407222464472596608578634718852294273313002628444019378708010550163612621480895876376338554679298090853263"},
+		{"2816510427748580758331037284777117739799287910327449993381818688383577828123182200904113516794492504322962636245776", "0"},
+	}
+	isoYDen = [][2]string{
+		{"4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559355", "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559355"},
+		{"0", "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559571"},
+		{"18", "4002409555221667393417789825735904156556882819939007885332058136124031650490837864442687629129015664037894272559769"},
+	}
+)
+
+func newE2(v [2]string) *fields_bls12381.E2 {
+	return &fields_bls12381.E2{
+		A0: emulated.ValueOf[emulated.BLS12381Fp](v[0]),
+		A1: emulated.ValueOf[emulated.BLS12381Fp](v[1]),
+	}
+}
+
+func newE2Slice(v [][2]string) []*fields_bls12381.E2 {
+	res := make([]*fields_bls12381.E2, len(v))
+	for i := range v {
+		res[i] = newE2(v[i])
+	}
+	return res
+}
+
+// MapToG2 maps the field elements u0 and u1 to a point in G2 as in the
+// hash_to_curve procedure of RFC 9380 Section 3 for the suite
+// BLS12381G2_XMD:SHA-256_SSWU_RO_. The elements u0 and u1 are the outputs of
+// hash_to_field.
+//
+// Both elements are mapped to the isogenous curve using the simplified SWU map,
+// mapped to the twist using the 3-isogeny, added and finally the cofactor is
+// cleared.
+func (g2 *G2) MapToG2(u0, u1 *fields_bls12381.E2) *G2Affine {
+	q0 := g2.isogeny(g2.mapToCurve2(u0))
+	q1 := g2.isogeny(g2.mapToCurve2(u1))
+	q := g2.add(q0, q1)
+	return g2.clearCofactor(q)
+}
+
+// mapToCurve2 implements the simplified SWU map to the curve E2' as defined in
+// RFC 9380 Section 6.6.2.
+//
+// The square root is computed in a hint and we only check that it is the
+// square root of either gx1 or gx2. This is sound as Z is not a square and
+// gx2 = Z³u⁶gx1, so that exactly one of gx1 and gx2 is a square.
+func (g2 *G2) mapToCurve2(u *fields_bls12381.E2) *G2Affine {
+	a := newE2(sswuA)
+	b := newE2(sswuB)
+	z := newE2(sswuZ)
+
+	// c1 = -B/A and c2 = B/(Z*A) are constants computed out of circuit.
+	var nA, nB, nZ, c1, c2 bls12381.E2
+	nA.A0.SetString(sswuA[0])
+	nA.A1.SetString(sswuA[1])
+	nB.A0.SetString(sswuB[0])
+	nB.A1.SetString(sswuB[1])
+	nZ.A0.SetString(sswuZ[0])
+	nZ.A1.SetString(sswuZ[1])
+	c1.Inverse(&nA).Mul(&c1, &nB).Neg(&c1)
+	c2.Mul(&nZ, &nA).Inverse(&c2).Mul(&c2, &nB)
+	c1El := fields_bls12381.FromE2(&c1)
+	c2El := fields_bls12381.FromE2(&c2)
+
+	// tv1 = Z²u⁴ + Zu²
+	zu2 := g2.Ext2.Mul(z, g2.Ext2.Square(u))
+	tv1 := g2.Ext2.Add(g2.Ext2.Square(zu2), zu2)
+	// x1 = (-B/A) * (1 + 1/tv1), or B/(Z*A) if tv1 = 0
+	tv1IsZero := g2.Ext2.IsZero(tv1)
+	den := g2.Ext2.Select(tv1IsZero, g2.Ext2.One(), tv1)
+	num := g2.Ext2.Mul(&c1El, g2.Ext2.Add(den, g2.Ext2.One()))
+	x1 := g2.Ext2.DivUnchecked(num, den)
+	x1 = g2.Ext2.Select(tv1IsZero, &c2El, x1)
+	gx1 := g2.evalCurve(a, b, x1)
+	// x2 = Zu² * x1
+	x2 := g2.Ext2.Mul(zu2, x1)
+	gx2 := g2.evalCurve(a, b, x2)
+
+	res, err := g2.fp.NewHint(sswuSqrtHint, 2, &gx1.A0, &gx1.A1, &gx2.A0, &gx2.A1, &u.A0, &u.A1)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+	y := &fields_bls12381.E2{A0: *res[0], A1: *res[1]}
+
+	// y² = gx1 if gx1 is square and y² = gx2 otherwise
+	yy := g2.Ext2.Square(y)
+	isGx1 := g2.Ext2.IsZero(g2.Ext2.Sub(yy, gx1))
+	g2.Ext2.AssertIsEqual(yy, g2.Ext2.Select(isGx1, gx1, gx2))
+	x := g2.Ext2.Select(isGx1, x1, x2)
+
+	// sgn0(u) = sgn0(y)
+	g2.api.AssertIsEqual(g2.Ext2.Sgn0(u), g2.Ext2.Sgn0(y))
+
+	return &G2Affine{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// evalCurve returns x³ + ax + b.
+func (g2 *G2) evalCurve(a, b, x *fields_bls12381.E2) *fields_bls12381.E2 {
+	res := g2.Ext2.Mul(g2.Ext2.Square(x), x)
+	res = g2.Ext2.Add(res, g2.Ext2.Mul(a, x))
+	return g2.Ext2.Add(res, b)
+}
+
+// isogeny maps the point p on the curve E2' to the twist E2 using the 3-isogeny
+// defined in RFC 9380 Appendix E.3.
+func (g2 *G2) isogeny(p *G2Affine) *G2Affine {
+	xNum := g2.evalPolynomial(false, newE2Slice(isoXNum), &p.X)
+	xDen := g2.evalPolynomial(true, newE2Slice(isoXDen), &p.X)
+	yNum := g2.evalPolynomial(false, newE2Slice(isoYNum), &p.X)
+	yDen := g2.evalPolynomial(true, newE2Slice(isoYDen), &p.X)
+
+	x := g2.Ext2.DivUnchecked(xNum, xDen)
+	y := g2.Ext2.DivUnchecked(g2.Ext2.Mul(&p.Y, yNum), yDen)
+
+	return &G2Affine{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// evalPolynomial evaluates the polynomial with the given coefficients in
+// increasing degree order at x using the Horner's method. If monic is set,
+// then the leading coefficient 1 is omitted from coefficients.
+func (g2 *G2) evalPolynomial(monic bool, coefficients []*fields_bls12381.E2, x *fields_bls12381.E2) *fields_bls12381.E2 {
+	dst := coefficients[len(coefficients)-1]
+	if monic {
+		dst = g2.Ext2.Add(dst, x)
+	}
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		dst = g2.Ext2.Mul(dst, x)
+		dst = g2.Ext2.Add(dst, coefficients[i])
+	}
+	return dst
+}
+
+// clearCofactor maps the point q on the twist to G2 by multiplying it by the
+// effective cofactor h_eff as defined in RFC 9380 Section 8.8.2. It uses the
+// method of Budroni and Pintore:
+//
+//	h_eff * q = [x²-x-1]q + ψ([x-1]q) + ψ²([2]q)
+//
+// where x is the (negative) seed of the curve.
+//
+// See https://eprint.iacr.org/2017/419.pdf, Section 4.1.
+func (g2 *G2) clearCofactor(q *G2Affine) *G2Affine {
+	// [x]q and [x²]q
+	xq := g2.scalarMulBySeed(q)
+	xxq := g2.scalarMulBySeed(xq)
+
+	// [x²-x-1]q
+	res := g2.sub(xxq, xq)
+	res = g2.sub(res, q)
+
+	// ψ([x-1]q)
+	t := g2.sub(xq, q)
+	t = g2.psi(t)
+	res = g2.add(res, t)
+
+	// ψ²([2]q) = -(w * X([2]q), Y([2]q)) where w is a primitive cube root of
+	// unity in Fp.
+	t = g2.double(q)
+	t.X = *g2.Ext2.MulByElement(&t.X, g2.w)
+	res = g2.sub(res, t)
+
+	return res
+}
@@ -0,0 +1,140 @@
+package sw_bls12381
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/r1cs"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bls12381"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type mapToG2Circuit struct {
+	U0, U1 fields_bls12381.E2
+	Res    G2Affine
+}
+
+func (c *mapToG2Circuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	res := g2.MapToG2(&c.U0, &c.U1)
+	g2.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestMapToG2TestSolve(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("abc")
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+	u, err := fp.Hash(msg, dst, 4)
+	assert.NoError(err)
+	res, err := bls12381.HashToG2(msg, dst)
+	assert.NoError(err)
+	witness := mapToG2Circuit{
+		U0: fields_bls12381.E2{
+			A0: emulated.ValueOf[emulated.BLS12381Fp](u[0]),
+			A1: emulated.ValueOf[emulated.BLS12381Fp](u[1]),
+		},
+		U1: fields_bls12381.E2{
+			A0: emulated.ValueOf[emulated.BLS12381Fp](u[2]),
+			A1: emulated.ValueOf[emulated.BLS12381Fp](u[3]),
+		},
+		Res: NewG2Affine(res),
+	}
+	err = test.IsSolved(&mapToG2Circuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type mapToG2WrongCircuit struct {
+	U0, U1 fields_bls12381.E2
+	Res    G2Affine
+}
+
+func (c *mapToG2WrongCircuit) Define(api frontend.API) error {
+	g2 := NewG2(api)
+	res := g2.MapToG2(&c.U0, &c.U1)
+	api.AssertIsEqual(g2.Ext2.IsZero(g2.Ext2.Sub(&res.X, &c.Res.X)), 0)
+	return nil
+}
+
+func TestMapToG2NonCanonicalY(t *testing.T) {
+	assert := test.NewAssert(t)
+	var fpParams emulated.BLS12381Fp
+	p := fpParams.Modulus()
+	nbBits, nbLimbs := int(fpParams.BitsPerLimb()), int(fpParams.NbLimbs())
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+	// find u0 such that the A0 coordinate of -y is smaller than 2^381-p, so
+	// that -y.A0+p still fits the width of the hint output.
+	var u []fp.Element
+	for ctr := 0; ; ctr++ {
+		var err error
+		u, err = fp.Hash([]byte{byte(ctr)}, dst, 4)
+		assert.NoError(err)
+		q := bls12381.MapToCurve2(&bls12381.E2{A0: u[0], A1: u[1]})
+		var negY bls12381.E2
+		negY.Neg(&q.Y)
+		a0 := negY.A0.BigInt(new(big.Int))
+		if a0.Sign() != 0 && a0.Add(a0, p).BitLen() <= p.BitLen() {
+			break
+		}
+	}
+	// maliciousHint returns -y with A0+p instead of A0. A0+p has the opposite
+	// parity, so sgn0(-y) would be equal to sgn0(u) if A0 was not checked
+	// to be canonical.
+	maliciousHint := func(nativeMod *big.Int, inputs, outputs []*big.Int) error {
+		if err := sswuSqrtHint(nativeMod, inputs, outputs); err != nil {
+			return err
+		}
+		recompose := func(limbs []*big.Int) *big.Int {
+			res := new(big.Int)
+			for i := len(limbs) - 1; i >= 0; i-- {
+				res.Lsh(res, uint(nbBits)).Add(res, limbs[i])
+			}
+			return res
+		}
+		decompose := func(v *big.Int, limbs []*big.Int) {
+			mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(nbBits)), big.NewInt(1))
+			for i := range limbs {
+				limbs[i].And(v, mask)
+				v.Rsh(v, uint(nbBits))
+			}
+		}
+		a0 := recompose(outputs[:nbLimbs])
+		a1 := recompose(outputs[nbLimbs:])
+		a0.Sub(p, a0).Mod(a0, p)
+		a1.Sub(p, a1).Mod(a1, p)
+		if a0.Sign() != 0 && new(big.Int).Add(a0, p).BitLen() <= p.BitLen() {
+			a0.Add(a0, p)
+			decompose(a0, outputs[:nbLimbs])
+			decompose(a1, outputs[nbLimbs:])
+		}
+		return nil
+	}
+
+	res := bls12381.MapToG2(bls12381.E2{A0: u[0], A1: u[1]})
+	res2 := bls12381.MapToG2(bls12381.E2{A0: u[2], A1: u[3]})
+	res.Add(&res, &res2)
+	witness := mapToG2WrongCircuit{
+		U0: fields_bls12381.E2{
+			A0: emulated.ValueOf[emulated.BLS12381Fp](u[0]),
+			A1: emulated.ValueOf[emulated.BLS12381Fp](u[1]),
+		},
+		U1: fields_bls12381.E2{
+			A0: emulated.ValueOf[emulated.BLS12381Fp](u[2]),
+			A1: emulated.ValueOf[emulated.BLS12381Fp](u[3]),
+		},
+		Res: NewG2Affine(res),
+	}
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &mapToG2WrongCircuit{})
+	assert.NoError(err)
+	w, err := frontend.NewWitness(&witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	_, err = ccs.Solve(w, solver.OverrideHint(solver.GetHintID(sswuSqrtHint), maliciousHint))
+	t.Log(err)
+	assert.Error(err)
+}
@@ -0,0 +1,62 @@
+package sw_bls12381
+
+import (
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{
+		sswuSqrtHint,
+	}
+}
+
+// sswuSqrtHint computes the square root used in the simplified SWU map. It
+// returns a square root of gx1 if gx1 is a square and a square root of gx2
+// otherwise. The sign of the returned root matches the sign of u.
+func sswuSqrtHint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) error {
+	return emulated.UnwrapHint(nativeInputs, nativeOutputs,
+		func(mod *big.Int, inputs, outputs []*big.Int) error {
+			var gx1, gx2, u, y bls12381.E2
+
+			gx1.A0.SetBigInt(inputs[0])
+			gx1.A1.SetBigInt(inputs[1])
+			gx2.A0.SetBigInt(inputs[2])
+			gx2.A1.SetBigInt(inputs[3])
+			u.A0.SetBigInt(inputs[4])
+			u.A1.SetBigInt(inputs[5])
+
+			if gx1.Legendre() != -1 {
+				y.Sqrt(&gx1)
+			} else {
+				y.Sqrt(&gx2)
+			}
+			if sgn0E2(&y) != sgn0E2(&u) {
+				y.Neg(&y)
+			}
+
+			y.A0.BigInt(outputs[0])
+			y.A1.BigInt(outputs[1])
+
+			return nil
+		})
+}
+
+// sgn0E2 returns the sign of z as defined in RFC 9380 Section 4.1.
+func sgn0E2(z *bls12381.E2) uint {
+	var a0, a1 big.Int
+	z.A0.BigInt(&a0)
+	z.A1.BigInt(&a1)
+	if a0.Sign() == 0 {
+		return a1.Bit(0)
+	}
+	return a0.Bit(0)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/test"
 )
@@ -228,3 +229,36 @@ func TestGroupMembershipSolve(t *testing.T) {
 	err := test.IsSolved(&GroupMembershipCircuit{}, &witness, ecc.BN254.ScalarField())
 	assert.NoError(err)
 }
+
+type IsOnG1Circuit struct {
+	InG1 G1Affine
+}
+
+func (c *IsOnG1Circuit) Define(api frontend.API) error {
+	pairing, err := NewPairing(api)
+	if err != nil {
+		return fmt.Errorf("new pairing: %w", err)
+	}
+	pairing.AssertIsOnG1(&c.InG1)
+	return nil
+}
+
+func TestIsOnG1Failure(t *testing.T) {
+	assert := test.NewAssert(t)
+	// find a point on the curve which is not in the prime order subgroup.
+	var p bls12381.G1Affine
+	var b fp.Element
+	b.SetUint64(4)
+	for x := uint64(1); ; x++ {
+		var y2 fp.Element
+		p.X.SetUint64(x)
+		y2.Square(&p.X).Mul(&y2, &p.X).Add(&y2, &b)
+		if p.Y.Sqrt(&y2) != nil {
+			break
+		}
+	}
+	assert.True(p.IsOnCurve())
+	assert.False(p.IsInSubGroup())
+	err := test.IsSolved(&IsOnG1Circuit{}, &IsOnG1Circuit{InG1: NewG1Affine(p)}, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
@@ -2,6 +2,7 @@ package sw_bn254
 
 import (
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
 	"github.com/consensys/gnark/std/math/emulated"
 )
@@ -14,3 +15,14 @@ func NewG1Affine(v bn254.G1Affine) G1Affine {
 		Y: emulated.ValueOf[emulated.BN254Fp](v.Y),
 	}
 }
+
+// Scalar is the scalar in the groups. For G1 and G2 the scalar field is the same.
+type Scalar = emulated.Element[ScalarField]
+
+// ScalarField is the [emulated.FieldParams] implementation of the curve scalar field.
+type ScalarField = emulated.BN254Fr
+
+// NewScalar allocates a witness from the native scalar and returns it.
+func NewScalar(v fr_bn254.Element) Scalar {
+	return emulated.ValueOf[ScalarField](v)
+}
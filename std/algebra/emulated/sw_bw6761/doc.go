@@ -0,0 +1,8 @@
+// Package sw_bw6761 implements G1 and G2 arithmetics and pairing computation over BW6-761 curve.
+//
+// The implementation follows [Housni22]: "Pairings in Rank-1 Constraint Systems"
+// and [Housni21]: "Optimized and secure pairing-friendly elliptic curves suitable for one layer proof composition".
+//
+// [Housni22]: https://eprint.iacr.org/2022/1162
+// [Housni21]: https://eprint.iacr.org/2020/351
+package sw_bw6761
@@ -0,0 +1,28 @@
+package sw_bw6761
+
+import (
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	fr_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type G1Affine = sw_emulated.AffinePoint[emulated.BW6761Fp]
+
+func NewG1Affine(v bw6761.G1Affine) G1Affine {
+	return G1Affine{
+		X: emulated.ValueOf[emulated.BW6761Fp](v.X),
+		Y: emulated.ValueOf[emulated.BW6761Fp](v.Y),
+	}
+}
+
+// Scalar is the scalar in the groups. For G1 and G2 the scalar field is the same.
+type Scalar = emulated.Element[ScalarField]
+
+// ScalarField is the [emulated.FieldParams] implementation of the curve scalar field.
+type ScalarField = emulated.BW6761Fr
+
+// NewScalar allocates a witness from the native scalar and returns it.
+func NewScalar(v fr_bw6761.Element) Scalar {
+	return emulated.ValueOf[ScalarField](v)
+}
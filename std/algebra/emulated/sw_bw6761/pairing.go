@@ -0,0 +1,596 @@
+package sw_bw6761
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bw6761"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+type baseEl = emulated.Element[emulated.BW6761Fp]
+
+type Pairing struct {
+	api frontend.API
+	*fields_bw6761.Ext6
+	curveF         *emulated.Field[emulated.BW6761Fp]
+	curve          *sw_emulated.Curve[emulated.BW6761Fp, emulated.BW6761Fr]
+	bTwist         *baseEl
+	thirdRootOneG1 *baseEl
+	thirdRootOneG2 *baseEl
+}
+
+type GTEl = fields_bw6761.E6
+
+func NewGTEl(v bw6761.GT) GTEl {
+	return GTEl{
+		B0: fields_bw6761.E3{
+			A0: emulated.ValueOf[emulated.BW6761Fp](v.B0.A0),
+			A1: emulated.ValueOf[emulated.BW6761Fp](v.B0.A1),
+			A2: emulated.ValueOf[emulated.BW6761Fp](v.B0.A2),
+		},
+		B1: fields_bw6761.E3{
+			A0: emulated.ValueOf[emulated.BW6761Fp](v.B1.A0),
+			A1: emulated.ValueOf[emulated.BW6761Fp](v.B1.A1),
+			A2: emulated.ValueOf[emulated.BW6761Fp](v.B1.A2),
+		},
+	}
+}
+
+func NewPairing(api frontend.API) (*Pairing, error) {
+	ba, err := emulated.NewField[emulated.BW6761Fp](api)
+	if err != nil {
+		return nil, fmt.Errorf("new base api: %w", err)
+	}
+	curve, err := sw_emulated.New[emulated.BW6761Fp, emulated.BW6761Fr](api, sw_emulated.GetBW6761Params())
+	if err != nil {
+		return nil, fmt.Errorf("new curve: %w", err)
+	}
+	bTwist := emulated.ValueOf[emulated.BW6761Fp](4)
+	thirdRootOneG1 := emulated.ValueOf[emulated.BW6761Fp]("1968985824090209297278610739700577151397666382303825728450741611566800370218827257750865013421937292370006175842381275743914023380727582819905021229583192207421122272650305267822868639090213645505120388400344940985710520836292650")
+	thirdRootOneG2 := emulated.ValueOf[emulated.BW6761Fp]("4922464560225523242118178942575080391082002530232324381063048548642823052024664478336818169867474395270858391911405337707247735739826664939444490469542109391530482826728203582549674992333383150446779312029624171857054392282775648")
+	return &Pairing{
+		api:            api,
+		Ext6:           fields_bw6761.NewExt6(api),
+		curveF:         ba,
+		curve:          curve,
+		bTwist:         &bTwist,
+		thirdRootOneG1: &thirdRootOneG1,
+		thirdRootOneG2: &thirdRootOneG2,
+	}, nil
+}
+
+// FinalExponentiation computes the exponentiation zᵈ where
+//
+//	d = (p⁶-1)/r = (p⁶-1)/Φ₆(p) ⋅ Φ₆(p)/r = (p³-1)(p+1)(p²-p+1)/r
+//
+// we use instead
+//
+//	d = s ⋅ (p³-1)(p+1)(p²-p+1)/r
+//
+// where s is the cofactor 12(x₀+1) (El Housni and Guillevic).
+//
+// This is the safe version of the method where e may be {-1,1}. If it is known
+// that e ≠ {-1,1} then using the unsafe version of the method saves
+// considerable amount of constraints. When called with the result of
+// [MillerLoop], then current method is applicable when length of the inputs to
+// Miller loop is 1.
+func (pr Pairing) FinalExponentiation(e *GTEl) *GTEl {
+	return pr.finalExponentiation(e, false)
+}
+
+// FinalExponentiationUnsafe computes the exponentiation zᵈ where
+//
+//	d = (p⁶-1)/r = (p⁶-1)/Φ₆(p) ⋅ Φ₆(p)/r = (p³-1)(p+1)(p²-p+1)/r
+//
+// we use instead
+//
+//	d = s ⋅ (p³-1)(p+1)(p²-p+1)/r
+//
+// where s is the cofactor 12(x₀+1) (El Housni and Guillevic).
+//
+// This is the unsafe version of the method where e may NOT be {-1,1}. If e ∈
+// {-1, 1}, then there exists no valid solution to the circuit. This method is
+// applicable when called with the result of [MillerLoop] method when the length
+// of the inputs to Miller loop is 1.
+func (pr Pairing) FinalExponentiationUnsafe(e *GTEl) *GTEl {
+	return pr.finalExponentiation(e, true)
+}
+
+// finalExponentiation computes the exponentiation zᵈ where
+//
+//	d = (p⁶-1)/r = (p⁶-1)/Φ₆(p) ⋅ Φ₆(p)/r = (p³-1)(p+1)(p²-p+1)/r
+//
+// we use instead
+//
+//	d = s ⋅ (p³-1)(p+1)(p²-p+1)/r
+//
+// where s is the cofactor 12(x₀+1) (El Housni and Guillevic).
+func (pr Pairing) finalExponentiation(e *GTEl, unsafe bool) *GTEl {
+
+	// 1. Easy part
+	// (p³-1)(p+1)
+	var selector1, selector2 frontend.Variable
+	_dummy := pr.Ext3.One()
+
+	if unsafe {
+		// The Miller loop result is ≠ {-1,1}, otherwise this means P and Q are
+		// linearly dependant and not from G1 and G2 respectively.
+		// So e ∈ G_{q,2} \ {-1,1} and hence e.B1 ≠ 0.
+		// Nothing to do.
+	} else {
+		// However, for a product of Miller loops (n>=2) this might happen.  If this is
+		// the case, the result is 1 in the torus. We assign a dummy value (1) to e.B1
+		// and proceed further.
+		selector1 = pr.Ext3.IsZero(&e.B1)
+		e.B1 = *pr.Ext3.Select(selector1, _dummy, &e.B1)
+	}
+
+	// Torus compression absorbed:
+	// Raising e to (p³-1) is
+	// e^(p³) / e = (e.B0 - w*e.B1) / (e.B0 + w*e.B1)
+	//            = (-e.B0/e.B1 + w) / (-e.B0/e.B1 - w)
+	// So the fraction -e.B0/e.B1 is already in the torus.
+	// This absorbs the torus compression in the easy part.
+	c := pr.Ext3.DivUnchecked(&e.B0, &e.B1)
+	c = pr.Ext3.Neg(c)
+	t0 := pr.FrobeniusTorus(c)
+	c = pr.MulTorus(t0, c)
+
+	// 2. Hard part (up to permutation)
+	// El Housni and Guillevic
+	// https://eprint.iacr.org/2020/351.pdf
+	// performed in torus compressed form
+	m1 := pr.ExptTorus(c)
+	_m1 := pr.InverseTorus(m1)
+	m2 := pr.ExptTorus(m1)
+	_m2 := pr.InverseTorus(m2)
+	m3 := pr.ExptTorus(m2)
+	f0 := pr.FrobeniusTorus(c)
+	f0 = pr.MulTorus(f0, c)
+	f0 = pr.MulTorus(f0, m2)
+	m2 = pr.SquareTorus(_m1)
+	f0 = pr.MulTorus(f0, m2)
+	f0_36 := pr.SquareTorus(f0)
+	f0_36 = pr.SquareTorus(f0_36)
+	f0_36 = pr.SquareTorus(f0_36)
+	f0_36 = pr.MulTorus(f0_36, f0)
+	f0_36 = pr.SquareTorus(f0_36)
+	f0_36 = pr.SquareTorus(f0_36)
+	g0 := pr.MulTorus(c, m1)
+	g0 = pr.FrobeniusTorus(g0)
+	g0 = pr.MulTorus(g0, m3)
+	g0 = pr.MulTorus(g0, _m2)
+	g0 = pr.MulTorus(g0, _m1)
+	g1 := pr.ExptTorus(g0)
+	_g1 := pr.InverseTorus(g1)
+	g2 := pr.ExptTorus(g1)
+	g3 := pr.ExptTorus(g2)
+	_g3 := pr.InverseTorus(g3)
+	g4 := pr.ExptTorus(g3)
+	_g4 := pr.InverseTorus(g4)
+	g5 := pr.ExptTorus(g4)
+	_g5 := pr.InverseTorus(g5)
+	g6 := pr.ExptTorus(g5)
+	gA := pr.MulTorus(g3, _g5)
+	gA = pr.SquareTorus(gA)
+	gA = pr.MulTorus(gA, g6)
+	gA = pr.MulTorus(gA, g1)
+	gA = pr.MulTorus(gA, g0)
+	g034 := pr.MulTorus(g0, g3)
+	g034 = pr.MulTorus(g034, _g4)
+	gB := pr.SquareTorus(g034)
+	gB = pr.MulTorus(gB, g034)
+	gB = pr.MulTorus(gB, g5)
+	gB = pr.MulTorus(gB, _g1)
+	_g1g2 := pr.MulTorus(_g1, g2)
+	gC := pr.MulTorus(_g3, _g1g2)
+	gC = pr.SquareTorus(gC)
+	gC = pr.MulTorus(gC, _g1g2)
+	gC = pr.MulTorus(gC, g0)
+	gC = pr.SquareTorus(gC)
+	gC = pr.MulTorus(gC, g2)
+	gC = pr.MulTorus(gC, g0)
+	gC = pr.MulTorus(gC, g4)
+
+	// ht, hy = 13, 9
+	// c1 = ht**2+3*hy**2 = 412
+	h1 := pr.Expc1Torus(gA)
+	// c2 = ht+hy = 22
+	h2 := pr.Expc2Torus(gB)
+	h2g2C := pr.SquareTorus(gC)
+	h2g2C = pr.MulTorus(h2g2C, h2)
+	h4 := pr.SquareTorus(h2g2C)
+	h4 = pr.MulTorus(h4, h2g2C)
+	h4 = pr.SquareTorus(h4)
+	c = pr.MulTorus(h1, h4)
+
+	var result GTEl
+	// MulTorus(c, f0_36) requires c ≠ -f0_36. When c = -f0_36, it means the
+	// product is 1 in the torus.
+	if unsafe {
+		// For a single pairing, this does not happen because the pairing is non-degenerate.
+		result = *pr.DecompressTorus(pr.MulTorus(c, f0_36))
+	} else {
+		// For a product of pairings this might happen when the result is expected to be 1.
+		// We assign a dummy value (1) to f0_36 and proceed furhter.
+		// Finally we do a select on both edge cases:
+		//   - Only if seletor1=0 and selector2=0, we return MulTorus(c, f0_36) decompressed.
+		//   - Otherwise, we return 1.
+		_sum := pr.Ext3.Add(c, f0_36)
+		selector2 = pr.Ext3.IsZero(_sum)
+		f0_36 = pr.Ext3.Select(selector2, _dummy, f0_36)
+		selector := pr.api.Mul(pr.api.Sub(1, selector1), pr.api.Sub(1, selector2))
+		result = *pr.Select(selector, pr.DecompressTorus(pr.MulTorus(c, f0_36)), pr.One())
+	}
+
+	return &result
+}
+
+// lineEvaluation represents a sparse Fp6 Elmt (result of the line evaluation)
+// line: 1 + R0(x/y) + R1(1/y) = 0 instead of R0'*y + R1'*x + R2' = 0 This
+// makes the multiplication by lines (MulBy034) circuit-efficient.
+type lineEvaluation struct {
+	R0, R1 baseEl
+}
+
+// Pair calculates the reduced pairing for a set of points
+// ∏ᵢ e(Pᵢ, Qᵢ).
+//
+// This function doesn't check that the inputs are in the correct subgroups.
+func (pr Pairing) Pair(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
+	res, err := pr.MillerLoop(P, Q)
+	if err != nil {
+		return nil, fmt.Errorf("miller loop: %w", err)
+	}
+	res = pr.finalExponentiation(res, len(P) == 1)
+	return res, nil
+}
+
+// PairingCheck calculates the reduced pairing for a set of points and asserts if the result is One
+// ∏ᵢ e(Pᵢ, Qᵢ) =? 1
+//
+// This function doesn't check that the inputs are in the correct subgroups.
+func (pr Pairing) PairingCheck(P []*G1Affine, Q []*G2Affine) error {
+	f, err := pr.Pair(P, Q)
+	if err != nil {
+		return err
+
+	}
+	one := pr.One()
+	pr.AssertIsEqual(f, one)
+
+	return nil
+}
+
+func (pr Pairing) AssertIsEqual(x, y *GTEl) {
+	pr.Ext6.AssertIsEqual(x, y)
+}
+
+func (pr Pairing) AssertIsOnCurve(P *G1Affine) {
+	pr.curve.AssertIsOnCurve(P)
+}
+
+func (pr Pairing) AssertIsOnTwist(Q *G2Affine) {
+	// Twist: Y² == X³ + aX + b, where a=0 and b=4
+	// (X,Y) ∈ {Y² == X³ + aX + b} U (0,0)
+
+	// if Q=(0,0) we assign b=0 otherwise 4, and continue
+	selector := pr.api.And(pr.curveF.IsZero(&Q.X), pr.curveF.IsZero(&Q.Y))
+
+	b := pr.curveF.Select(selector, pr.curveF.Zero(), pr.bTwist)
+
+	left := pr.curveF.Mul(&Q.Y, &Q.Y)
+	right := pr.curveF.Mul(&Q.X, &Q.X)
+	right = pr.curveF.Mul(right, &Q.X)
+	right = pr.curveF.Add(right, b)
+	pr.curveF.AssertIsEqual(left, right)
+}
+
+func (pr Pairing) AssertIsOnG1(P *G1Affine) {
+	// 1- Check P is on the curve
+	pr.AssertIsOnCurve(P)
+
+	// 2- Check P has the right subgroup order
+	// ϕ(P) = (ωx, y)
+	phiP := &G1Affine{
+		X: *pr.curveF.Mul(&P.X, pr.thirdRootOneG1),
+		Y: P.Y,
+	}
+	pr.assertIsInSubgroup(P, phiP)
+}
+
+func (pr Pairing) AssertIsOnG2(Q *G2Affine) {
+	// 1- Check Q is on the curve
+	pr.AssertIsOnTwist(Q)
+
+	// 2- Check Q has the right subgroup order
+	// ϕ(Q) = (ω²x, y)
+	phiQ := &G2Affine{
+		X: *pr.curveF.Mul(&Q.X, pr.thirdRootOneG2),
+		Y: Q.Y,
+	}
+	pr.assertIsInSubgroup(Q, phiQ)
+}
+
+// assertIsInSubgroup asserts that the point P with the endomorphism image
+// phiP=ϕ(P) is in the prime order subgroup, i.e. that
+//
+//	[x₀+1]P + [x₀³-x₀²+1]ϕ(P) == 0
+//
+// The curve and its twist are both defined over 𝔽p with a=0, so the group law
+// formulas of the curve apply to the points of both G1 and G2.
+func (pr Pairing) assertIsInSubgroup(P, phiP *G1Affine) {
+	// [x₀³-x₀²+1]ϕ(P)
+	res := pr.scalarMulBySeed(phiP)
+	res = pr.curve.Add(res, pr.curve.Neg(phiP))
+	res = pr.scalarMulBySeed(res)
+	res = pr.scalarMulBySeed(res)
+	res = pr.curve.Add(res, phiP)
+
+	// [x₀+1]P
+	xP := pr.scalarMulBySeed(P)
+	xP = pr.curve.Add(xP, P)
+
+	// [r]P == 0 <==> [x₀+1]P == -[x₀³-x₀²+1]ϕ(P)
+	pr.curve.AssertIsEqual(xP, pr.curve.Neg(res))
+}
+
+// scalarMulBySeed computes [x₀]q where x₀=9586122913090633729 is the seed of
+// the curve.
+func (pr Pairing) scalarMulBySeed(q *G1Affine) *G1Affine {
+	// x₀ = 2⁶³ + 2⁵⁸ + 2⁵⁶ + 2⁵¹ + 2⁴⁷ + 2⁴⁶ + 1
+	z := pr.doubleN(q, 4)
+	z = pr.curve.Add(pr.curve.Double(z), q)
+	z = pr.curve.Double(z)
+	z = pr.curve.Add(pr.curve.Double(z), q)
+	z = pr.doubleN(z, 4)
+	z = pr.curve.Add(pr.curve.Double(z), q)
+	z = pr.doubleN(z, 3)
+	z = pr.curve.Add(pr.curve.Double(z), q)
+	z = pr.curve.Add(pr.curve.Double(z), q)
+	z = pr.doubleN(z, 45)
+	z = pr.curve.Add(pr.curve.Double(z), q)
+
+	return z
+}
+
+func (pr Pairing) doubleN(p *G1Affine, n int) *G1Affine {
+	pn := p
+	for s := 0; s < n; s++ {
+		pn = pr.curve.Double(pn)
+	}
+	return pn
+}
+
+// loopCounter0 = x₀+1 and loopCounter1 = x₀³-x₀²-x₀ in signed binary (little
+// endian).
+var loopCounter0, loopCounter1 [190]int8
+
+func init() {
+	// x₀+1
+	loopCounter0 = [190]int8{0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, -1, 0, 1, 0, 0, 1, 0, 0, 0, 0, 1, 0, 1, 0, 0, 0, 0, 1}
+
+	// x₀³-x₀²-x₀
+	T, _ := new(big.Int).SetString("880904806456922042166256752416502360955572640081583800319", 10)
+	ecc.NafDecomposition(T, loopCounter1[:])
+}
+
+// MillerLoop computes the optimal Tate multi-Miller loop
+// (or twisted ate or Eta revisited)
+//
+// ∏ᵢ { fᵢ_{x₀+1+λ(x₀³-x₀²-x₀),Pᵢ}(Qᵢ) }
+//
+// Alg.2 in https://eprint.iacr.org/2021/1359.pdf
+// Eq. (6) in https://hackmd.io/@gnark/BW6-761-changes
+func (pr Pairing) MillerLoop(P []*G1Affine, Q []*G2Affine) (*GTEl, error) {
+	// check input size match
+	n := len(P)
+	if n == 0 || n != len(Q) {
+		return nil, errors.New("invalid inputs sizes")
+	}
+
+	res := pr.Ext6.One()
+
+	var l0, l *lineEvaluation
+	p0 := make([]*G1Affine, n)
+	p1 := make([]*G1Affine, n)
+	pAcc := make([]*G1Affine, n)
+	yInv := make([]*baseEl, n)
+	xOverY := make([]*baseEl, n)
+
+	for k := 0; k < n; k++ {
+		p0[k] = P[k]
+		// p1 = ϕ̂(P) is the image of P by the endomorphism associated to λ
+		p1[k] = &G1Affine{
+			X: *pr.curveF.MulMod(&P[k].X, pr.thirdRootOneG2),
+			Y: *pr.curveF.Neg(&P[k].Y),
+		}
+		pAcc[k] = p1[k]
+		// the lines are evaluated at Q which is over 𝔽p. We normalize them
+		// by 1/y_Q so that they are of the form 1 + R0(x/y) + R1(1/y).
+		yInv[k] = pr.curveF.Inverse(&Q[k].Y)
+		xOverY[k] = pr.curveF.MulMod(&Q[k].X, yInv[k])
+	}
+
+	// Compute f_{a0+λ*a1,P}(Q)
+
+	// i = len(loopCounter0) - 2, separately to avoid an E6 Square
+	// (Square(res) = 1² = 1)
+
+	// k = 0, separately to avoid MulBy034 (res × ℓ)
+	// pAcc[0] ← 2pAcc[0] and l0 the tangent ℓ passing 2pAcc[0]
+	pAcc[0], l0 = pr.doubleStep(pAcc[0])
+	// line evaluation at Q[0]
+	// and assign line to res (1, 0, 0, R0, R1, 0)
+	res.B1.A0 = *pr.curveF.MulMod(&l0.R0, xOverY[0])
+	res.B1.A1 = *pr.curveF.MulMod(&l0.R1, yInv[0])
+
+	for k := 1; k < n; k++ {
+		// pAcc[k] ← 2pAcc[k] and l0 the tangent ℓ passing 2pAcc[k]
+		pAcc[k], l0 = pr.doubleStep(pAcc[k])
+		// ℓ × res
+		res = pr.mulByLine(res, l0, xOverY[k], yInv[k])
+	}
+
+	for i := len(loopCounter0) - 3; i >= 1; i-- {
+		// mutualize the square among n Miller loops
+		// (∏ᵢfᵢ)²
+		res = pr.Square(res)
+
+		j := loopCounter1[i]*3 + loopCounter0[i]
+
+		for k := 0; k < n; k++ {
+			// pAcc[k] ← 2pAcc[k] and l0 the tangent ℓ passing 2pAcc[k]
+			pAcc[k], l0 = pr.doubleStep(pAcc[k])
+			// ℓ × res
+			res = pr.mulByLine(res, l0, xOverY[k], yInv[k])
+
+			// Only the combinations j ∈ {-3,-1,0,1,3} appear in the loop
+			// counters of BW6-761.
+			switch j {
+			case -3:
+				// pAcc[k] ← pAcc[k]-p1[k] and
+				// l the line ℓ passing pAcc[k] and -p1[k]
+				pAcc[k], l = pr.addStep(pAcc[k], pr.curve.Neg(p1[k]))
+				// ℓ × res
+				res = pr.mulByLine(res, l, xOverY[k], yInv[k])
+			case -1:
+				// pAcc[k] ← pAcc[k]-p0[k] and
+				// l the line ℓ passing pAcc[k] and -p0[k]
+				pAcc[k], l = pr.addStep(pAcc[k], pr.curve.Neg(p0[k]))
+				// ℓ × res
+				res = pr.mulByLine(res, l, xOverY[k], yInv[k])
+			case 0:
+			case 1:
+				// pAcc[k] ← pAcc[k]+p0[k] and
+				// l the line ℓ passing pAcc[k] and p0[k]
+				pAcc[k], l = pr.addStep(pAcc[k], p0[k])
+				// ℓ × res
+				res = pr.mulByLine(res, l, xOverY[k], yInv[k])
+			case 3:
+				// pAcc[k] ← pAcc[k]+p1[k] and
+				// l the line ℓ passing pAcc[k] and p1[k]
+				pAcc[k], l = pr.addStep(pAcc[k], p1[k])
+				// ℓ × res
+				res = pr.mulByLine(res, l, xOverY[k], yInv[k])
+			default:
+				return nil, errors.New("invalid loopCounter")
+			}
+		}
+	}
+
+	// i = 0, separately to avoid a point doubling
+	// j = -3
+	res = pr.Square(res)
+	for k := 0; k < n; k++ {
+		// l0 the tangent ℓ passing 2pAcc[k]
+		l0 = pr.tangentCompute(pAcc[k])
+		// ℓ × res
+		res = pr.mulByLine(res, l0, xOverY[k], yInv[k])
+		// the line passing 2pAcc[k] and -p1[k] is vertical as 2pAcc[k]-p1[k]
+		// is the point at infinity. Its evaluation at Q is in 𝔽p and is
+		// killed by the final exponentiation.
+	}
+
+	return res, nil
+}
+
+// mulByLine evaluates the line ℓ at Q, given by x/y and 1/y, and multiplies
+// it by res.
+func (pr Pairing) mulByLine(res *GTEl, l *lineEvaluation, xOverY, yInv *baseEl) *GTEl {
+	r0 := pr.curveF.MulMod(&l.R0, xOverY)
+	r1 := pr.curveF.MulMod(&l.R1, yInv)
+	return pr.MulBy034(res, r0, r1)
+}
+
+// addStep adds two points in affine coordinates, and evaluates the line in Miller loop
+// https://eprint.iacr.org/2022/1162 (Section 6.1)
+func (pr Pairing) addStep(p1, p2 *G1Affine) (*G1Affine, *lineEvaluation) {
+
+	// compute λ = (y2-y1)/(x2-x1)
+	p2ypy := pr.curveF.Sub(&p2.Y, &p1.Y)
+	p2xpx := pr.curveF.Sub(&p2.X, &p1.X)
+	λ := pr.curveF.Div(p2ypy, p2xpx)
+
+	// xr = λ²-x1-x2
+	λλ := pr.curveF.MulMod(λ, λ)
+	qxrx := pr.curveF.Add(&p2.X, &p1.X)
+	xr := pr.curveF.Sub(λλ, qxrx)
+
+	// p.y = λ(x1-xr) - y1
+	pxrx := pr.curveF.Sub(&p1.X, xr)
+	λpxrx := pr.curveF.MulMod(λ, pxrx)
+	yr := pr.curveF.Sub(λpxrx, &p1.Y)
+
+	var res G1Affine
+	res.X = *xr
+	res.Y = *yr
+
+	var line lineEvaluation
+	line.R0 = *pr.curveF.Neg(λ)
+	line.R1 = *pr.curveF.MulMod(λ, &p1.X)
+	line.R1 = *pr.curveF.Sub(&line.R1, &p1.Y)
+
+	return &res, &line
+
+}
+
+// doubleStep doubles a point in affine coordinates, and evaluates the line in Miller loop
+// https://eprint.iacr.org/2022/1162 (Section 6.1)
+func (pr Pairing) doubleStep(p1 *G1Affine) (*G1Affine, *lineEvaluation) {
+
+	var p G1Affine
+
+	// λ = 3x²/2y
+	n := pr.curveF.MulMod(&p1.X, &p1.X)
+	three := big.NewInt(3)
+	n = pr.curveF.MulConst(n, three)
+	d := pr.curveF.MulConst(&p1.Y, big.NewInt(2))
+	λ := pr.curveF.Div(n, d)
+
+	// xr = λ²-2x
+	xr := pr.curveF.MulMod(λ, λ)
+	xr = pr.curveF.Sub(xr, &p1.X)
+	xr = pr.curveF.Sub(xr, &p1.X)
+
+	// yr = λ(x-xr)-y
+	yr := pr.curveF.Sub(&p1.X, xr)
+	yr = pr.curveF.MulMod(λ, yr)
+	yr = pr.curveF.Sub(yr, &p1.Y)
+
+	p.X = *xr
+	p.Y = *yr
+
+	var line lineEvaluation
+	line.R0 = *pr.curveF.Neg(λ)
+	line.R1 = *pr.curveF.MulMod(λ, &p1.X)
+	line.R1 = *pr.curveF.Sub(&line.R1, &p1.Y)
+
+	return &p, &line
+
+}
+
+// tangentCompute computes the line that goes through p1 and p1 but does not compute 2p1
+func (pr Pairing) tangentCompute(p1 *G1Affine) *lineEvaluation {
+
+	// λ = 3x²/2y
+	n := pr.curveF.MulMod(&p1.X, &p1.X)
+	three := big.NewInt(3)
+	n = pr.curveF.MulConst(n, three)
+	d := pr.curveF.MulConst(&p1.Y, big.NewInt(2))
+	λ := pr.curveF.Div(n, d)
+
+	var line lineEvaluation
+	line.R0 = *pr.curveF.Neg(λ)
+	line.R1 = *pr.curveF.MulMod(λ, &p1.X)
+	line.R1 = *pr.curveF.Sub(&line.R1, &p1.Y)
+
+	return &line
+
+}
@@ -12,7 +12,8 @@ these parameters are stored in the variable of type [CurveParams].
 
 This package implements unified and complete point addition. The method
 [Curve.AddUnified] can be used for point additions or in case of points at
-infinity. As such, this package does not expose separate Add and Double methods.
+infinity. The methods [Curve.Add] and [Curve.Double] use cheaper incomplete
+formulas and can be used when the inputs are known not to be exceptional.
 
 The package provides a few curve parameters, see functions [GetSecp256k1Params]
 and [GetBN254Params].
@@ -0,0 +1,76 @@
+package sw_emulated
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{
+		decomposeScalarHint,
+	}
+}
+
+func decomposeScalarHintArgs[S emulated.FieldParams](lambda, s *emulated.Element[S]) []frontend.Variable {
+	var fr S
+	args := []frontend.Variable{fr.BitsPerLimb(), fr.NbLimbs()}
+	args = append(args, emulated.ValueOf[S](fr.Modulus()).Limbs...)
+	args = append(args, lambda.Limbs...)
+	args = append(args, s.Limbs...)
+	return args
+}
+
+// decomposeScalarHint decomposes the scalar s into s1 and s2 such that
+//
+//	s1 + λ * s2 == s mod r
+//
+// using the GLV lattice basis. It returns |s1|, |s2| and the signs of s1 and s2
+// (1 if negative, 0 otherwise). The inputs are the number of bits per limb, the
+// number of limbs and the limbs of r, λ and s.
+func decomposeScalarHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) < 2 || !inputs[0].IsUint64() || !inputs[1].IsInt64() {
+		return fmt.Errorf("expected header of two elements")
+	}
+	nbBits := uint(inputs[0].Uint64())
+	nbLimbs := int(inputs[1].Int64())
+	if len(inputs) != 2+3*nbLimbs {
+		return fmt.Errorf("expected %d inputs got %d", 2+3*nbLimbs, len(inputs))
+	}
+	if len(outputs) != 4 {
+		return fmt.Errorf("expected 4 outputs got %d", len(outputs))
+	}
+	r := recompose(inputs[2:2+nbLimbs], nbBits)
+	lambda := recompose(inputs[2+nbLimbs:2+2*nbLimbs], nbBits)
+	s := recompose(inputs[2+2*nbLimbs:], nbBits)
+	var glvBasis ecc.Lattice
+	ecc.PrecomputeLattice(r, lambda, &glvBasis)
+	sp := ecc.SplitScalar(s, &glvBasis)
+	for i := 0; i < 2; i++ {
+		outputs[i].Abs(&sp[i])
+		if sp[i].Sign() < 0 {
+			outputs[2+i].SetUint64(1)
+		} else {
+			outputs[2+i].SetUint64(0)
+		}
+	}
+	return nil
+}
+
+func recompose(inputs []*big.Int, nbBits uint) *big.Int {
+	res := new(big.Int)
+	for i := len(inputs) - 1; i >= 0; i-- {
+		res.Lsh(res, nbBits)
+		res.Add(res, inputs[i])
+	}
+	return res
+}
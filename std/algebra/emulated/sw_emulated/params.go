@@ -4,8 +4,10 @@ import (
 	"crypto/elliptic"
 	"math/big"
 
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
 	"github.com/consensys/gnark-crypto/ecc/secp256k1"
 	"github.com/consensys/gnark/std/math/emulated"
 )
@@ -16,12 +18,20 @@ import (
 //	Y² = X³ + aX + b
 //
 // The base point is defined by (Gx, Gy).
+//
+// If the curve has an efficient endomorphism φ(X, Y) = (ωX, Y) acting as a
+// scalar multiplication by λ on the prime order subgroup, then the
+// parameters Eigenvalue (λ) and ThirdRootOne (ω) can be set to enable the GLV
+// scalar multiplications such as [Curve.ScalarMulGLV]. Otherwise they must be
+// nil.
 type CurveParams struct {
-	A  *big.Int      // a in curve equation
-	B  *big.Int      // b in curve equation
-	Gx *big.Int      // base point x
-	Gy *big.Int      // base point y
-	Gm [][2]*big.Int // m*base point coords
+	A            *big.Int      // a in curve equation
+	B            *big.Int      // b in curve equation
+	Gx           *big.Int      // base point x
+	Gy           *big.Int      // base point y
+	Gm           [][2]*big.Int // m*base point coords
+	Eigenvalue   *big.Int      // endomorphism eigenvalue
+	ThirdRootOne *big.Int      // endomorphism image scaler
 }
 
 // GetSecp256k1Params returns curve parameters for the curve secp256k1. When
@@ -29,12 +39,16 @@ type CurveParams struct {
 // field [emulated.Secp256k1Fr].
 func GetSecp256k1Params() CurveParams {
 	_, g1aff := secp256k1.Generators()
+	lambda, _ := new(big.Int).SetString("78074008874160198520644763525212887401909906723592317393988542598630163514318", 10)
+	omega, _ := new(big.Int).SetString("60197513588986302554485582024885075108884032450952339817679072026166228089408", 10)
 	return CurveParams{
-		A:  big.NewInt(0),
-		B:  big.NewInt(7),
-		Gx: g1aff.X.BigInt(new(big.Int)),
-		Gy: g1aff.Y.BigInt(new(big.Int)),
-		Gm: computeSecp256k1Table(),
+		A:            big.NewInt(0),
+		B:            big.NewInt(7),
+		Gx:           g1aff.X.BigInt(new(big.Int)),
+		Gy:           g1aff.Y.BigInt(new(big.Int)),
+		Gm:           computeSecp256k1Table(),
+		Eigenvalue:   lambda,
+		ThirdRootOne: omega,
 	}
 }
 
@@ -43,12 +57,16 @@ func GetSecp256k1Params() CurveParams {
 // field [emulated.BN254Fr].
 func GetBN254Params() CurveParams {
 	_, _, g1aff, _ := bn254.Generators()
+	lambda, _ := new(big.Int).SetString("4407920970296243842393367215006156084916469457145843978461", 10)
+	omega, _ := new(big.Int).SetString("2203960485148121921418603742825762020974279258880205651966", 10)
 	return CurveParams{
-		A:  big.NewInt(0),
-		B:  big.NewInt(3),
-		Gx: g1aff.X.BigInt(new(big.Int)),
-		Gy: g1aff.Y.BigInt(new(big.Int)),
-		Gm: computeBN254Table(),
+		A:            big.NewInt(0),
+		B:            big.NewInt(3),
+		Gx:           g1aff.X.BigInt(new(big.Int)),
+		Gy:           g1aff.Y.BigInt(new(big.Int)),
+		Gm:           computeBN254Table(),
+		Eigenvalue:   lambda,
+		ThirdRootOne: omega,
 	}
 }
 
@@ -57,12 +75,53 @@ func GetBN254Params() CurveParams {
 // field [emulated.BLS12381Fr].
 func GetBLS12381Params() CurveParams {
 	_, _, g1aff, _ := bls12381.Generators()
+	lambda, _ := new(big.Int).SetString("228988810152649578064853576960394133503", 10)
+	omega, _ := new(big.Int).SetString("4002409555221667392624310435006688643935503118305586438271171395842971157480381377015405980053539358417135540939436", 10)
+	return CurveParams{
+		A:            big.NewInt(0),
+		B:            big.NewInt(4),
+		Gx:           g1aff.X.BigInt(new(big.Int)),
+		Gy:           g1aff.Y.BigInt(new(big.Int)),
+		Gm:           computeBLS12381Table(),
+		Eigenvalue:   lambda,
+		ThirdRootOne: omega,
+	}
+}
+
+// GetBLS12377Params returns the curve parameters for the curve BLS12-377.
+// When initialising new curve, use the base field [emulated.BLS12377Fp] and scalar
+// field [emulated.BLS12377Fr].
+func GetBLS12377Params() CurveParams {
+	_, _, g1aff, _ := bls12377.Generators()
+	lambda, _ := new(big.Int).SetString("91893752504881257701523279626832445440", 10)
+	omega, _ := new(big.Int).SetString("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410945", 10)
+	return CurveParams{
+		A:            big.NewInt(0),
+		B:            big.NewInt(1),
+		Gx:           g1aff.X.BigInt(new(big.Int)),
+		Gy:           g1aff.Y.BigInt(new(big.Int)),
+		Gm:           computeBLS12377Table(),
+		Eigenvalue:   lambda,
+		ThirdRootOne: omega,
+	}
+}
+
+// GetBW6761Params returns the curve parameters for the curve BW6-761.
+// When initialising new curve, use the base field [emulated.BW6761Fp] and scalar
+// field [emulated.BW6761Fr].
+func GetBW6761Params() CurveParams {
+	_, _, g1aff, _ := bw6761.Generators()
+	b := new(big.Int).Sub(emulated.BW6761Fp{}.Modulus(), big.NewInt(1))
+	lambda, _ := new(big.Int).SetString("80949648264912719408558363140637477264845294720710499478137287262712535938301461879813459410945", 10)
+	omega, _ := new(big.Int).SetString("1968985824090209297278610739700577151397666382303825728450741611566800370218827257750865013421937292370006175842381275743914023380727582819905021229583192207421122272650305267822868639090213645505120388400344940985710520836292650", 10)
 	return CurveParams{
-		A:  big.NewInt(0),
-		B:  big.NewInt(4),
-		Gx: g1aff.X.BigInt(new(big.Int)),
-		Gy: g1aff.Y.BigInt(new(big.Int)),
-		Gm: computeBLS12381Table(),
+		A:            big.NewInt(0),
+		B:            b,
+		Gx:           g1aff.X.BigInt(new(big.Int)),
+		Gy:           g1aff.Y.BigInt(new(big.Int)),
+		Gm:           computeBW6761Table(),
+		Eigenvalue:   lambda,
+		ThirdRootOne: omega,
 	}
 }
 
@@ -106,8 +165,12 @@ func GetCurveParams[Base emulated.FieldParams]() CurveParams {
 		return secp256k1Params
 	case emulated.BN254Fp{}.Modulus().String():
 		return bn254Params
+	case emulated.BLS12377Fp{}.Modulus().String():
+		return bls12377Params
 	case emulated.BLS12381Fp{}.Modulus().String():
 		return bls12381Params
+	case emulated.BW6761Fp{}.Modulus().String():
+		return bw6761Params
 	case emulated.P256Fp{}.Modulus().String():
 		return p256Params
 	case emulated.P384Fp{}.Modulus().String():
@@ -120,7 +183,9 @@ func GetCurveParams[Base emulated.FieldParams]() CurveParams {
 var (
 	secp256k1Params CurveParams
 	bn254Params     CurveParams
+	bls12377Params  CurveParams
 	bls12381Params  CurveParams
+	bw6761Params    CurveParams
 	p256Params      CurveParams
 	p384Params      CurveParams
 )
@@ -128,7 +193,9 @@ var (
 func init() {
 	secp256k1Params = GetSecp256k1Params()
 	bn254Params = GetBN254Params()
+	bls12377Params = GetBLS12377Params()
 	bls12381Params = GetBLS12381Params()
+	bw6761Params = GetBW6761Params()
 	p256Params = GetP256Params()
 	p384Params = GetP384Params()
 }
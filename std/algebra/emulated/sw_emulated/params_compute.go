@@ -2,10 +2,13 @@ package sw_emulated
 
 import (
 	"crypto/elliptic"
+	"crypto/sha256"
 	"math/big"
 
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
 	"github.com/consensys/gnark-crypto/ecc/secp256k1"
 )
 
@@ -87,6 +90,58 @@ func computeBLS12381Table() [][2]*big.Int {
 	return table
 }
 
+func computeBLS12377Table() [][2]*big.Int {
+	Gjac, _, _, _ := bls12377.Generators()
+	table := make([][2]*big.Int, 256)
+	tmp := new(bls12377.G1Jac).Set(&Gjac)
+	aff := new(bls12377.G1Affine)
+	jac := new(bls12377.G1Jac)
+	for i := 1; i < 256; i++ {
+		tmp = tmp.Double(tmp)
+		switch i {
+		case 1, 2:
+			jac.Set(tmp).AddAssign(&Gjac)
+			aff.FromJacobian(jac)
+			table[i-1] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+		case 3:
+			jac.Set(tmp).SubAssign(&Gjac)
+			aff.FromJacobian(jac)
+			table[i-1] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+			fallthrough
+		default:
+			aff.FromJacobian(tmp)
+			table[i] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+		}
+	}
+	return table
+}
+
+func computeBW6761Table() [][2]*big.Int {
+	Gjac, _, _, _ := bw6761.Generators()
+	table := make([][2]*big.Int, 384)
+	tmp := new(bw6761.G1Jac).Set(&Gjac)
+	aff := new(bw6761.G1Affine)
+	jac := new(bw6761.G1Jac)
+	for i := 1; i < 384; i++ {
+		tmp = tmp.Double(tmp)
+		switch i {
+		case 1, 2:
+			jac.Set(tmp).AddAssign(&Gjac)
+			aff.FromJacobian(jac)
+			table[i-1] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+		case 3:
+			jac.Set(tmp).SubAssign(&Gjac)
+			aff.FromJacobian(jac)
+			table[i-1] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+			fallthrough
+		default:
+			aff.FromJacobian(tmp)
+			table[i] = [2]*big.Int{aff.X.BigInt(new(big.Int)), aff.Y.BigInt(new(big.Int))}
+		}
+	}
+	return table
+}
+
 func computeP256Table() [][2]*big.Int {
 	table := make([][2]*big.Int, 256)
 	p256 := elliptic.P256()
@@ -130,3 +185,47 @@ func computeP384Table() [][2]*big.Int {
 	}
 	return table
 }
+
+// computeMSMOffset returns a point R on the curve y²=x³+ax+b over the prime
+// field of modulus p and the point [2^n]R. R is obtained by hashing a fixed
+// domain separation tag to the x-coordinate, so its discrete logarithm with
+// respect to any other point is not known.
+func computeMSMOffset(a, b, p *big.Int, n int) (R, Rn [2]*big.Int) {
+	var x, y, rhs big.Int
+	for ctr := byte(0); ; ctr++ {
+		h := sha256.Sum256(append([]byte("gnark-msm-offset"), ctr))
+		x.SetBytes(h[:])
+		x.Mod(&x, p)
+		rhs.Mul(&x, &x)
+		rhs.Add(&rhs, a)
+		rhs.Mul(&rhs, &x)
+		rhs.Add(&rhs, b)
+		rhs.Mod(&rhs, p)
+		if rhs.Sign() != 0 && y.ModSqrt(&rhs, p) != nil {
+			break
+		}
+	}
+	R = [2]*big.Int{new(big.Int).Set(&x), new(big.Int).Set(&y)}
+	var lambda, tmp big.Int
+	for i := 0; i < n; i++ {
+		// λ = (3x²+a)/2y
+		lambda.Mul(&x, &x)
+		lambda.Mul(&lambda, big.NewInt(3))
+		lambda.Add(&lambda, a)
+		tmp.Lsh(&y, 1)
+		tmp.ModInverse(&tmp, p)
+		lambda.Mul(&lambda, &tmp)
+		lambda.Mod(&lambda, p)
+		// x' = λ²-2x, y' = λ(x-x')-y
+		tmp.Mul(&lambda, &lambda)
+		tmp.Sub(&tmp, &x)
+		tmp.Sub(&tmp, &x)
+		tmp.Mod(&tmp, p)
+		x.Sub(&x, &tmp)
+		y.Sub(new(big.Int).Mul(&lambda, &x), &y)
+		y.Mod(&y, p)
+		x.Set(&tmp)
+	}
+	Rn = [2]*big.Int{new(big.Int).Set(&x), new(big.Int).Set(&y)}
+	return R, Rn
+}
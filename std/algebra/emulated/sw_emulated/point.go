@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/math/emulated"
 )
@@ -27,6 +28,22 @@ func New[Base, Scalars emulated.FieldParams](api frontend.API, params CurveParam
 	}
 	Gx := emulated.ValueOf[Base](params.Gx)
 	Gy := emulated.ValueOf[Base](params.Gy)
+	var eigenvalue *emulated.Element[Scalars]
+	var thirdRootOne *emulated.Element[Base]
+	var glvBits int
+	if params.Eigenvalue != nil && params.ThirdRootOne != nil {
+		var fr Scalars
+		var glvBasis ecc.Lattice
+		ecc.PrecomputeLattice(fr.Modulus(), params.Eigenvalue, &glvBasis)
+		// the decomposed scalars are bounded by the lattice basis vectors.
+		for _, v := range []*big.Int{&glvBasis.V1[0], &glvBasis.V1[1], &glvBasis.V2[0], &glvBasis.V2[1]} {
+			if v.BitLen()+1 > glvBits {
+				glvBits = v.BitLen() + 1
+			}
+		}
+		eigenvalue = sa.NewElement(params.Eigenvalue)
+		thirdRootOne = ba.NewElement(params.ThirdRootOne)
+	}
 	return &Curve[Base, Scalars]{
 		params:    params,
 		api:       api,
@@ -40,6 +57,10 @@ func New[Base, Scalars emulated.FieldParams](api frontend.API, params CurveParam
 		a:    emulated.ValueOf[Base](params.A),
 		b:    emulated.ValueOf[Base](params.B),
 		addA: params.A.Cmp(big.NewInt(0)) != 0,
+
+		eigenvalue:   eigenvalue,
+		thirdRootOne: thirdRootOne,
+		glvBits:      glvBits,
 	}, nil
 }
 
@@ -63,6 +84,13 @@ type Curve[Base, Scalars emulated.FieldParams] struct {
 	a    emulated.Element[Base]
 	b    emulated.Element[Base]
 	addA bool
+
+	// eigenvalue and thirdRootOne define the endomorphism if it exists. In
+	// that case glvBits is the bound on the bit length of the decomposed
+	// scalars.
+	eigenvalue   *emulated.Element[Scalars]
+	thirdRootOne *emulated.Element[Base]
+	glvBits      int
 }
 
 // Generator returns the base point of the curve. The method does not copy and
@@ -100,6 +128,26 @@ func (c *Curve[B, S]) AssertIsEqual(p, q *AffinePoint[B]) {
 	c.baseApi.AssertIsEqual(&p.Y, &q.Y)
 }
 
+// Add adds p and q and returns it. It doesn't modify p nor q.
+//
+// ⚠️  p must be different than q and -q, and both nonzero.
+//
+// It uses incomplete formulas in affine coordinates. For the complete formulas
+// see [Curve.AddUnified].
+func (c *Curve[B, S]) Add(p, q *AffinePoint[B]) *AffinePoint[B] {
+	return c.add(p, q)
+}
+
+// Double doubles p and returns it. It doesn't modify p.
+//
+// ⚠️  p.Y must be nonzero.
+//
+// It uses incomplete formulas in affine coordinates. For the complete formulas
+// see [Curve.AddUnified].
+func (c *Curve[B, S]) Double(p *AffinePoint[B]) *AffinePoint[B] {
+	return c.double(p)
+}
+
 // add adds p and q and returns it. It doesn't modify p nor q.
 //
 // ⚠️  p must be different than q and -q, and both nonzero.
@@ -373,6 +421,8 @@ func (c *Curve[B, S]) Lookup2(b0, b1 frontend.Variable, i0, i1, i2, i3 *AffinePo
 // positions 1, n-2 and n-1 outside of the loop to optimize the number of
 // constraints using [ELM03] (Section 3.1)
 //
+// For curves with an efficient endomorphism, see [Curve.ScalarMulGLV].
+//
 // [ELM03]: https://arxiv.org/pdf/math/0208038.pdf
 // [HMV04]: https://link.springer.com/book/10.1007/b97644
 // [EVM]: https://ethereum.github.io/yellowpaper/paper.pdf
@@ -481,6 +531,9 @@ func (c *Curve[B, S]) ScalarMulBase(s *emulated.Element[S]) *AffinePoint[B] {
 //
 // This saves the Select logic related to (0,0) and the use of AddUnified to
 // handle the 0-scalar edge case.
+//
+// For curves with an efficient endomorphism, see
+// [Curve.JointScalarMulBaseGLV].
 func (c *Curve[B, S]) JointScalarMulBase(p *AffinePoint[B], s2, s1 *emulated.Element[S]) *AffinePoint[B] {
 	g := c.Generator()
 	gm := c.GeneratorMultiples()
@@ -531,3 +584,292 @@ func (c *Curve[B, S]) JointScalarMulBase(p *AffinePoint[B], s2, s1 *emulated.Ele
 
 	return c.add(res1, res2)
 }
+
+// msmWindowSize is the number of scalar bits processed at once in
+// [Curve.MultiScalarMul].
+const msmWindowSize = 4
+
+// MultiScalarMul computes the multi-scalar multiplication ∑ s_i * p_i and
+// returns it. It doesn't modify the inputs. It returns an error if there is a
+// mismatch in the lengths of the inputs or if the inputs are empty.
+//
+// ✅ p_i can be (0,0), s_i can be 0 and the result can be (0,0).
+// (0,0) is not on the curve but we conventionally take it as the
+// neutral/infinity point as per the [EVM].
+//
+// It computes the interleaved windowed multi-scalar multiplication algorithm
+// (Straus) [HMV04] (Algorithm 3.51) where all the points share the doublings.
+//
+// The scalars are recoded into odd signed digits in the set {±1, ±3, ...,
+// ±(2^w-1)} directly from the bits of s_i|1, so that no digit is zero. The
+// w-bit windows of the bits are then directly the indices in the tables of the
+// multiples [-(2^w-1)]p_i, ..., [-1]p_i, [1]p_i, ..., [2^w-1]p_i, which are
+// queried using log-derivative lookups. At the end, we conditionally subtract
+// p_i if s_i is even.
+//
+// As we use incomplete formulas for the addition law, the accumulator is
+// initialised with a fixed point R and the corresponding multiple of R is
+// subtracted at the end.
+//
+// ⚠️  The inputs for which the accumulator equals ± a point to add are
+// rejected, for example p_i = [2^-w mod r]R for which the first addition is
+// R + R. R is derived by hashing to the curve, so such inputs are reachable
+// only with a known discrete logarithm relation between p_i and R. Honestly
+// generated inputs hit them with negligible probability, but an adversary
+// choosing the points can make the proof fail. Use [Curve.ScalarMul] when the
+// inputs may be adversarial and must always be provable.
+//
+// For curves with an efficient endomorphism, see [Curve.MultiScalarMulGLV].
+//
+// [HMV04]: https://link.springer.com/book/10.1007/b97644
+// [EVM]: https://ethereum.github.io/yellowpaper/paper.pdf
+func (c *Curve[B, S]) MultiScalarMul(p []*AffinePoint[B], s []*emulated.Element[S]) (*AffinePoint[B], error) {
+	if len(p) != len(s) {
+		return nil, fmt.Errorf("mismatching points and scalars slice lengths")
+	}
+	if len(p) == 0 {
+		return nil, fmt.Errorf("no inputs")
+	}
+	return c.multiScalarMul(p, s, false), nil
+}
+
+// ScalarMulGLV computes s * p and returns it using the endomorphism of the
+// curve. It doesn't modify p nor s. If the curve parameters do not define an
+// endomorphism, then it is equivalent to [Curve.ScalarMul].
+//
+// ✅ p can can be (0,0) and s can be 0.
+//
+// ⚠️  p must be in the prime order subgroup. The endomorphism acts as the
+// multiplication by the eigenvalue only on this subgroup, for other points the
+// result is incorrect. In particular, the method must not be used for
+// subgroup membership checks.
+//
+// ⚠️  As in [Curve.MultiScalarMul], the inputs for which the accumulator
+// collides with a point to add are rejected.
+//
+// The scalar is decomposed as s = s1 + λ*s2 with half-size s1, s2 [GLV01] and
+// we compute the multi-scalar multiplication s1 * p + s2 * φ(p) as in
+// [Curve.MultiScalarMul] with half as many doublings.
+//
+// [GLV01]: https://link.springer.com/content/pdf/10.1007/3-540-44647-8_11.pdf
+func (c *Curve[B, S]) ScalarMulGLV(p *AffinePoint[B], s *emulated.Element[S]) *AffinePoint[B] {
+	if c.eigenvalue == nil {
+		return c.ScalarMul(p, s)
+	}
+	return c.multiScalarMul([]*AffinePoint[B]{p}, []*emulated.Element[S]{s}, true)
+}
+
+// JointScalarMulBaseGLV computes s2 * p + s1 * g and returns it using the
+// endomorphism of the curve, where g is the fixed generator. It doesn't modify
+// p, s1 and s2. If the curve parameters do not define an endomorphism, then it
+// is equivalent to [Curve.JointScalarMulBase].
+//
+// ⚠️  p must be in the prime order subgroup, see [Curve.ScalarMulGLV].
+func (c *Curve[B, S]) JointScalarMulBaseGLV(p *AffinePoint[B], s2, s1 *emulated.Element[S]) *AffinePoint[B] {
+	if c.eigenvalue == nil {
+		return c.JointScalarMulBase(p, s2, s1)
+	}
+	return c.multiScalarMul([]*AffinePoint[B]{p, c.Generator()}, []*emulated.Element[S]{s2, s1}, true)
+}
+
+// MultiScalarMulGLV computes the multi-scalar multiplication ∑ s_i * p_i using
+// the endomorphism of the curve and returns it. Every scalar is decomposed as
+// s_i = s_i1 + λ*s_i2 with half-size s_i1, s_i2 [GLV01] and we compute
+// ∑ s_i1 * p_i + s_i2 * φ(p_i) as in [Curve.MultiScalarMul]. If the curve
+// parameters do not define an endomorphism, then it is equivalent to
+// [Curve.MultiScalarMul].
+//
+// ⚠️  p_i must be in the prime order subgroup, see [Curve.ScalarMulGLV]. As
+// in [Curve.MultiScalarMul], the inputs for which the accumulator collides
+// with a point to add are rejected.
+//
+// [GLV01]: https://link.springer.com/content/pdf/10.1007/3-540-44647-8_11.pdf
+func (c *Curve[B, S]) MultiScalarMulGLV(p []*AffinePoint[B], s []*emulated.Element[S]) (*AffinePoint[B], error) {
+	if len(p) != len(s) {
+		return nil, fmt.Errorf("mismatching points and scalars slice lengths")
+	}
+	if len(p) == 0 {
+		return nil, fmt.Errorf("no inputs")
+	}
+	return c.multiScalarMul(p, s, c.eigenvalue != nil), nil
+}
+
+// multiScalarMul computes ∑ s_i * p_i as described in [Curve.MultiScalarMul].
+// If glv is set, then it uses the endomorphism as described in
+// [Curve.MultiScalarMulGLV]. It assumes that the inputs are of the same length.
+func (c *Curve[B, S]) multiScalarMul(p []*AffinePoint[B], s []*emulated.Element[S], glv bool) *AffinePoint[B] {
+	var st S
+	multiples := make([][]*AffinePoint[B], 0, 2*len(p))
+	bits := make([][]frontend.Variable, 0, 2*len(p))
+	for i := range p {
+		// if p_i=(0,0) we use the generator with a zero scalar instead
+		selector := c.api.And(c.baseApi.IsZero(&p[i].X), c.baseApi.IsZero(&p[i].Y))
+		pi := c.Select(selector, &c.g, p[i])
+		si := c.scalarApi.Select(selector, c.scalarApi.Zero(), s[i])
+		if glv {
+			sBits, signs := c.decomposeScalar(si)
+			m1, m2 := c.glvMultiples(pi, signs)
+			multiples = append(multiples, m1, m2)
+			bits = append(bits, sBits[0], sBits[1])
+		} else {
+			sBits := c.scalarApi.ToBits(c.scalarApi.Reduce(si))
+			multiples = append(multiples, c.oddMultiples(pi))
+			bits = append(bits, sBits[:st.Modulus().BitLen()])
+		}
+	}
+	return c.multiScalarMulBits(multiples, bits)
+}
+
+// decomposeScalar decomposes s into s1 and s2 such that s1 + λ * s2 == s mod
+// r, where λ is the eigenvalue of the endomorphism. It returns the bits of |s1|
+// and |s2| and the signs of s1 and s2 (1 if negative, 0 otherwise).
+func (c *Curve[B, S]) decomposeScalar(s *emulated.Element[S]) (bits [2][]frontend.Variable, signs [2]frontend.Variable) {
+	sr := c.scalarApi.Reduce(s)
+	sd, err := c.api.Compiler().NewHint(decomposeScalarHint, 4, decomposeScalarHintArgs(c.eigenvalue, sr)...)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+	var sub [2]*emulated.Element[S]
+	for i := 0; i < 2; i++ {
+		// the decomposed scalars are small, ToBinary also range checks them.
+		bits[i] = c.api.ToBinary(sd[i], c.glvBits)
+		signs[i] = sd[2+i]
+		c.api.AssertIsBoolean(signs[i])
+		e := c.scalarApi.FromBits(bits[i]...)
+		sub[i] = c.scalarApi.Select(signs[i], c.scalarApi.Neg(e), e)
+	}
+	c.scalarApi.AssertIsEqual(c.scalarApi.Add(sub[0], c.scalarApi.Mul(sub[1], c.eigenvalue)), sr)
+	return bits, signs
+}
+
+// glvMultiples returns the odd multiples (see [Curve.oddMultiples]) of
+// (-1)^signs[0] * p and (-1)^signs[1] * φ(p).
+func (c *Curve[B, S]) glvMultiples(p *AffinePoint[B], signs [2]frontend.Variable) (m1, m2 []*AffinePoint[B]) {
+	m1 = c.oddMultiples(c.Select(signs[0], c.Neg(p), p))
+	// φ(X, Y) = (ωX, Y) commutes with the scalar multiplication, so we obtain
+	// the multiples of ±φ(p) from the multiples of ±p.
+	d := c.api.Xor(signs[0], signs[1])
+	m2 = make([]*AffinePoint[B], len(m1))
+	for i := range m1 {
+		m2[i] = &AffinePoint[B]{
+			X: *c.baseApi.Mul(&m1[i].X, c.thirdRootOne),
+			Y: *c.baseApi.Select(d, c.baseApi.Neg(&m1[i].Y), &m1[i].Y),
+		}
+	}
+	return m1, m2
+}
+
+// assertDistinctX asserts that p.X ≠ q.X. It makes the incomplete addition of
+// p and q unsatisfiable when p = q, in which case λ = 0/0 would otherwise be
+// unconstrained.
+func (c *Curve[B, S]) assertDistinctX(p, q *AffinePoint[B]) {
+	c.baseApi.Inverse(c.baseApi.Sub(&p.X, &q.X))
+}
+
+// oddMultiples returns the points [1]p, [3]p, ..., [2^w-1]p and [2^w]p where w
+// is the window size of the multi-scalar multiplication.
+//
+// ⚠️  p must not be of order dividing 2^(w+1)-1.
+func (c *Curve[B, S]) oddMultiples(p *AffinePoint[B]) []*AffinePoint[B] {
+	half := 1 << (msmWindowSize - 1)
+	res := make([]*AffinePoint[B], half+1)
+	double := c.double(p)
+	res[0] = p
+	for j := 1; j < half; j++ {
+		res[j] = c.add(res[j-1], double)
+	}
+	res[half] = c.add(res[half-1], p)
+	return res
+}
+
+// multiScalarMulBits computes ∑ k_i * p_i where bits[i] are the bits of the
+// non-negative integer k_i and multiples[i] are the odd multiples of p_i as
+// returned by [Curve.oddMultiples]. All bits[i] must have the same length.
+//
+// ⚠️  The additions to the accumulator are incomplete. We assert that the
+// added points have distinct x coordinates, so the inputs for which the
+// accumulator equals ± a point to add are rejected.
+func (c *Curve[B, S]) multiScalarMulBits(multiples [][]*AffinePoint[B], bits [][]frontend.Variable) *AffinePoint[B] {
+	var fp B
+	n := len(bits[0])
+	// k_i|1 = 2^L + ∑_{j<L} (2b_{j+1}-1) 2^j where L is a multiple of the
+	// window size and b_j are the bits of k_i.
+	nbWindows := (n - 1 + msmWindowSize - 1) / msmWindowSize
+	half := 1 << (msmWindowSize - 1)
+
+	R, Rn := computeMSMOffset(c.params.A, c.params.B, fp.Modulus(), (nbWindows-1)*msmWindowSize)
+	acc := &AffinePoint[B]{
+		X: emulated.ValueOf[B](R[0]),
+		Y: emulated.ValueOf[B](R[1]),
+	}
+	windows := make([][]frontend.Variable, len(bits))
+	tablesX := make([]*emulated.Table[B], len(bits))
+	tablesY := make([]*emulated.Table[B], len(bits))
+	for i := range bits {
+		windows[i] = make([]frontend.Variable, nbWindows)
+		for j := range windows[i] {
+			var w frontend.Variable = 0
+			for k := 0; k < msmWindowSize; k++ {
+				if pos := j*msmWindowSize + k + 1; pos < n {
+					w = c.api.Add(w, c.api.Mul(bits[i][pos], 1<<k))
+				}
+			}
+			windows[i][j] = w
+		}
+		// table of the multiples [2j-(2^w-1)]p_i for j=0..2^w-1. The negative
+		// multiples are obtained by negation.
+		xs := make([]*emulated.Element[B], 2*half)
+		ys := make([]*emulated.Element[B], 2*half)
+		for j := 0; j < half; j++ {
+			xs[half+j], ys[half+j] = &multiples[i][j].X, &multiples[i][j].Y
+			xs[half-1-j], ys[half-1-j] = &multiples[i][j].X, c.baseApi.Neg(&multiples[i][j].Y)
+		}
+		tablesX[i] = c.baseApi.NewTable(xs)
+		tablesY[i] = c.baseApi.NewTable(ys)
+		// the leading 2^L term is [2^w]p_i shifted by the doublings of the
+		// remaining windows.
+		c.assertDistinctX(acc, multiples[i][half])
+		acc = c.add(acc, multiples[i][half])
+	}
+	lookup := func(i, j int) *AffinePoint[B] {
+		return &AffinePoint[B]{
+			X: *tablesX[i].Lookup(windows[i][j]),
+			Y: *tablesY[i].Lookup(windows[i][j]),
+		}
+	}
+
+	for i := range bits {
+		q := lookup(i, nbWindows-1)
+		c.assertDistinctX(acc, q)
+		acc = c.add(acc, q)
+	}
+	for j := nbWindows - 2; j >= 0; j-- {
+		for k := 0; k < msmWindowSize-1; k++ {
+			acc = c.double(acc)
+		}
+		// doubleAndAdd computes (acc+q)+acc, where the second addition fails
+		// if acc+q = ±acc as the numerator 2acc.Y is non-zero.
+		q := lookup(0, j)
+		c.assertDistinctX(acc, q)
+		acc = c.doubleAndAdd(acc, q)
+		for i := 1; i < len(bits); i++ {
+			q = lookup(i, j)
+			c.assertDistinctX(acc, q)
+			acc = c.add(acc, q)
+		}
+	}
+	// we have computed the multiples of k_i|1, correct for the even k_i.
+	for i := range bits {
+		c.assertDistinctX(acc, multiples[i][0])
+		tmp := c.add(acc, c.Neg(multiples[i][0]))
+		acc = c.Select(bits[i][0], acc, tmp)
+	}
+	// we use AddUnified here so that the result is (0,0) when the sum is
+	// zero.
+	negRn := &AffinePoint[B]{
+		X: emulated.ValueOf[B](Rn[0]),
+		Y: emulated.ValueOf[B](new(big.Int).Sub(fp.Modulus(), Rn[1])),
+	}
+	return c.AddUnified(acc, negRn)
+}
@@ -7,10 +7,13 @@ import (
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
 	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
 	fr_bls381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	fr_bn "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	fr_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
 	"github.com/consensys/gnark-crypto/ecc/secp256k1"
 	fp_secp "github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
 	fr_secp "github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
@@ -419,9 +422,54 @@ func TestScalarMulBase3(t *testing.T) {
 	assert.NoError(err)
 }
 
+func TestScalarMulBase4(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, g, _ := bls12377.Generators()
+	var r fr_bn.Element
+	_, _ = r.SetRandom()
+	s := new(big.Int)
+	r.BigInt(s)
+	var S bls12377.G1Affine
+	S.ScalarMultiplication(&g, s)
+
+	circuit := ScalarMulBaseTest[emulated.BLS12377Fp, emulated.BLS12377Fr]{}
+	witness := ScalarMulBaseTest[emulated.BLS12377Fp, emulated.BLS12377Fr]{
+		S: emulated.ValueOf[emulated.BLS12377Fr](s),
+		Q: AffinePoint[emulated.BLS12377Fp]{
+			X: emulated.ValueOf[emulated.BLS12377Fp](S.X),
+			Y: emulated.ValueOf[emulated.BLS12377Fp](S.Y),
+		},
+	}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
+func TestScalarMulBase5(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, g, _ := bw6761.Generators()
+	var r fr_bw6761.Element
+	_, _ = r.SetRandom()
+	s := new(big.Int)
+	r.BigInt(s)
+	var S bw6761.G1Affine
+	S.ScalarMultiplication(&g, s)
+
+	circuit := ScalarMulBaseTest[emulated.BW6761Fp, emulated.BW6761Fr]{}
+	witness := ScalarMulBaseTest[emulated.BW6761Fp, emulated.BW6761Fr]{
+		S: emulated.ValueOf[emulated.BW6761Fr](s),
+		Q: AffinePoint[emulated.BW6761Fp]{
+			X: emulated.ValueOf[emulated.BW6761Fp](S.X),
+			Y: emulated.ValueOf[emulated.BW6761Fp](S.Y),
+		},
+	}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
 type ScalarMulTest[T, S emulated.FieldParams] struct {
 	P, Q AffinePoint[T]
 	S    emulated.Element[S]
+	glv  bool
 }
 
 func (c *ScalarMulTest[T, S]) Define(api frontend.API) error {
@@ -429,7 +477,12 @@ func (c *ScalarMulTest[T, S]) Define(api frontend.API) error {
 	if err != nil {
 		return err
 	}
-	res := cr.ScalarMul(&c.P, &c.S)
+	var res *AffinePoint[T]
+	if c.glv {
+		res = cr.ScalarMulGLV(&c.P, &c.S)
+	} else {
+		res = cr.ScalarMul(&c.P, &c.S)
+	}
 	cr.AssertIsEqual(res, &c.Q)
 	return nil
 }
@@ -725,3 +778,274 @@ func TestIsOnCurve3(t *testing.T) {
 	err = test.IsSolved(&circuit, &witness2, testCurve.ScalarField())
 	assert.NoError(err)
 }
+
+type MultiScalarMulTest[T, S emulated.FieldParams] struct {
+	Points  []AffinePoint[T]
+	Scalars []emulated.Element[S]
+	Res     AffinePoint[T]
+	glv     bool
+}
+
+func (c *MultiScalarMulTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	ps := make([]*AffinePoint[T], len(c.Points))
+	for i := range c.Points {
+		ps[i] = &c.Points[i]
+	}
+	ss := make([]*emulated.Element[S], len(c.Scalars))
+	for i := range c.Scalars {
+		ss[i] = &c.Scalars[i]
+	}
+	msm := cr.MultiScalarMul
+	if c.glv {
+		msm = cr.MultiScalarMulGLV
+	}
+	res, err := msm(ps, ss)
+	if err != nil {
+		return err
+	}
+	cr.AssertIsEqual(res, &c.Res)
+	return nil
+}
+
+func TestMultiScalarMul(t *testing.T) {
+	assert := test.NewAssert(t)
+	nbLen := 4
+	P := make([]secp256k1.G1Affine, nbLen)
+	S := make([]fr_secp.Element, nbLen)
+	for i := 0; i < nbLen; i++ {
+		S[i].SetRandom()
+		P[i].ScalarMultiplicationBase(S[i].BigInt(new(big.Int)))
+		S[i].SetRandom()
+	}
+	var res secp256k1.G1Affine
+	_, err := res.MultiExp(P, S, ecc.MultiExpConfig{})
+	assert.NoError(err)
+
+	cP := make([]AffinePoint[emulated.Secp256k1Fp], len(P))
+	for i := range cP {
+		cP[i] = AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](P[i].X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](P[i].Y),
+		}
+	}
+	cS := make([]emulated.Element[emulated.Secp256k1Fr], len(S))
+	for i := range cS {
+		cS[i] = emulated.ValueOf[emulated.Secp256k1Fr](S[i])
+	}
+	for _, glv := range []bool{false, true} {
+		circuit := MultiScalarMulTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+			Points:  make([]AffinePoint[emulated.Secp256k1Fp], nbLen),
+			Scalars: make([]emulated.Element[emulated.Secp256k1Fr], nbLen),
+			glv:     glv,
+		}
+		assignment := MultiScalarMulTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+			Points:  cP,
+			Scalars: cS,
+			Res: AffinePoint[emulated.Secp256k1Fp]{
+				X: emulated.ValueOf[emulated.Secp256k1Fp](res.X),
+				Y: emulated.ValueOf[emulated.Secp256k1Fp](res.Y),
+			},
+		}
+		err = test.IsSolved(&circuit, &assignment, testCurve.ScalarField())
+		assert.NoError(err, glv)
+	}
+}
+
+func TestMultiScalarMulEdgeCases(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, _, g, _ := bn254.Generators()
+	var s fr_bn.Element
+	s.SetRandom()
+	var p, negP, sp bn254.G1Affine
+	p.ScalarMultiplication(&g, s.BigInt(new(big.Int)))
+	negP.Neg(&p)
+	s.SetRandom()
+	sp.ScalarMultiplication(&p, s.BigInt(new(big.Int)))
+
+	point := func(q bn254.G1Affine) AffinePoint[emulated.BN254Fp] {
+		return AffinePoint[emulated.BN254Fp]{
+			X: emulated.ValueOf[emulated.BN254Fp](q.X),
+			Y: emulated.ValueOf[emulated.BN254Fp](q.Y),
+		}
+	}
+	var zero bn254.G1Affine
+	var res bn254.G1Affine
+	res.Double(&sp)
+
+	for _, glv := range []bool{false, true} {
+		circuit := MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+			Points:  make([]AffinePoint[emulated.BN254Fp], 4),
+			Scalars: make([]emulated.Element[emulated.BN254Fr], 4),
+			glv:     glv,
+		}
+		// zero point, zero scalar and repeated points
+		assignment := MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+			Points:  []AffinePoint[emulated.BN254Fp]{point(zero), point(p), point(p), point(g)},
+			Scalars: []emulated.Element[emulated.BN254Fr]{emulated.ValueOf[emulated.BN254Fr](s), emulated.ValueOf[emulated.BN254Fr](s), emulated.ValueOf[emulated.BN254Fr](s), emulated.ValueOf[emulated.BN254Fr](0)},
+			Res:     point(res),
+		}
+		err := test.IsSolved(&circuit, &assignment, testCurve.ScalarField())
+		assert.NoError(err, glv)
+
+		// result is zero
+		assignment = MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+			Points:  []AffinePoint[emulated.BN254Fp]{point(p), point(negP), point(g), point(g)},
+			Scalars: []emulated.Element[emulated.BN254Fr]{emulated.ValueOf[emulated.BN254Fr](s), emulated.ValueOf[emulated.BN254Fr](s), emulated.ValueOf[emulated.BN254Fr](1), emulated.ValueOf[emulated.BN254Fr](-1)},
+			Res:     point(zero),
+		}
+		err = test.IsSolved(&circuit, &assignment, testCurve.ScalarField())
+		assert.NoError(err, glv)
+	}
+}
+
+func TestMultiScalarMulOffsetCollision(t *testing.T) {
+	assert := test.NewAssert(t)
+	// p = [2^-w]R is such that the first addition to the accumulator is R+R,
+	// for which the incomplete addition would not constrain the result.
+	var fp emulated.BN254Fp
+	params := GetBN254Params()
+	nbWindows := (fr_bn.Bits - 1 + msmWindowSize - 1) / msmWindowSize
+	R, _ := computeMSMOffset(params.A, params.B, fp.Modulus(), (nbWindows-1)*msmWindowSize)
+	var r, p bn254.G1Affine
+	r.X.SetBigInt(R[0])
+	r.Y.SetBigInt(R[1])
+	var e fr_bn.Element
+	e.SetUint64(1 << msmWindowSize).Inverse(&e)
+	p.ScalarMultiplication(&r, e.BigInt(new(big.Int)))
+
+	var s fr_bn.Element
+	s.SetRandom()
+	var res, forged bn254.G1Affine
+	res.ScalarMultiplication(&p, s.BigInt(new(big.Int)))
+	forged.Add(&res, &r)
+
+	circuit := MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+		Points:  make([]AffinePoint[emulated.BN254Fp], 1),
+		Scalars: make([]emulated.Element[emulated.BN254Fr], 1),
+	}
+	for _, q := range []bn254.G1Affine{res, forged} {
+		assignment := MultiScalarMulTest[emulated.BN254Fp, emulated.BN254Fr]{
+			Points: []AffinePoint[emulated.BN254Fp]{{
+				X: emulated.ValueOf[emulated.BN254Fp](p.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](p.Y),
+			}},
+			Scalars: []emulated.Element[emulated.BN254Fr]{emulated.ValueOf[emulated.BN254Fr](s)},
+			Res: AffinePoint[emulated.BN254Fp]{
+				X: emulated.ValueOf[emulated.BN254Fp](q.X),
+				Y: emulated.ValueOf[emulated.BN254Fp](q.Y),
+			},
+		}
+		err := test.IsSolved(&circuit, &assignment, testCurve.ScalarField())
+		assert.Error(err)
+	}
+}
+
+func TestScalarMulGLVMaxScalar(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, g := secp256k1.Generators()
+	// s = r-1
+	s := new(big.Int).Sub(fr_secp.Modulus(), big.NewInt(1))
+	var S secp256k1.G1Affine
+	S.Neg(&g)
+
+	circuit := ScalarMulTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{glv: true}
+	witness := ScalarMulTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		S: emulated.ValueOf[emulated.Secp256k1Fr](s),
+		P: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](g.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](g.Y),
+		},
+		Q: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](S.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](S.Y),
+		},
+	}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
+func TestScalarMulGLVBW6761(t *testing.T) {
+	assert := test.NewAssert(t)
+	var r fr_bw6761.Element
+	_, _ = r.SetRandom()
+	s := new(big.Int)
+	r.BigInt(s)
+	var p, res bw6761.G1Affine
+	_, _, gen, _ := bw6761.Generators()
+	p.ScalarMultiplication(&gen, s)
+	_, _ = r.SetRandom()
+	r.BigInt(s)
+	res.ScalarMultiplication(&p, s)
+
+	circuit := ScalarMulTest[emulated.BW6761Fp, emulated.BW6761Fr]{glv: true}
+	witness := ScalarMulTest[emulated.BW6761Fp, emulated.BW6761Fr]{
+		S: emulated.ValueOf[emulated.BW6761Fr](s),
+		P: AffinePoint[emulated.BW6761Fp]{
+			X: emulated.ValueOf[emulated.BW6761Fp](p.X),
+			Y: emulated.ValueOf[emulated.BW6761Fp](p.Y),
+		},
+		Q: AffinePoint[emulated.BW6761Fp]{
+			X: emulated.ValueOf[emulated.BW6761Fp](res.X),
+			Y: emulated.ValueOf[emulated.BW6761Fp](res.Y),
+		},
+	}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
+type JointScalarMulBaseTest[T, S emulated.FieldParams] struct {
+	P, Q   AffinePoint[T]
+	S1, S2 emulated.Element[S]
+	glv    bool
+}
+
+func (c *JointScalarMulBaseTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	var res *AffinePoint[T]
+	if c.glv {
+		res = cr.JointScalarMulBaseGLV(&c.P, &c.S2, &c.S1)
+	} else {
+		res = cr.JointScalarMulBase(&c.P, &c.S2, &c.S1)
+	}
+	cr.AssertIsEqual(res, &c.Q)
+	return nil
+}
+
+func TestJointScalarMulBase(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, g := secp256k1.Generators()
+	var r1, r2, r3 fr_secp.Element
+	_, _ = r1.SetRandom()
+	_, _ = r2.SetRandom()
+	_, _ = r3.SetRandom()
+	var p, res, tmp secp256k1.G1Affine
+	p.ScalarMultiplication(&g, r3.BigInt(new(big.Int)))
+	res.ScalarMultiplication(&g, r1.BigInt(new(big.Int)))
+	tmp.ScalarMultiplication(&p, r2.BigInt(new(big.Int)))
+	res.Add(&res, &tmp)
+
+	witness := JointScalarMulBaseTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		S1: emulated.ValueOf[emulated.Secp256k1Fr](r1),
+		S2: emulated.ValueOf[emulated.Secp256k1Fr](r2),
+		P: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](p.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](p.Y),
+		},
+		Q: AffinePoint[emulated.Secp256k1Fp]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](res.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](res.Y),
+		},
+	}
+	for _, glv := range []bool{false, true} {
+		circuit := JointScalarMulBaseTest[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{glv: glv}
+		err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+		assert.NoError(err, glv)
+	}
+}
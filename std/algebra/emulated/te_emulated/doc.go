@@ -0,0 +1,31 @@
+/*
+Package te_emulated implements elliptic curve group operations in twisted
+Edwards form.
+
+The elliptic curve is the set of points (X,Y) satisfying the equation:
+
+	aX² + Y² = 1 + dX²Y²
+
+over some base field 𝐅p for some constants a, d ∈ 𝐅p. Additionally, for every
+curve we also define its generator (base point) G and the cofactor h. All these
+parameters are stored in the variable of type [CurveParams].
+
+When a is a square and d is a non-square in 𝐅p, the addition law is complete
+and the neutral element (0,1) is a proper point on the curve. In that case we
+do not need to handle any edge cases and the package only exposes the complete
+[Curve.Add] and [Curve.Double] methods. This is the case for all curves for
+which we provide the parameters, see [GetEd25519Params].
+
+Similarly to package [github.com/consensys/gnark/std/algebra/emulated/sw_emulated],
+this package uses type parameters to define the base and scalar fields of the
+points and variables to define the coefficients of the curve. The method
+[GetCurveParams] allows to resolve the curve parameters given the base field
+type parameter.
+
+This package uses field emulation (unlike package
+[github.com/consensys/gnark/std/algebra/native/twistededwards], which requires
+the curve to be defined over the native field). This allows to use any twisted
+Edwards curve over any native (SNARK) field, at the cost of significantly more
+expensive operations.
+*/
+package te_emulated
@@ -0,0 +1,271 @@
+package te_emulated
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// New returns a new [Curve] instance over the base field Base and scalar field
+// Scalars defined by the curve parameters params. It returns an error if
+// initialising the field emulation fails (for example, when the native field is
+// too small) or when the curve parameters are incompatible with the fields.
+func New[Base, Scalars emulated.FieldParams](api frontend.API, params CurveParams) (*Curve[Base, Scalars], error) {
+	ba, err := emulated.NewField[Base](api)
+	if err != nil {
+		return nil, fmt.Errorf("new base api: %w", err)
+	}
+	sa, err := emulated.NewField[Scalars](api)
+	if err != nil {
+		return nil, fmt.Errorf("new scalar api: %w", err)
+	}
+	var fp Base
+	if params.A == nil || params.D == nil || params.Gx == nil || params.Gy == nil {
+		return nil, fmt.Errorf("incomplete curve parameters")
+	}
+	minusOne := new(big.Int).Sub(fp.Modulus(), big.NewInt(1))
+	return &Curve[Base, Scalars]{
+		params:    params,
+		api:       api,
+		baseApi:   ba,
+		scalarApi: sa,
+		g: AffinePoint[Base]{
+			X: emulated.ValueOf[Base](params.Gx),
+			Y: emulated.ValueOf[Base](params.Gy),
+		},
+		a:           emulated.ValueOf[Base](params.A),
+		d:           emulated.ValueOf[Base](params.D),
+		aIsMinusOne: new(big.Int).Mod(params.A, fp.Modulus()).Cmp(minusOne) == 0,
+	}, nil
+}
+
+// Curve is an initialised curve which allows performing group operations.
+type Curve[Base, Scalars emulated.FieldParams] struct {
+	// params is the parameters of the curve
+	params CurveParams
+	// api is the native api, we construct it ourselves to be sure
+	api frontend.API
+	// baseApi is the api for point operations
+	baseApi *emulated.Field[Base]
+	// scalarApi is the api for scalar operations
+	scalarApi *emulated.Field[Scalars]
+
+	// g is the generator (base point) of the curve.
+	g AffinePoint[Base]
+
+	a           emulated.Element[Base]
+	d           emulated.Element[Base]
+	aIsMinusOne bool
+}
+
+// AffinePoint represents a point on the elliptic curve. We do not check that
+// the point is actually on the curve.
+//
+// Point (0,1) represents the neutral element of the group.
+type AffinePoint[Base emulated.FieldParams] struct {
+	X, Y emulated.Element[Base]
+}
+
+// Params returns the parameters of the curve.
+func (c *Curve[B, S]) Params() CurveParams {
+	return c.params
+}
+
+// Generator returns the base point of the curve. The method does not copy and
+// modifying the returned element leads to undefined behaviour!
+func (c *Curve[B, S]) Generator() *AffinePoint[B] {
+	return &c.g
+}
+
+// Neutral returns the neutral element (0,1) of the group.
+func (c *Curve[B, S]) Neutral() *AffinePoint[B] {
+	return &AffinePoint[B]{
+		X: *c.baseApi.Zero(),
+		Y: *c.baseApi.One(),
+	}
+}
+
+// Neg returns an inverse of p. It doesn't modify p.
+func (c *Curve[B, S]) Neg(p *AffinePoint[B]) *AffinePoint[B] {
+	return &AffinePoint[B]{
+		X: *c.baseApi.Neg(&p.X),
+		Y: p.Y,
+	}
+}
+
+// AssertIsEqual asserts that p and q are the same point.
+func (c *Curve[B, S]) AssertIsEqual(p, q *AffinePoint[B]) {
+	c.baseApi.AssertIsEqual(&p.X, &q.X)
+	c.baseApi.AssertIsEqual(&p.Y, &q.Y)
+}
+
+// mulByA returns a*x. When a=-1 (as is the case for Ed25519), it avoids
+// the multiplication.
+func (c *Curve[B, S]) mulByA(x *emulated.Element[B]) *emulated.Element[B] {
+	if c.aIsMinusOne {
+		return c.baseApi.Neg(x)
+	}
+	return c.baseApi.MulMod(&c.a, x)
+}
+
+// AssertIsOnCurve asserts if p belongs to the curve. It doesn't modify p.
+func (c *Curve[B, S]) AssertIsOnCurve(p *AffinePoint[B]) {
+	// aX² + Y² == 1 + dX²Y²
+	xx := c.baseApi.MulMod(&p.X, &p.X)
+	yy := c.baseApi.MulMod(&p.Y, &p.Y)
+	lhs := c.baseApi.Add(c.mulByA(xx), yy)
+	dxxyy := c.baseApi.MulMod(&c.d, c.baseApi.MulMod(xx, yy))
+	rhs := c.baseApi.Add(c.baseApi.One(), dxxyy)
+	c.baseApi.AssertIsEqual(lhs, rhs)
+}
+
+// Add adds p and q and returns it. It doesn't modify p nor q.
+//
+// ✅ p can be equal to q, and either or both can be the neutral element (0,1).
+//
+// It uses the complete addition formulas in affine coordinates. The formulas
+// are complete when a is a square and d is a non-square in the base field. See
+// [BL07] (Theorem 3.3).
+//
+// [BL07]: https://eprint.iacr.org/2007/286
+func (c *Curve[B, S]) Add(p, q *AffinePoint[B]) *AffinePoint[B] {
+	// x = (x1y2 + y1x2) / (1 + dx1x2y1y2)
+	// y = (y1y2 - ax1x2) / (1 - dx1x2y1y2)
+	x1y2 := c.baseApi.MulMod(&p.X, &q.Y)
+	y1x2 := c.baseApi.MulMod(&p.Y, &q.X)
+	x1x2 := c.baseApi.MulMod(&p.X, &q.X)
+	y1y2 := c.baseApi.MulMod(&p.Y, &q.Y)
+	dxy := c.baseApi.MulMod(&c.d, c.baseApi.MulMod(x1x2, y1y2))
+
+	xnum := c.baseApi.Add(x1y2, y1x2)
+	xden := c.baseApi.Add(c.baseApi.One(), dxy)
+	ynum := c.baseApi.Sub(y1y2, c.mulByA(x1x2))
+	yden := c.baseApi.Sub(c.baseApi.One(), dxy)
+
+	return &AffinePoint[B]{
+		X: *c.baseApi.Div(xnum, xden),
+		Y: *c.baseApi.Div(ynum, yden),
+	}
+}
+
+// Double doubles p and return it. It doesn't modify p.
+//
+// ✅ p can be the neutral element (0,1).
+//
+// It uses the dedicated doubling formulas in affine coordinates which do not
+// depend on the coefficient d. See [BL07] (Section 6).
+//
+// [BL07]: https://eprint.iacr.org/2007/286
+func (c *Curve[B, S]) Double(p *AffinePoint[B]) *AffinePoint[B] {
+	// x = 2xy / (ax² + y²)
+	// y = (y² - ax²) / (2 - ax² - y²)
+	xy := c.baseApi.MulMod(&p.X, &p.Y)
+	xx := c.baseApi.MulMod(&p.X, &p.X)
+	yy := c.baseApi.MulMod(&p.Y, &p.Y)
+	axx := c.mulByA(xx)
+
+	xnum := c.baseApi.MulConst(xy, big.NewInt(2))
+	xden := c.baseApi.Add(axx, yy)
+	ynum := c.baseApi.Sub(yy, axx)
+	two := emulated.ValueOf[B](2)
+	yden := c.baseApi.Sub(&two, xden)
+
+	return &AffinePoint[B]{
+		X: *c.baseApi.Div(xnum, xden),
+		Y: *c.baseApi.Div(ynum, yden),
+	}
+}
+
+// Select selects between p and q given the selector b. If b == 1, then returns
+// p and q otherwise.
+func (c *Curve[B, S]) Select(b frontend.Variable, p, q *AffinePoint[B]) *AffinePoint[B] {
+	x := c.baseApi.Select(b, &p.X, &q.X)
+	y := c.baseApi.Select(b, &p.Y, &q.Y)
+	return &AffinePoint[B]{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// Lookup2 performs a 2-bit lookup between i0, i1, i2, i3 based on bits b0
+// and b1. Returns:
+//   - i0 if b0=0 and b1=0,
+//   - i1 if b0=1 and b1=0,
+//   - i2 if b0=0 and b1=1,
+//   - i3 if b0=1 and b1=1.
+func (c *Curve[B, S]) Lookup2(b0, b1 frontend.Variable, i0, i1, i2, i3 *AffinePoint[B]) *AffinePoint[B] {
+	x := c.baseApi.Lookup2(b0, b1, &i0.X, &i1.X, &i2.X, &i3.X)
+	y := c.baseApi.Lookup2(b0, b1, &i0.Y, &i1.Y, &i2.Y, &i3.Y)
+	return &AffinePoint[B]{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// scalarBits returns the bits of the reduced scalar s in little-endian order.
+// The number of returned bits is the bit length of the scalar field modulus.
+//
+// Reduction only ensures that the returned bits represent an integer congruent
+// to s modulo the scalar field modulus. If the point being multiplied is not in
+// the prime order subgroup, then the caller must additionally ensure that the
+// scalar is in range using [emulated.Field.AssertIsInRange].
+func (c *Curve[B, S]) scalarBits(s *emulated.Element[S]) []frontend.Variable {
+	var st S
+	sr := c.scalarApi.Reduce(s)
+	sBits := c.scalarApi.ToBits(sr)
+	return sBits[:st.Modulus().BitLen()]
+}
+
+// ScalarMul computes s * p and returns it. It doesn't modify p nor s.
+// This function doesn't check that the p is on the curve. See AssertIsOnCurve.
+//
+// ✅ p can be the neutral element (0,1) and s can be 0.
+//
+// It computes the standard big-endian double-and-add algorithm using complete
+// formulas.
+func (c *Curve[B, S]) ScalarMul(p *AffinePoint[B], s *emulated.Element[S]) *AffinePoint[B] {
+	sBits := c.scalarBits(s)
+	n := len(sBits)
+
+	res := c.Select(sBits[n-1], p, c.Neutral())
+	for i := n - 2; i >= 0; i-- {
+		res = c.Double(res)
+		tmp := c.Add(res, p)
+		res = c.Select(sBits[i], tmp, res)
+	}
+	return res
+}
+
+// ScalarMulBase computes s * g and returns it, where g is the fixed generator.
+// It doesn't modify s.
+//
+// ✅ s can be 0.
+func (c *Curve[B, S]) ScalarMulBase(s *emulated.Element[S]) *AffinePoint[B] {
+	return c.ScalarMul(c.Generator(), s)
+}
+
+// DoubleBaseScalarMul computes s1 * p1 + s2 * p2 and returns it. It doesn't
+// modify the inputs.
+//
+// ✅ p1 and p2 can be the neutral element (0,1) and s1 and s2 can be 0.
+//
+// It uses the Straus-Shamir trick for sharing the doublings between both scalar
+// multiplications and selects the point to add using a 2-bit lookup.
+func (c *Curve[B, S]) DoubleBaseScalarMul(p1, p2 *AffinePoint[B], s1, s2 *emulated.Element[S]) *AffinePoint[B] {
+	s1Bits := c.scalarBits(s1)
+	s2Bits := c.scalarBits(s2)
+	n := len(s1Bits)
+
+	neutral := c.Neutral()
+	p12 := c.Add(p1, p2)
+
+	res := c.Lookup2(s1Bits[n-1], s2Bits[n-1], neutral, p1, p2, p12)
+	for i := n - 2; i >= 0; i-- {
+		res = c.Double(res)
+		tmp := c.Lookup2(s1Bits[i], s2Bits[i], neutral, p1, p2, p12)
+		res = c.Add(res, tmp)
+	}
+	return res
+}
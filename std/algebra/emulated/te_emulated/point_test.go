@@ -0,0 +1,232 @@
+package te_emulated
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+var testCurve = ecc.BN254
+
+// nativePoint is a reference implementation of the group law used for
+// computing the expected values in the tests.
+type nativePoint struct {
+	X, Y *big.Int
+}
+
+func (p nativePoint) add(params CurveParams, mod *big.Int, q nativePoint) nativePoint {
+	x1y2 := new(big.Int).Mul(p.X, q.Y)
+	y1x2 := new(big.Int).Mul(p.Y, q.X)
+	x1x2 := new(big.Int).Mul(p.X, q.X)
+	y1y2 := new(big.Int).Mul(p.Y, q.Y)
+	dxy := new(big.Int).Mul(params.D, x1x2)
+	dxy.Mul(dxy, y1y2)
+	xden := new(big.Int).Add(big.NewInt(1), dxy)
+	xden.ModInverse(xden.Mod(xden, mod), mod)
+	yden := new(big.Int).Sub(big.NewInt(1), dxy)
+	yden.ModInverse(yden.Mod(yden, mod), mod)
+	x := new(big.Int).Add(x1y2, y1x2)
+	x.Mul(x, xden).Mod(x, mod)
+	y := new(big.Int).Mul(params.A, x1x2)
+	y.Sub(y1y2, y)
+	y.Mul(y, yden).Mod(y, mod)
+	return nativePoint{x, y}
+}
+
+func (p nativePoint) scalarMul(params CurveParams, mod *big.Int, s *big.Int) nativePoint {
+	res := nativePoint{big.NewInt(0), big.NewInt(1)}
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = res.add(params, mod, res)
+		if s.Bit(i) == 1 {
+			res = res.add(params, mod, p)
+		}
+	}
+	return res
+}
+
+func (p nativePoint) emulated() AffinePoint[emulated.Ed25519Fp] {
+	return AffinePoint[emulated.Ed25519Fp]{
+		X: emulated.ValueOf[emulated.Ed25519Fp](p.X),
+		Y: emulated.ValueOf[emulated.Ed25519Fp](p.Y),
+	}
+}
+
+func randomEd25519Point(t *testing.T) (nativePoint, *big.Int) {
+	params := GetEd25519Params()
+	s, err := rand.Int(rand.Reader, emulated.Ed25519Fr{}.Modulus())
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := nativePoint{params.Gx, params.Gy}
+	return g.scalarMul(params, emulated.Ed25519Fp{}.Modulus(), s), s
+}
+
+type OnCurveTest[T, S emulated.FieldParams] struct {
+	P AffinePoint[T]
+}
+
+func (c *OnCurveTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	cr.AssertIsOnCurve(&c.P)
+	cr.AssertIsOnCurve(cr.Generator())
+	cr.AssertIsOnCurve(cr.Neutral())
+	return nil
+}
+
+func TestAssertIsOnCurve(t *testing.T) {
+	assert := test.NewAssert(t)
+	p, _ := randomEd25519Point(t)
+	circuit := OnCurveTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{}
+	witness := OnCurveTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{P: p.emulated()}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+
+	p.Y.Add(p.Y, big.NewInt(1))
+	witness = OnCurveTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{P: p.emulated()}
+	err = test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.Error(err)
+}
+
+type AddTest[T, S emulated.FieldParams] struct {
+	P, Q, R AffinePoint[T]
+}
+
+func (c *AddTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	res := cr.Add(&c.P, &c.Q)
+	cr.AssertIsEqual(res, &c.R)
+	// neutral element
+	res = cr.Add(&c.P, cr.Neutral())
+	cr.AssertIsEqual(res, &c.P)
+	// inverse
+	res = cr.Add(&c.P, cr.Neg(&c.P))
+	cr.AssertIsEqual(res, cr.Neutral())
+	return nil
+}
+
+func TestAdd(t *testing.T) {
+	assert := test.NewAssert(t)
+	params := GetEd25519Params()
+	mod := emulated.Ed25519Fp{}.Modulus()
+	p, _ := randomEd25519Point(t)
+	q, _ := randomEd25519Point(t)
+	r := p.add(params, mod, q)
+	circuit := AddTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{}
+	witness := AddTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{
+		P: p.emulated(),
+		Q: q.emulated(),
+		R: r.emulated(),
+	}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
+type DoubleTest[T, S emulated.FieldParams] struct {
+	P, Q AffinePoint[T]
+}
+
+func (c *DoubleTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	res := cr.Double(&c.P)
+	cr.AssertIsEqual(res, &c.Q)
+	res = cr.Add(&c.P, &c.P)
+	cr.AssertIsEqual(res, &c.Q)
+	res = cr.Double(cr.Neutral())
+	cr.AssertIsEqual(res, cr.Neutral())
+	return nil
+}
+
+func TestDouble(t *testing.T) {
+	assert := test.NewAssert(t)
+	params := GetEd25519Params()
+	mod := emulated.Ed25519Fp{}.Modulus()
+	p, _ := randomEd25519Point(t)
+	q := p.add(params, mod, p)
+	circuit := DoubleTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{}
+	witness := DoubleTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{
+		P: p.emulated(),
+		Q: q.emulated(),
+	}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
+type ScalarMulBaseTest[T, S emulated.FieldParams] struct {
+	S emulated.Element[S]
+	Q AffinePoint[T]
+}
+
+func (c *ScalarMulBaseTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	res := cr.ScalarMulBase(&c.S)
+	cr.AssertIsEqual(res, &c.Q)
+	return nil
+}
+
+func TestScalarMulBase(t *testing.T) {
+	assert := test.NewAssert(t)
+	q, s := randomEd25519Point(t)
+	circuit := ScalarMulBaseTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{}
+	witness := ScalarMulBaseTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{
+		S: emulated.ValueOf[emulated.Ed25519Fr](s),
+		Q: q.emulated(),
+	}
+	err := test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+}
+
+type DoubleBaseScalarMulTest[T, S emulated.FieldParams] struct {
+	P1, P2 AffinePoint[T]
+	S1, S2 emulated.Element[S]
+	Q      AffinePoint[T]
+}
+
+func (c *DoubleBaseScalarMulTest[T, S]) Define(api frontend.API) error {
+	cr, err := New[T, S](api, GetCurveParams[T]())
+	if err != nil {
+		return err
+	}
+	res := cr.DoubleBaseScalarMul(&c.P1, &c.P2, &c.S1, &c.S2)
+	cr.AssertIsEqual(res, &c.Q)
+	return nil
+}
+
+func TestDoubleBaseScalarMul(t *testing.T) {
+	assert := test.NewAssert(t)
+	params := GetEd25519Params()
+	mod := emulated.Ed25519Fp{}.Modulus()
+	p1, _ := randomEd25519Point(t)
+	p2, _ := randomEd25519Point(t)
+	s1, err := rand.Int(rand.Reader, emulated.Ed25519Fr{}.Modulus())
+	assert.NoError(err)
+	s2, err := rand.Int(rand.Reader, emulated.Ed25519Fr{}.Modulus())
+	assert.NoError(err)
+	q := p1.scalarMul(params, mod, s1).add(params, mod, p2.scalarMul(params, mod, s2))
+	circuit := DoubleBaseScalarMulTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{}
+	witness := DoubleBaseScalarMulTest[emulated.Ed25519Fp, emulated.Ed25519Fr]{
+		P1: p1.emulated(),
+		P2: p2.emulated(),
+		S1: emulated.ValueOf[emulated.Ed25519Fr](s1),
+		S2: emulated.ValueOf[emulated.Ed25519Fr](s2),
+		Q:  q.emulated(),
+	}
+	err = test.IsSolved(&circuit, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+}
@@ -0,0 +1,65 @@
+package algebra
+
+// Curve defines the group operations on the first group G1 of an elliptic
+// curve, where G1El is the type of the points and Scalar the type of the
+// scalars. Both the native (2-chain) and emulated curve implementations in the
+// sub-packages implement this interface, which allows to write gadgets once
+// and instantiate them over any of the supported curves.
+type Curve[G1El any, Scalar any] interface {
+	// Add adds two points and returns the sum. It does not modify the input
+	// points. The points must be non-zero and not equal or opposite to each
+	// other.
+	Add(*G1El, *G1El) *G1El
+
+	// AddUnified adds two points and returns the sum. It does not modify the
+	// input points. Unlike Add, it uses complete formulas and the points can
+	// be equal, opposite or (0,0), which represents the point at infinity.
+	AddUnified(*G1El, *G1El) *G1El
+
+	// AssertIsEqual asserts that two points are equal.
+	AssertIsEqual(*G1El, *G1El)
+
+	// Neg negates the point and returns it. It does not modify the input
+	// point.
+	Neg(*G1El) *G1El
+
+	// ScalarMul computes scalar*point and returns it. It does not modify the
+	// inputs. It returns (0,0) if the scalar is zero or the point is (0,0).
+	ScalarMul(*G1El, *Scalar) *G1El
+
+	// ScalarMulBase computes scalar*generator and returns it, where the
+	// generator is the fixed generator of G1. It does not modify the scalar.
+	// It returns (0,0) if the scalar is zero.
+	ScalarMulBase(*Scalar) *G1El
+
+	// MultiScalarMul computes the sum of scalar_i*point_i and returns it. It
+	// does not modify the inputs. It returns an error if the input slices are
+	// of different lengths or empty.
+	MultiScalarMul([]*G1El, []*Scalar) (*G1El, error)
+}
+
+// Pairing defines the pairing between the groups G1 and G2 with the values in
+// the target group GT, where G1El, G2El and GtEl are the types of the elements
+// of the respective groups.
+type Pairing[G1El any, G2El any, GtEl any] interface {
+	// MillerLoop computes the product of the Miller loops for the pairs of
+	// points. It returns an error if the input slices are of different lengths
+	// or empty.
+	MillerLoop([]*G1El, []*G2El) (*GtEl, error)
+
+	// FinalExponentiation computes the final exponentiation of the input and
+	// returns it.
+	FinalExponentiation(*GtEl) *GtEl
+
+	// Pair computes the product of the pairings for the pairs of points. It
+	// returns an error if the input slices are of different lengths or empty.
+	Pair([]*G1El, []*G2El) (*GtEl, error)
+
+	// PairingCheck asserts that the product of the pairings for the pairs of
+	// points is one. It returns an error if the input slices are of different
+	// lengths or empty.
+	PairingCheck([]*G1El, []*G2El) error
+
+	// AssertIsEqual asserts that two target group elements are equal.
+	AssertIsEqual(*GtEl, *GtEl)
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
 	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
 	"github.com/consensys/gnark/constraint"
 	"github.com/consensys/gnark/frontend"
@@ -471,3 +472,132 @@ func BenchmarkVarScalarMulG1(b *testing.B) {
 	b.Log("plonk", ccsBench.GetNbConstraints())
 
 }
+
+// -------------------------------------------------------------------------------------------------
+// Subgroup membership
+
+type g1AssertIsOnG1 struct {
+	A G1Affine
+}
+
+func (circuit *g1AssertIsOnG1) Define(api frontend.API) error {
+	circuit.A.AssertIsOnG1(api)
+	return nil
+}
+
+func TestAssertIsOnG1(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	// point in G1
+	aJac := randomPointG1()
+	var a bls12377.G1Affine
+	a.FromJacobian(&aJac)
+	var witness g1AssertIsOnG1
+	witness.A.Assign(&a)
+	assert.SolvingSucceeded(&g1AssertIsOnG1{}, &witness, test.WithCurves(ecc.BW6_761), test.NoFuzzing())
+
+	// point on the curve but not in G1
+	var x, y, one fp.Element
+	one.SetOne()
+	for {
+		x.SetRandom()
+		y.Square(&x).Mul(&y, &x).Add(&y, &one)
+		if y.Legendre() == 1 {
+			y.Sqrt(&y)
+			break
+		}
+	}
+	b := bls12377.G1Affine{X: x, Y: y}
+	assert.False(b.IsInSubGroup())
+	witness.A.Assign(&b)
+	assert.SolvingFailed(&g1AssertIsOnG1{}, &witness, test.WithCurves(ecc.BW6_761), test.NoFuzzing())
+}
+
+type g1MultiScalarMul struct {
+	Points  [4]G1Affine
+	Scalars [4]frontend.Variable
+	C       G1Affine `gnark:",public"`
+}
+
+func (circuit *g1MultiScalarMul) Define(api frontend.API) error {
+	res, err := MultiScalarMul(api, circuit.Points[:], circuit.Scalars[:])
+	if err != nil {
+		return err
+	}
+	res.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestMultiScalarMulG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	var circuit, witness g1MultiScalarMul
+	var points [4]bls12377.G1Affine
+	var scalars [4]fr.Element
+	for i := range points {
+		p := randomPointG1()
+		points[i].FromJacobian(&p)
+		_, _ = scalars[i].SetRandom()
+	}
+	// repeated point and small scalars
+	points[3] = points[2]
+	scalars[2].SetZero()
+	scalars[3].SetOne()
+	var c bls12377.G1Affine
+	_, err := c.MultiExp(points[:], scalars[:], ecc.MultiExpConfig{})
+	assert.NoError(err)
+	for i := range points {
+		witness.Points[i].Assign(&points[i])
+		witness.Scalars[i] = scalars[i].String()
+	}
+	witness.C.Assign(&c)
+	assert.SolvingSucceeded(&circuit, &witness, test.WithCurves(ecc.BW6_761))
+}
+
+// g1MultiScalarMulWrong asserts that the MSM is different from C.
+type g1MultiScalarMulWrong struct {
+	Points  [4]G1Affine
+	Scalars [4]frontend.Variable
+	C       G1Affine `gnark:",public"`
+}
+
+func (circuit *g1MultiScalarMulWrong) Define(api frontend.API) error {
+	res, err := MultiScalarMul(api, circuit.Points[:], circuit.Scalars[:])
+	if err != nil {
+		return err
+	}
+	api.AssertIsDifferent(res.X, circuit.C.X)
+	return nil
+}
+
+func TestMultiScalarMulG1OffsetCollision(t *testing.T) {
+	assert := test.NewAssert(t)
+	// Q_0 = [2^-w]R is such that the first addition to the accumulator is
+	// R+R, for which the incomplete addition would not constrain the result.
+	cc := getInnerCurveConfig(ecc.BW6_761.ScalarField())
+	nbits := cc.lambda.BitLen() + 3
+	nbWindows := (nbits - 1 + msmWindowSize - 1) / msmWindowSize
+	R, _ := msmOffset((nbWindows - 1) * msmWindowSize)
+	var e fr.Element
+	e.SetUint64(1 << msmWindowSize).Inverse(&e)
+
+	var points [4]bls12377.G1Affine
+	var scalars [4]fr.Element
+	points[0].ScalarMultiplication(&R, e.BigInt(new(big.Int)))
+	_, _ = scalars[0].SetRandom()
+	for i := 1; i < len(points); i++ {
+		p := randomPointG1()
+		points[i].FromJacobian(&p)
+		_, _ = scalars[i].SetRandom()
+	}
+	var c bls12377.G1Affine
+	_, err := c.MultiExp(points[:], scalars[:], ecc.MultiExpConfig{})
+	assert.NoError(err)
+
+	var circuit, witness g1MultiScalarMulWrong
+	for i := range points {
+		witness.Points[i].Assign(&points[i])
+		witness.Scalars[i] = scalars[i].String()
+	}
+	witness.C.Assign(&c)
+	assert.SolvingFailed(&circuit, &witness, test.WithCurves(ecc.BW6_761))
+}
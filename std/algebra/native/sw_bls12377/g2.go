@@ -78,6 +78,56 @@ func (p *G2Affine) AddAssign(api frontend.API, p1 G2Affine) *G2Affine {
 	return p
 }
 
+// AddUnified adds p1 to p and returns p.
+//
+// ✅ p can be equal to p1, and either or both can be (0,0). (0,0) is not on
+// the curve but we conventionally take it as the neutral/infinity point.
+//
+// It uses the unified formulas of Brier and Joye ([[BriJoy02]] (Corollary 1)).
+//
+// [BriJoy02]: https://link.springer.com/content/pdf/10.1007/3-540-45664-3_24.pdf
+func (p *G2Affine) AddUnified(api frontend.API, p1 G2Affine) *G2Affine {
+	isZero := func(e fields_bls12377.E2) frontend.Variable {
+		return api.And(api.IsZero(e.A0), api.IsZero(e.A1))
+	}
+	// selector1 = 1 when p is (0,0) and 0 otherwise
+	selector1 := api.And(isZero(p.X), isZero(p.Y))
+	// selector2 = 1 when p1 is (0,0) and 0 otherwise
+	selector2 := api.And(isZero(p1.X), isZero(p1.Y))
+
+	// λ = ((p.x+p1.x)² - p.x*p1.x)/(p.y + p1.y), here we assume a=0
+	var pxp1x, pxplusp1x, num, denum, one, l, xr, yr fields_bls12377.E2
+	pxp1x.Mul(api, p.X, p1.X)
+	pxplusp1x.Add(api, p.X, p1.X)
+	num.Square(api, pxplusp1x).Sub(api, num, pxp1x)
+	denum.Add(api, p.Y, p1.Y)
+	// if p.y + p1.y = 0, assign dummy 1 to denum and continue
+	selector3 := isZero(denum)
+	one.SetOne()
+	denum.Select(api, selector3, one, denum)
+	l.DivUnchecked(api, num, denum)
+
+	// xr = λ²-p.x-p1.x
+	xr.Square(api, l).Sub(api, xr, pxplusp1x)
+
+	// yr = λ(p.x-xr) - p.y
+	yr.Sub(api, p.X, xr).Mul(api, l, yr).Sub(api, yr, p.Y)
+
+	var res, zero G2Affine
+	res.X, res.Y = xr, yr
+	zero.X.SetZero()
+	zero.Y.SetZero()
+	// if p=(0,0) return p1
+	res.Select(api, selector1, p1, res)
+	// if p1=(0,0) return p
+	res.Select(api, selector2, *p, res)
+	// if p.y + p1.y = 0, return (0,0)
+	res.Select(api, selector3, zero, res)
+
+	p.X, p.Y = res.X, res.Y
+	return p
+}
+
 // AddAssign adds 2 point in Jacobian coordinates
 // p=p, a=p1
 func (p *G2Jac) AddAssign(api frontend.API, p1 *G2Jac) *G2Jac {
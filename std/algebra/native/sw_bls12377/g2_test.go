@@ -108,6 +108,58 @@ func TestAddAssignAffineG2(t *testing.T) {
 
 }
 
+// -------------------------------------------------------------------------------------------------
+// Add unified
+
+type g2AddUnified struct {
+	A, B G2Affine
+	C    G2Affine `gnark:",public"`
+}
+
+func (circuit *g2AddUnified) Define(api frontend.API) error {
+	expected := circuit.A
+	expected.AddUnified(api, circuit.B)
+	expected.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestAddUnifiedG2(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	_a := randomPointG2()
+	_b := randomPointG2()
+	var a, b, negA, infinity bls12377.G2Affine
+	a.FromJacobian(&_a)
+	b.FromJacobian(&_b)
+	negA.Neg(&a)
+
+	for _, tc := range []struct {
+		name string
+		a, b bls12377.G2Affine
+	}{
+		{"distinct", a, b},
+		{"equal", a, a},
+		{"inverse", a, negA},
+		{"left infinity", infinity, b},
+		{"right infinity", a, infinity},
+		{"both infinity", infinity, infinity},
+	} {
+		assert.Run(func(assert *test.Assert) {
+			var _c bls12377.G2Jac
+			var c bls12377.G2Affine
+			_c.FromAffine(&tc.a)
+			_c.AddMixed(&tc.b)
+			c.FromJacobian(&_c)
+
+			var witness g2AddUnified
+			witness.A.Assign(&tc.a)
+			witness.B.Assign(&tc.b)
+			witness.C.Assign(&c)
+			assert.SolvingSucceeded(&g2AddUnified{}, &witness, test.WithCurves(ecc.BW6_761), test.NoFuzzing())
+		}, tc.name)
+	}
+}
+
 // -------------------------------------------------------------------------------------------------
 // Double Jacobian
 
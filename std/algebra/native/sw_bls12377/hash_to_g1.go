@@ -0,0 +1,196 @@
+package sw_bls12377
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/cmp"
+)
+
+// constants of the simplified SWU map to the curve E1' isogenous to G1 and of
+// the 2-isogeny E1' → E1. The isogeny coefficients are given in increasing
+// degree order.
+var (
+	sswuA = newInt("258664426012969092796408009721202742408018065645352501567204841856062976176281513834280849065051431927238430294002")
+	sswuB = big.NewInt(22)
+	sswuZ = big.NewInt(5)
+
+	isoXNum = []*big.Int{
+		newInt("193998319509726820447277314072485610595876362210707887456279225959507476652652651634192264150953923683470146535424"),
+		newInt("40474824132456359704279181570318738632422647360355249739068643631356267969150730939906729705473"),
+		newInt("193998319509726820507989550271170150152295134566185995404913197000040351261255617081226666104680020093330241093633"),
+	}
+	isoXDen = []*big.Int{
+		newInt("161899296529825438817116726281274954529690589441420998956274574525425071876602923759626918821892"),
+	}
+	isoYNum = []*big.Int{
+		newInt("193998319509726820507989550271170150152295134566185995404913197000040351261255617081226666104680020093330241093631"),
+		newInt("32333053251621136903112182208573040583096119983059602439070460434672245065050016464457115901761911040205276577794"),
+		newInt("129332213006484547066038603046131306324615528732935438218576102373893108782773376834518846023512776472080255287298"),
+		newInt("226331372761347957259321141983031841844344323660550327972398729833380409804798219928097777122126690108885281275905"),
+	}
+	isoYDen = []*big.Int{
+		newInt("258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458169"),
+		newInt("971395779178952632902700357687649727178143536648525993737647447152550431259617542557761512931340"),
+		newInt("485697889589476316451350178843824863589071768324262996868823723576275215629808771278880756465676"),
+	}
+)
+
+func newInt(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic("invalid integer")
+	}
+	return v
+}
+
+func init() {
+	solver.RegisterHint(SSWUSqrtHint)
+}
+
+// SSWUSqrtHint computes the square root used in the simplified SWU map. It
+// returns a square root of gx1 if gx1 is a square and a square root of gx2
+// otherwise. The parity of the returned root matches the parity of u.
+func SSWUSqrtHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != 3 || len(outputs) != 1 {
+		return errors.New("expecting three inputs and one output")
+	}
+	gx1, gx2, u := inputs[0], inputs[1], inputs[2]
+	y := new(big.Int)
+	if big.Jacobi(gx1, mod) != -1 {
+		y.ModSqrt(gx1, mod)
+	} else if y.ModSqrt(gx2, mod) == nil {
+		return errors.New("neither gx1 nor gx2 is a square")
+	}
+	if y.Bit(0) != new(big.Int).Mod(u, mod).Bit(0) {
+		y.Sub(mod, y).Mod(y, mod)
+	}
+	outputs[0].Set(y)
+	return nil
+}
+
+// MapToG1 maps the field elements u0 and u1 to a point in G1 as in the
+// hash_to_curve procedure of RFC 9380 Section 3 for the suite
+// BLS12377G1_XMD:SHA-256_SSWU_RO_. The elements u0 and u1 are the outputs of
+// hash_to_field and must be in the native field (BLS12-377 base field).
+//
+// Both elements are mapped to the isogenous curve using the simplified SWU map,
+// mapped to the curve using the 2-isogeny, added and finally the cofactor is
+// cleared.
+func MapToG1(api frontend.API, u0, u1 frontend.Variable) G1Affine {
+	q0 := mapToIsogenousCurve(api, u0)
+	q0 = isogeny(api, q0)
+	q1 := mapToIsogenousCurve(api, u1)
+	q1 = isogeny(api, q1)
+	q0.AddAssign(api, q1)
+	return clearCofactor(api, q0)
+}
+
+// mapToIsogenousCurve implements the simplified SWU map to the curve E1' as
+// defined in RFC 9380 Section 6.6.2.
+//
+// The square root is computed in a hint and we only check that it is the
+// square root of either gx1 or gx2. This is sound as Z is not a square and
+// gx2 = Z³u⁶gx1, so that exactly one of gx1 and gx2 is a square.
+func mapToIsogenousCurve(api frontend.API, u frontend.Variable) G1Affine {
+	p := api.Compiler().Field()
+	// c1 = -B/A and c2 = B/(Z*A)
+	c1 := new(big.Int).ModInverse(sswuA, p)
+	c1.Mul(c1, sswuB).Neg(c1).Mod(c1, p)
+	c2 := new(big.Int).Mul(sswuZ, sswuA)
+	c2.ModInverse(c2, p).Mul(c2, sswuB).Mod(c2, p)
+
+	// tv1 = Z²u⁴ + Zu²
+	zu2 := api.Mul(sswuZ, u, u)
+	tv1 := api.Add(api.Mul(zu2, zu2), zu2)
+	// x1 = (-B/A) * (1 + 1/tv1), or B/(Z*A) if tv1 = 0
+	tv1IsZero := api.IsZero(tv1)
+	den := api.Select(tv1IsZero, 1, tv1)
+	x1 := api.DivUnchecked(api.Mul(c1, api.Add(den, 1)), den)
+	x1 = api.Select(tv1IsZero, c2, x1)
+	gx1 := evalCurve(api, x1)
+	// x2 = Zu² * x1
+	x2 := api.Mul(zu2, x1)
+	gx2 := evalCurve(api, x2)
+
+	res, err := api.Compiler().NewHint(SSWUSqrtHint, 1, gx1, gx2, u)
+	if err != nil {
+		panic(err)
+	}
+	y := res[0]
+
+	// y² = gx1 if gx1 is square and y² = gx2 otherwise
+	yy := api.Mul(y, y)
+	isGx1 := api.IsZero(api.Sub(yy, gx1))
+	api.AssertIsEqual(yy, api.Select(isGx1, gx1, gx2))
+	x := api.Select(isGx1, x1, x2)
+
+	// sgn0(u) = sgn0(y)
+	api.AssertIsEqual(sgn0(api, u), sgn0(api, y))
+
+	return G1Affine{X: x, Y: y}
+}
+
+// evalCurve returns x³ + Ax + B for the coefficients A and B of the isogenous
+// curve E1'.
+func evalCurve(api frontend.API, x frontend.Variable) frontend.Variable {
+	return api.Add(api.Mul(x, x, x), api.Mul(sswuA, x), sswuB)
+}
+
+// sgn0 returns the sign of v as defined in RFC 9380 Section 4.1, which is the
+// parity of the canonical representation of v.
+func sgn0(api frontend.API, v frontend.Variable) frontend.Variable {
+	vBits := bits.ToBinary(api, v)
+	// the binary decomposition is not unique for values less than 2^n - p, so
+	// we additionally check that it is less than the modulus.
+	p := api.Compiler().Field()
+	pBits := make([]frontend.Variable, len(vBits))
+	for i := range pBits {
+		pBits[i] = p.Bit(i)
+	}
+	api.AssertIsEqual(cmp.IsLessBinary(api, vBits, pBits), 1)
+	return vBits[0]
+}
+
+// isogeny maps the point p on the curve E1' to the curve E1 using the
+// 2-isogeny.
+func isogeny(api frontend.API, p G1Affine) G1Affine {
+	xNum := evalPolynomial(api, false, isoXNum, p.X)
+	xDen := evalPolynomial(api, true, isoXDen, p.X)
+	yNum := evalPolynomial(api, false, isoYNum, p.X)
+	yDen := evalPolynomial(api, true, isoYDen, p.X)
+
+	return G1Affine{
+		X: api.DivUnchecked(xNum, xDen),
+		Y: api.DivUnchecked(api.Mul(p.Y, yNum), yDen),
+	}
+}
+
+// evalPolynomial evaluates the polynomial with the given coefficients in
+// increasing degree order at x using the Horner's method. If monic is set,
+// then the leading coefficient 1 is omitted from coefficients.
+func evalPolynomial(api frontend.API, monic bool, coefficients []*big.Int, x frontend.Variable) frontend.Variable {
+	var dst frontend.Variable = coefficients[len(coefficients)-1]
+	if monic {
+		dst = api.Add(dst, x)
+	}
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		dst = api.Mul(dst, x)
+		dst = api.Add(dst, coefficients[i])
+	}
+	return dst
+}
+
+// clearCofactor maps the point q on the curve to G1 by computing q - [x₀]q
+// where x₀ is the seed of the curve. See https://eprint.iacr.org/2019/403.pdf,
+// Section 5.
+func clearCofactor(api frontend.API, q G1Affine) G1Affine {
+	var res G1Affine
+	res.scalarMulBySeed(api, q)
+	res.Neg(api, res)
+	res.AddAssign(api, q)
+	return res
+}
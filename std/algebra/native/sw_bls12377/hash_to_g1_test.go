@@ -0,0 +1,38 @@
+package sw_bls12377
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fp"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type mapToG1Circuit struct {
+	U0, U1 frontend.Variable
+	Res    G1Affine
+}
+
+func (c *mapToG1Circuit) Define(api frontend.API) error {
+	res := MapToG1(api, c.U0, c.U1)
+	res.AssertIsEqual(api, c.Res)
+	return nil
+}
+
+func TestMapToG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, msg := range []string{"", "abc", "abcdef0123456789"} {
+		dst := []byte("QUUX-V01-CS02-with-BLS12377G1_XMD:SHA-256_SSWU_RO_")
+		u, err := fp.Hash([]byte(msg), dst, 2)
+		assert.NoError(err)
+		res, err := bls12377.HashToG1([]byte(msg), dst)
+		assert.NoError(err)
+		var witness mapToG1Circuit
+		witness.U0 = u[0].String()
+		witness.U1 = u[1].String()
+		witness.Res.Assign(&res)
+		assert.SolvingSucceeded(&mapToG1Circuit{}, &witness, test.WithCurves(ecc.BW6_761), test.NoFuzzing())
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package sw_bls24315
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc"
@@ -24,6 +25,7 @@ import (
 	"github.com/consensys/gnark-crypto/ecc/bw6-633/fr"
 	"github.com/consensys/gnark/constraint/solver"
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
 )
 
 // G1Jac point in Jacobian coords
@@ -68,6 +70,49 @@ func (p *G1Affine) AddAssign(api frontend.API, p1 G1Affine) *G1Affine {
 	return p
 }
 
+// AddUnified adds p1 to p and returns p.
+//
+// ✅ p can be equal to p1, and either or both can be (0,0). (0,0) is not on
+// the curve but we conventionally take it as the neutral/infinity point.
+//
+// It uses the unified formulas of Brier and Joye ([[BriJoy02]] (Corollary 1)).
+//
+// [BriJoy02]: https://link.springer.com/content/pdf/10.1007/3-540-45664-3_24.pdf
+func (p *G1Affine) AddUnified(api frontend.API, p1 G1Affine) *G1Affine {
+	// selector1 = 1 when p is (0,0) and 0 otherwise
+	selector1 := api.And(api.IsZero(p.X), api.IsZero(p.Y))
+	// selector2 = 1 when p1 is (0,0) and 0 otherwise
+	selector2 := api.And(api.IsZero(p1.X), api.IsZero(p1.Y))
+
+	// λ = ((p.x+p1.x)² - p.x*p1.x)/(p.y + p1.y), here we assume a=0
+	pxp1x := api.Mul(p.X, p1.X)
+	pxplusp1x := api.Add(p.X, p1.X)
+	num := api.Sub(api.Mul(pxplusp1x, pxplusp1x), pxp1x)
+	denum := api.Add(p.Y, p1.Y)
+	// if p.y + p1.y = 0, assign dummy 1 to denum and continue
+	selector3 := api.IsZero(denum)
+	denum = api.Select(selector3, 1, denum)
+	l := api.DivUnchecked(num, denum)
+
+	// xr = λ²-p.x-p1.x
+	xr := api.Sub(api.Mul(l, l), pxplusp1x)
+
+	// yr = λ(p.x-xr) - p.y
+	yr := api.Sub(api.Mul(l, api.Sub(p.X, xr)), p.Y)
+
+	var res G1Affine
+	res.X, res.Y = xr, yr
+	// if p=(0,0) return p1
+	res.Select(api, selector1, p1, res)
+	// if p1=(0,0) return p
+	res.Select(api, selector2, *p, res)
+	// if p.y + p1.y = 0, return (0,0)
+	res.Select(api, selector3, G1Affine{X: 0, Y: 0}, res)
+
+	p.X, p.Y = res.X, res.Y
+	return p
+}
+
 // AddAssign adds 2 point in Jacobian coordinates
 // p=p, a=p1
 func (p *G1Jac) AddAssign(api frontend.API, p1 G1Jac) *G1Jac {
@@ -451,6 +496,8 @@ func (p *G1Affine) DoubleAndAdd(api frontend.API, p1, p2 *G1Affine) *G1Affine {
 }
 
 // ScalarMulBase computes s * g1 and returns it, where g1 is the fixed generator. It doesn't modify s.
+//
+// ✅ When s=0, it returns (0,0).
 func (P *G1Affine) ScalarMulBase(api frontend.API, s frontend.Variable) *G1Affine {
 
 	points := getCurvePoints()
@@ -473,8 +520,10 @@ func (P *G1Affine) ScalarMulBase(api frontend.API, s frontend.Variable) *G1Affin
 	}
 
 	// i = 0
+	// we use AddUnified here instead of AddAssign so that when s=0, res=(0,0)
+	// because AddUnified(-g1, g1) = (0,0)
 	tmp.Neg(api, G1Affine{points.G1x, points.G1y})
-	tmp.AddAssign(api, res)
+	tmp.AddUnified(api, res)
 	res.Select(api, sBits[0], res, tmp)
 
 	P.X = res.X
@@ -482,3 +531,214 @@ func (P *G1Affine) ScalarMulBase(api frontend.API, s frontend.Variable) *G1Affin
 
 	return P
 }
+
+// decomposeScalarG1Odd decomposes the scalar s into s1 and s2 such that
+//
+//	s1 + λ * s2 == s + k*r
+//
+// where s1 and s2 are non-negative and odd. It returns s1, s2 and k+1 (which is
+// non-negative for s < 2r).
+var decomposeScalarG1Odd = func(scalarField *big.Int, inputs []*big.Int, res []*big.Int) error {
+	cc := getInnerCurveConfig(scalarField)
+	sp := ecc.SplitScalar(inputs[0], cc.glvBasis)
+	// (λ+1, λ) and (-λ, 1) are in the kernel of (s1, s2) -> s1 + λ * s2 mod r
+	// and generate all the parities. We add them to make s1 and s2 odd.
+	lambda1 := new(big.Int).Add(cc.lambda, big.NewInt(1))
+	for c := 0; c < 4; c++ {
+		res[0].Set(&sp[0])
+		res[1].Set(&sp[1])
+		if c&1 == 1 {
+			res[0].Add(res[0], lambda1)
+			res[1].Add(res[1], cc.lambda)
+		}
+		if c&2 == 2 {
+			res[0].Sub(res[0], cc.lambda)
+			res[1].Add(res[1], big.NewInt(1))
+		}
+		if res[0].Bit(0) == 1 && res[1].Bit(0) == 1 {
+			break
+		}
+	}
+	// add 2*(λ+1, λ) until both are non-negative, this keeps the parities.
+	for res[0].Sign() < 0 || res[1].Sign() < 0 {
+		res[0].Add(res[0], lambda1)
+		res[0].Add(res[0], lambda1)
+		res[1].Add(res[1], cc.lambda)
+		res[1].Add(res[1], cc.lambda)
+	}
+	res[2].Mul(res[1], cc.lambda).Add(res[2], res[0])
+	res[2].Sub(res[2], inputs[0])
+	res[2].Div(res[2], cc.fr)
+	res[2].Add(res[2], big.NewInt(1))
+	return nil
+}
+
+func init() {
+	solver.RegisterHint(decomposeScalarG1Odd)
+}
+
+// msmWindowSize is the number of scalar bits processed at once in
+// [MultiScalarMul].
+const msmWindowSize = 4
+
+// MultiScalarMul computes ∑ [s_i] Q_i and returns it. It returns an error if
+// there is a mismatch in the lengths of the inputs or if the inputs are empty.
+//
+// ⚠️  The scalars must be smaller than 2^n where n is the bit length of the
+// BLS24-315 scalar field modulus and the points Q_i must be in G1.
+//
+// It combines the GLV decomposition with the interleaved windowed
+// multi-scalar multiplication algorithm (Straus), so that all the points Q_i
+// and Φ(Q_i) share the doublings. The hint decomposes the scalars into odd
+// sub-scalars, which are recoded into odd signed digits in the set {±1, ±3,
+// ..., ±(2^w-1)} directly from their bits. The w-bit windows of the bits are
+// the indices in the tables of the multiples [-(2^w-1)]Q_i, ..., [2^w-1]Q_i,
+// which are queried using log-derivative lookups. The table for Φ(Q_i) is
+// obtained from the table for Q_i as Φ only scales the x-coordinate.
+//
+// As we use incomplete formulas for the addition law, the accumulator is
+// initialised with a fixed point R and the corresponding multiple of R is
+// subtracted at the end.
+//
+// ⚠️  The inputs for which the accumulator equals ± a point to add are
+// rejected, for example Q_i = [2^-w mod r]R for which the first addition is
+// R + R. R is derived by hashing to the curve, so such inputs are reachable
+// only with a known discrete logarithm relation between Q_i and R. Honestly
+// generated inputs hit them with negligible probability, but an adversary
+// choosing the points can make the proof fail.
+func MultiScalarMul(api frontend.API, Q []G1Affine, s []frontend.Variable) (G1Affine, error) {
+	var res G1Affine
+	if len(Q) != len(s) {
+		return res, fmt.Errorf("mismatching points and scalars slice lengths")
+	}
+	if len(Q) == 0 {
+		return res, fmt.Errorf("no inputs")
+	}
+	cc := getInnerCurveConfig(api.Compiler().Field())
+	// the decomposed scalars are at most two bits longer than λ due to the
+	// parity and sign adjustments and an additional bit for k.
+	nbits := cc.lambda.BitLen() + 3
+	nbitsK := nbits + cc.lambda.BitLen() - cc.fr.BitLen() + 2
+	// s1|1 = 2^L + ∑_{j<L} (2b_{j+1}-1) 2^j where L is a multiple of the
+	// window size and b_j are the bits of s1. Same for s2.
+	nbWindows := (nbits - 1 + msmWindowSize - 1) / msmWindowSize
+	half := 1 << (msmWindowSize - 1)
+
+	R, Rn := msmOffset((nbWindows - 1) * msmWindowSize)
+	var acc G1Affine
+	acc.Assign(&R)
+	// addToAcc adds q to the accumulator. The addition is incomplete, so we
+	// assert that the x coordinates differ as otherwise λ = 0/0 would be
+	// unconstrained when acc = q.
+	addToAcc := func(q G1Affine) {
+		api.AssertIsDifferent(acc.X, q.X)
+		acc.AddAssign(api, q)
+	}
+	windows := make([][2][]frontend.Variable, len(Q))
+	tablesX := make([]*logderivlookup.Table, len(Q))
+	tablesY := make([]*logderivlookup.Table, len(Q))
+	for i := range Q {
+		// s must be reduced for the integer relation below to hold
+		api.ToBinary(s[i], cc.fr.BitLen())
+		sd, err := api.Compiler().NewHint(decomposeScalarG1Odd, 3, s[i])
+		if err != nil {
+			// err is non-nil only for invalid number of inputs
+			panic(err)
+		}
+		//     s1 + λ * s2 + r == s + (k+1)*r
+		// all the terms are small enough for the equality to hold over the
+		// integers.
+		api.AssertIsEqual(
+			api.Add(sd[0], api.Mul(sd[1], cc.lambda), cc.fr),
+			api.Add(s[i], api.Mul(cc.fr, sd[2])),
+		)
+		api.ToBinary(sd[2], nbitsK)
+		for k := 0; k < 2; k++ {
+			bits := api.ToBinary(sd[k], nbits)
+			api.AssertIsEqual(bits[0], 1)
+			windows[i][k] = make([]frontend.Variable, nbWindows)
+			for j := range windows[i][k] {
+				var w frontend.Variable = 0
+				for l := 0; l < msmWindowSize; l++ {
+					if pos := j*msmWindowSize + l + 1; pos < nbits {
+						w = api.Add(w, api.Mul(bits[pos], 1<<l))
+					}
+				}
+				windows[i][k][j] = w
+			}
+		}
+		// table of the multiples [2j-(2^w-1)]Q_i for j=0..2^w-1. We compute
+		// the positive odd multiples and obtain the others by negation.
+		xs := make([]frontend.Variable, 2*half)
+		ys := make([]frontend.Variable, 2*half)
+		var double, tmp G1Affine
+		double.Double(api, Q[i])
+		tmp = Q[i]
+		for j := 0; j < half; j++ {
+			if j > 0 {
+				tmp.AddAssign(api, double)
+			}
+			xs[half+j], ys[half+j] = tmp.X, tmp.Y
+			xs[half-1-j], ys[half-1-j] = tmp.X, api.Neg(tmp.Y)
+		}
+		tablesX[i] = logderivlookup.New(api)
+		tablesY[i] = logderivlookup.New(api)
+		for j := range xs {
+			tablesX[i].Insert(xs[j])
+			tablesY[i].Insert(ys[j])
+		}
+		// the leading 2^L terms are [2^w]Q_i and [2^w]Φ(Q_i) shifted by the
+		// doublings of the remaining windows.
+		tmp.AddAssign(api, Q[i])
+		addToAcc(tmp)
+		cc.phi1(api, &tmp, &tmp)
+		addToAcc(tmp)
+	}
+	// lookup returns the digit multiple of Q_i for k=0 and of Φ(Q_i) for k=1
+	lookup := func(i, k, j int) *G1Affine {
+		p := &G1Affine{
+			X: tablesX[i].Lookup(windows[i][k][j])[0],
+			Y: tablesY[i].Lookup(windows[i][k][j])[0],
+		}
+		if k == 1 {
+			cc.phi1(api, p, p)
+		}
+		return p
+	}
+
+	for i := range Q {
+		addToAcc(*lookup(i, 0, nbWindows-1))
+		addToAcc(*lookup(i, 1, nbWindows-1))
+	}
+	for j := nbWindows - 2; j >= 0; j-- {
+		for k := 0; k < msmWindowSize-1; k++ {
+			acc.Double(api, acc)
+		}
+		// DoubleAndAdd computes (acc+q)+acc, where the second addition fails
+		// if acc+q = ±acc as the numerator 2acc.Y is non-zero.
+		q := lookup(0, 0, j)
+		api.AssertIsDifferent(acc.X, q.X)
+		acc.DoubleAndAdd(api, &acc, q)
+		addToAcc(*lookup(0, 1, j))
+		for i := 1; i < len(Q); i++ {
+			addToAcc(*lookup(i, 0, j))
+			addToAcc(*lookup(i, 1, j))
+		}
+	}
+	// we use AddUnified here so that the result is (0,0) when the sum is
+	// zero and the accumulator may be equal to -[2^n]R.
+	Rn.Neg(&Rn)
+	res.Assign(&Rn)
+	res.AddUnified(api, acc)
+	return res, nil
+}
+
+// msmOffset returns a point R in G1 of unknown discrete logarithm and [2^n]R.
+func msmOffset(n int) (R, Rn bls24315.G1Affine) {
+	R, err := bls24315.HashToG1([]byte("gnark-msm-offset"), []byte("BLS24315G1_XMD:SHA-256_SSWU_RO_"))
+	if err != nil {
+		panic(err)
+	}
+	Rn.ScalarMultiplication(&R, new(big.Int).Lsh(big.NewInt(1), uint(n)))
+	return R, Rn
+}
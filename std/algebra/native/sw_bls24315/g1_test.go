@@ -471,3 +471,92 @@ func BenchmarkVarScalarMulG1(b *testing.B) {
 	b.Log("plonk", ccsBench.GetNbConstraints())
 
 }
+
+type g1MultiScalarMul struct {
+	Points  [4]G1Affine
+	Scalars [4]frontend.Variable
+	C       G1Affine `gnark:",public"`
+}
+
+func (circuit *g1MultiScalarMul) Define(api frontend.API) error {
+	res, err := MultiScalarMul(api, circuit.Points[:], circuit.Scalars[:])
+	if err != nil {
+		return err
+	}
+	res.AssertIsEqual(api, circuit.C)
+	return nil
+}
+
+func TestMultiScalarMulG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	var circuit, witness g1MultiScalarMul
+	var points [4]bls24315.G1Affine
+	var scalars [4]fr.Element
+	for i := range points {
+		p := randomPointG1()
+		points[i].FromJacobian(&p)
+		_, _ = scalars[i].SetRandom()
+	}
+	// repeated point and small scalars
+	points[3] = points[2]
+	scalars[2].SetZero()
+	scalars[3].SetOne()
+	var c bls24315.G1Affine
+	_, err := c.MultiExp(points[:], scalars[:], ecc.MultiExpConfig{})
+	assert.NoError(err)
+	for i := range points {
+		witness.Points[i].Assign(&points[i])
+		witness.Scalars[i] = scalars[i].String()
+	}
+	witness.C.Assign(&c)
+	assert.SolvingSucceeded(&circuit, &witness, test.WithCurves(ecc.BW6_633))
+}
+
+// g1MultiScalarMulWrong asserts that the MSM is different from C.
+type g1MultiScalarMulWrong struct {
+	Points  [4]G1Affine
+	Scalars [4]frontend.Variable
+	C       G1Affine `gnark:",public"`
+}
+
+func (circuit *g1MultiScalarMulWrong) Define(api frontend.API) error {
+	res, err := MultiScalarMul(api, circuit.Points[:], circuit.Scalars[:])
+	if err != nil {
+		return err
+	}
+	api.AssertIsDifferent(res.X, circuit.C.X)
+	return nil
+}
+
+func TestMultiScalarMulG1OffsetCollision(t *testing.T) {
+	assert := test.NewAssert(t)
+	// Q_0 = [2^-w]R is such that the first addition to the accumulator is
+	// R+R, for which the incomplete addition would not constrain the result.
+	cc := getInnerCurveConfig(ecc.BW6_633.ScalarField())
+	nbits := cc.lambda.BitLen() + 3
+	nbWindows := (nbits - 1 + msmWindowSize - 1) / msmWindowSize
+	R, _ := msmOffset((nbWindows - 1) * msmWindowSize)
+	var e fr.Element
+	e.SetUint64(1 << msmWindowSize).Inverse(&e)
+
+	var points [4]bls24315.G1Affine
+	var scalars [4]fr.Element
+	points[0].ScalarMultiplication(&R, e.BigInt(new(big.Int)))
+	_, _ = scalars[0].SetRandom()
+	for i := 1; i < len(points); i++ {
+		p := randomPointG1()
+		points[i].FromJacobian(&p)
+		_, _ = scalars[i].SetRandom()
+	}
+	var c bls24315.G1Affine
+	_, err := c.MultiExp(points[:], scalars[:], ecc.MultiExpConfig{})
+	assert.NoError(err)
+
+	var circuit, witness g1MultiScalarMulWrong
+	for i := range points {
+		witness.Points[i].Assign(&points[i])
+		witness.Scalars[i] = scalars[i].String()
+	}
+	witness.C.Assign(&c)
+	assert.SolvingFailed(&circuit, &witness, test.WithCurves(ecc.BW6_633))
+}
@@ -0,0 +1,209 @@
+package sw_bls24315
+
+import (
+	"fmt"
+	"math/big"
+
+	fr_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/fields_bls24315"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Curve allows G1 operations in BLS24-315. It implements the generic
+// [github.com/consensys/gnark/std/algebra.Curve] interface over the native
+// field.
+type Curve struct {
+	api frontend.API
+	fr  *emulated.Field[ScalarField]
+}
+
+// Scalar is a scalar in the groups. It is represented as an emulated element
+// for compatibility with the emulated curves and is packed into a single native
+// variable for the group operations.
+type Scalar = emulated.Element[ScalarField]
+
+// ScalarField is the [emulated.FieldParams] implementation of the curve scalar field.
+type ScalarField = emulated.BLS24315Fr
+
+// NewScalar allocates a witness from the native scalar and returns it.
+func NewScalar(v fr_bls24315.Element) Scalar {
+	return emulated.ValueOf[ScalarField](v)
+}
+
+// NewCurve initializes a new [Curve] instance.
+func NewCurve(api frontend.API) (*Curve, error) {
+	f, err := emulated.NewField[ScalarField](api)
+	if err != nil {
+		return nil, fmt.Errorf("new scalar api: %w", err)
+	}
+	return &Curve{
+		api: api,
+		fr:  f,
+	}, nil
+}
+
+// Add points P and Q and return the result. Does not modify the inputs.
+func (c *Curve) Add(P, Q *G1Affine) *G1Affine {
+	res := &G1Affine{
+		X: P.X,
+		Y: P.Y,
+	}
+	res.AddAssign(c.api, *Q)
+	return res
+}
+
+// AddUnified adds points P and Q and returns the result. Does not modify the
+// inputs. Unlike [Curve.Add], P and Q can be equal, opposite or (0,0).
+func (c *Curve) AddUnified(P, Q *G1Affine) *G1Affine {
+	res := &G1Affine{
+		X: P.X,
+		Y: P.Y,
+	}
+	res.AddUnified(c.api, *Q)
+	return res
+}
+
+// AssertIsEqual asserts the equality of P and Q.
+func (c *Curve) AssertIsEqual(P, Q *G1Affine) {
+	P.AssertIsEqual(c.api, *Q)
+}
+
+// Neg negates P and returns the result. Does not modify P.
+func (c *Curve) Neg(P *G1Affine) *G1Affine {
+	res := &G1Affine{}
+	res.Neg(c.api, *P)
+	return res
+}
+
+// ScalarMul computes scalar*P and returns the result. It doesn't modify the
+// inputs.
+func (c *Curve) ScalarMul(P *G1Affine, scalar *Scalar) *G1Affine {
+	// the scalar multiplication uses incomplete additions. If P=(0,0) or the
+	// scalar is zero, we multiply the generator by one instead and return
+	// (0,0).
+	points := getCurvePoints()
+	isZeroP := c.api.And(c.api.IsZero(P.X), c.api.IsZero(P.Y))
+	s := c.packScalar(scalar)
+	isZero := c.api.Or(isZeroP, c.api.IsZero(s))
+	s = c.api.Select(isZero, 1, s)
+	Q := &G1Affine{}
+	Q.Select(c.api, isZeroP, G1Affine{X: points.G1x, Y: points.G1y}, *P)
+	res := &G1Affine{}
+	res.ScalarMul(c.api, *Q, s)
+	res.Select(c.api, isZero, G1Affine{X: 0, Y: 0}, *res)
+	return res
+}
+
+// ScalarMulBase computes scalar*G where G is the standard base point of the
+// curve. It doesn't modify the scalar.
+func (c *Curve) ScalarMulBase(scalar *Scalar) *G1Affine {
+	res := &G1Affine{}
+	res.ScalarMulBase(c.api, c.packScalar(scalar))
+	return res
+}
+
+// MultiScalarMul computes ∑ scalars_i * P_i and returns the result. It
+// doesn't modify the inputs. It returns an error if there is a mismatch in the
+// lengths of the inputs or if the inputs are empty. P_i can be (0,0), see
+// [MultiScalarMul] for the excluded inputs.
+func (c *Curve) MultiScalarMul(P []*G1Affine, scalars []*Scalar) (*G1Affine, error) {
+	if len(P) != len(scalars) {
+		return nil, fmt.Errorf("mismatching points and scalars slice lengths")
+	}
+	// if P_i=(0,0) we use the generator with a zero scalar instead
+	points := getCurvePoints()
+	inP := make([]G1Affine, len(P))
+	inS := make([]frontend.Variable, len(scalars))
+	for i := range P {
+		isZero := c.api.And(c.api.IsZero(P[i].X), c.api.IsZero(P[i].Y))
+		inP[i].Select(c.api, isZero, G1Affine{X: points.G1x, Y: points.G1y}, *P[i])
+		inS[i] = c.api.Select(isZero, 0, c.packScalar(scalars[i]))
+	}
+	res, err := MultiScalarMul(c.api, inP, inS)
+	return &res, err
+}
+
+// packScalar reduces the emulated scalar and recomposes its limbs into a
+// single native variable. The scalar field of BLS24-315 is smaller than the
+// native field, so the composition does not overflow.
+func (c *Curve) packScalar(scalar *Scalar) frontend.Variable {
+	var fr ScalarField
+	sr := c.fr.Reduce(scalar)
+	var res frontend.Variable = 0
+	for i := range sr.Limbs {
+		coef := new(big.Int).Lsh(big.NewInt(1), fr.BitsPerLimb()*uint(i))
+		res = c.api.Add(res, c.api.Mul(sr.Limbs[i], coef))
+	}
+	return res
+}
+
+// Pairing allows computing the pairing over BLS24-315. It implements the
+// generic [github.com/consensys/gnark/std/algebra.Pairing] interface over the
+// native field.
+type Pairing struct {
+	api frontend.API
+}
+
+// NewPairing initializes a [Pairing] instance.
+func NewPairing(api frontend.API) *Pairing {
+	return &Pairing{
+		api: api,
+	}
+}
+
+// MillerLoop computes the Miller loop between the pairs of inputs. It doesn't
+// modify the inputs. It returns an error if there is a mismatch between the
+// lengths of the inputs.
+func (p *Pairing) MillerLoop(P []*G1Affine, Q []*G2Affine) (*GT, error) {
+	inP := make([]G1Affine, len(P))
+	for i := range P {
+		inP[i] = *P[i]
+	}
+	inQ := make([]G2Affine, len(Q))
+	for i := range Q {
+		inQ[i] = *Q[i]
+	}
+	res, err := MillerLoop(p.api, inP, inQ)
+	return &res, err
+}
+
+// FinalExponentiation performs the final exponentiation on the target group
+// element. It doesn't modify the input.
+func (p *Pairing) FinalExponentiation(e *GT) *GT {
+	res := FinalExponentiation(p.api, *e)
+	return &res
+}
+
+// Pair computes a full multi-pairing on the input pairs.
+func (p *Pairing) Pair(P []*G1Affine, Q []*G2Affine) (*GT, error) {
+	inP := make([]G1Affine, len(P))
+	for i := range P {
+		inP[i] = *P[i]
+	}
+	inQ := make([]G2Affine, len(Q))
+	for i := range Q {
+		inQ[i] = *Q[i]
+	}
+	res, err := Pair(p.api, inP, inQ)
+	return &res, err
+}
+
+// PairingCheck computes the multi-pairing of the input pairs and asserts that
+// the result is an identity element in the target group. It returns an error if
+// there is a mismatch between the lengths of the inputs.
+func (p *Pairing) PairingCheck(P []*G1Affine, Q []*G2Affine) error {
+	res, err := p.Pair(P, Q)
+	if err != nil {
+		return err
+	}
+	var one fields_bls24315.E24
+	one.SetOne()
+	res.AssertIsEqual(p.api, one)
+	return nil
+}
+
+// AssertIsEqual asserts the equality of the target group elements.
+func (p *Pairing) AssertIsEqual(e1, e2 *GT) {
+	e1.AssertIsEqual(p.api, *e2)
+}
@@ -0,0 +1,251 @@
+// Package bytes implements operations on byte arrays with runtime length.
+//
+// In circuit, the sizes of arrays are fixed at compile time. For parsing
+// real-world data (JSON payloads, certificates, e-mails) we need to handle
+// arrays whose length is only known during solving. We represent such an array
+// as [Array], which stores a fixed-capacity slice of bytes and a variable
+// length. The bytes at positions after the length are ignored by the operations
+// and the returned arrays have zero bytes at these positions.
+//
+// The operations which move bytes by a variable offset (concatenation,
+// shifting) use a lookup table from [logderivlookup], so that their cost is
+// linear in the capacity of the arrays instead of quadratic as when using
+// multiplexers. The substring search [IndexOf] compares polynomial hashes of
+// the windows of the array using a random challenge obtained from the
+// commitment.
+//
+// All the functions assume that the input bytes are range checked, for example
+// obtained from [uints.BinaryField.ByteValueOf] or from witness.
+package bytes
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/consensys/gnark/std/selector"
+)
+
+// Array is a byte array with a runtime length. The capacity of the array is
+// the length of Data and is fixed at compile time. The Length must be at most
+// the capacity.
+type Array struct {
+	Data   []uints.U8
+	Length frontend.Variable
+}
+
+// NewArray returns an [Array] of the given capacity for assigning the witness.
+// The bytes after the length of v are set to zero. It panics if v is longer
+// than the capacity.
+func NewArray(v []byte, capacity int) Array {
+	if len(v) > capacity {
+		panic(fmt.Sprintf("value length %d exceeds capacity %d", len(v), capacity))
+	}
+	data := make([]byte, capacity)
+	copy(data, v)
+	return Array{Data: uints.NewU8Array(data), Length: len(v)}
+}
+
+// Placeholder returns an [Array] of the given capacity for compiling the
+// circuit.
+func Placeholder(capacity int) Array {
+	return Array{Data: make([]uints.U8, capacity)}
+}
+
+// Concat returns the concatenation of the arrays a and b. The capacity of the
+// result is the sum of the capacities of a and b.
+func Concat(api frontend.API, a, b Array) Array {
+	capA, capB := len(a.Data), len(b.Data)
+	am := mask(api, a)
+	bm := mask(api, b)
+	// the table is 0^capA || b || 0^capA and we look up the entries starting
+	// from capA-a.Length, which gives a.Length zeros followed by b.
+	entries := make([]frontend.Variable, 0, 2*capA+capB)
+	entries = appendZeros(entries, capA)
+	entries = append(entries, bm...)
+	entries = appendZeros(entries, capA)
+	shifted := lookupShifted(api, entries, api.Sub(capA, a.Length), capA+capB)
+	res := make([]uints.U8, capA+capB)
+	for i := range res {
+		if i < capA {
+			res[i] = uints.U8{Val: api.Add(am[i], shifted[i])}
+		} else {
+			res[i] = uints.U8{Val: shifted[i]}
+		}
+	}
+	return Array{Data: res, Length: api.Add(a.Length, b.Length)}
+}
+
+// ShiftLeft returns the array a without its first s bytes. The capacity of the
+// result is the capacity of a. The shift s must be at most the length of a,
+// otherwise a proof cannot be generated.
+func ShiftLeft(api frontend.API, a Array, s frontend.Variable) Array {
+	capA := len(a.Data)
+	rangecheck.CheckVariableBound(api, s, api.Add(a.Length, 1), bits.Len(uint(capA+1)))
+	// the table is a || 0^capA and we look up the entries starting from s.
+	entries := make([]frontend.Variable, 0, 2*capA)
+	entries = append(entries, mask(api, a)...)
+	entries = appendZeros(entries, capA)
+	shifted := lookupShifted(api, entries, s, capA)
+	return Array{Data: toBytes(shifted), Length: api.Sub(a.Length, s)}
+}
+
+// ShiftRight returns the array a prepended with s zero bytes. The capacity of
+// the result is the capacity of a. The sum of s and the length of a must be
+// at most the capacity of a, otherwise a proof cannot be generated.
+func ShiftRight(api frontend.API, a Array, s frontend.Variable) Array {
+	capA := len(a.Data)
+	length := api.Add(a.Length, s)
+	rangecheck.CheckBound(api, length, big.NewInt(int64(capA+1)))
+	// the table is 0^capA || a and we look up the entries starting from
+	// capA-s.
+	entries := make([]frontend.Variable, 0, 2*capA)
+	entries = appendZeros(entries, capA)
+	entries = append(entries, mask(api, a)...)
+	shifted := lookupShifted(api, entries, api.Sub(capA, s), capA)
+	return Array{Data: toBytes(shifted), Length: length}
+}
+
+// Slice returns the length bytes of a starting from the position start as an
+// array of the given capacity. The range must be within the length of a and
+// the length must be at most the capacity, otherwise a proof cannot be
+// generated.
+func Slice(api frontend.API, a Array, start, length frontend.Variable, capacity int) Array {
+	shifted := ShiftLeft(api, a, start)
+	rangecheck.CheckVariableBound(api, length, api.Add(shifted.Length, 1), bits.Len(uint(len(a.Data)+1)))
+	res := Array{Data: make([]uints.U8, capacity), Length: length}
+	for i := range res.Data {
+		if i < len(shifted.Data) {
+			res.Data[i] = shifted.Data[i]
+		} else {
+			res.Data[i] = uints.NewU8(0)
+		}
+	}
+	res.Data = toBytes(mask(api, res))
+	return res
+}
+
+// IsEqualPrefix returns 1 if the first n bytes of a and b are equal and 0
+// otherwise. The length n must be at most the length of the shorter slice,
+// otherwise a proof cannot be generated.
+func IsEqualPrefix(api frontend.API, a, b []uints.U8, n frontend.Variable) frontend.Variable {
+	diffs := prefixDiffs(api, a, b, n)
+	// the differences are in [-255, 255], so the sum of their squares is zero
+	// only if all the differences are zero.
+	var acc frontend.Variable = 0
+	for i := range diffs {
+		acc = api.MulAcc(acc, diffs[i], diffs[i])
+	}
+	return api.IsZero(acc)
+}
+
+// AssertIsEqualPrefix asserts that the first n bytes of a and b are equal. The
+// length n must be at most the length of the shorter slice, otherwise a proof
+// cannot be generated.
+func AssertIsEqualPrefix(api frontend.API, a, b []uints.U8, n frontend.Variable) {
+	diffs := prefixDiffs(api, a, b, n)
+	for i := range diffs {
+		api.AssertIsEqual(diffs[i], 0)
+	}
+}
+
+// prefixDiffs returns the differences of the first n bytes of a and b and zero
+// at other positions.
+func prefixDiffs(api frontend.API, a, b []uints.U8, n frontend.Variable) []frontend.Variable {
+	l := len(a)
+	if len(b) < l {
+		l = len(b)
+	}
+	m := Indicators(api, l, n)
+	res := make([]frontend.Variable, l)
+	for i := range res {
+		res[i] = api.Mul(m[i], api.Sub(a[i].Val, b[i].Val))
+	}
+	return res
+}
+
+// Mask returns the array a with the bytes after its length set to zero.
+func Mask(api frontend.API, a Array) Array {
+	return Array{Data: toBytes(mask(api, a)), Length: a.Length}
+}
+
+// mask returns the bytes of a at positions less than the length of a and zero
+// at other positions.
+func mask(api frontend.API, a Array) []frontend.Variable {
+	m := Indicators(api, len(a.Data), a.Length)
+	res := make([]frontend.Variable, len(a.Data))
+	for i := range res {
+		res[i] = api.Mul(m[i], a.Data[i].Val)
+	}
+	return res
+}
+
+// Indicators returns n values where the values at positions less than length
+// are 1 and the remaining values are 0. The length must be at most n,
+// otherwise a proof cannot be generated. If the length is constant, then the
+// values are constant.
+func Indicators(api frontend.API, n int, length frontend.Variable) []frontend.Variable {
+	if l, ok := api.Compiler().ConstantValue(length); ok {
+		if !l.IsUint64() || l.Uint64() > uint64(n) {
+			panic(fmt.Sprintf("length %s exceeds %d", l, n))
+		}
+		res := make([]frontend.Variable, n)
+		for i := range res {
+			if uint64(i) < l.Uint64() {
+				res[i] = 1
+			} else {
+				res[i] = 0
+			}
+		}
+		return res
+	}
+	switch n {
+	case 0:
+		api.AssertIsEqual(length, 0)
+		return nil
+	case 1:
+		api.AssertIsBoolean(length)
+		return []frontend.Variable{length}
+	}
+	ones := make([]frontend.Variable, n)
+	for i := range ones {
+		ones[i] = 1
+	}
+	return selector.Partition(api, length, false, ones)
+}
+
+// lookupShifted returns n values of entries starting from the position
+// offset. The offset must be at most len(entries)-n.
+func lookupShifted(api frontend.API, entries []frontend.Variable, offset frontend.Variable, n int) []frontend.Variable {
+	if n == 0 {
+		return nil
+	}
+	t := logderivlookup.New(api)
+	for i := range entries {
+		t.Insert(entries[i])
+	}
+	inds := make([]frontend.Variable, n)
+	for i := range inds {
+		inds[i] = api.Add(offset, i)
+	}
+	return t.Lookup(inds...)
+}
+
+func appendZeros(in []frontend.Variable, n int) []frontend.Variable {
+	for i := 0; i < n; i++ {
+		in = append(in, 0)
+	}
+	return in
+}
+
+func toBytes(in []frontend.Variable) []uints.U8 {
+	res := make([]uints.U8, len(in))
+	for i := range in {
+		res[i] = uints.U8{Val: in[i]}
+	}
+	return res
+}
@@ -0,0 +1,250 @@
+package bytes
+
+import (
+	stdbytes "bytes"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+// assertArrayEqual asserts that the lengths of the arrays are equal and that
+// the arrays have equal bytes at all positions.
+func assertArrayEqual(api frontend.API, a, b Array) {
+	api.AssertIsEqual(a.Length, b.Length)
+	for i := range a.Data {
+		api.AssertIsEqual(a.Data[i].Val, b.Data[i].Val)
+	}
+}
+
+type ConcatCircuit struct {
+	A, B     Array
+	Expected Array
+}
+
+func (c *ConcatCircuit) Define(api frontend.API) error {
+	assertArrayEqual(api, Concat(api, c.A, c.B), c.Expected)
+	return nil
+}
+
+func TestConcat(t *testing.T) {
+	assert := test.NewAssert(t)
+	circuit := ConcatCircuit{A: Placeholder(8), B: Placeholder(6), Expected: Placeholder(14)}
+	for _, tc := range []struct{ a, b string }{
+		{"hello", " world"}, {"", "abc"}, {"abcdefgh", ""}, {"", ""}, {"abcdefgh", "ijklmn"},
+	} {
+		witness := ConcatCircuit{
+			A:        NewArray([]byte(tc.a), 8),
+			B:        NewArray([]byte(tc.b), 6),
+			Expected: NewArray([]byte(tc.a+tc.b), 14),
+		}
+		// the bytes after the length must be ignored.
+		if len(tc.a) < 8 {
+			witness.A.Data[7].Val = 'x'
+		}
+		err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc)
+	}
+	assert.ProverSucceeded(&circuit, &ConcatCircuit{
+		A:        NewArray([]byte("hello"), 8),
+		B:        NewArray([]byte(" world"), 6),
+		Expected: NewArray([]byte("hello world"), 14),
+	}, test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK), test.NoFuzzing())
+}
+
+type ShiftCircuit struct {
+	A           Array
+	S           frontend.Variable
+	Left, Right Array
+}
+
+func (c *ShiftCircuit) Define(api frontend.API) error {
+	assertArrayEqual(api, ShiftLeft(api, c.A, c.S), c.Left)
+	assertArrayEqual(api, ShiftRight(api, c.A, c.S), c.Right)
+	return nil
+}
+
+func TestShift(t *testing.T) {
+	assert := test.NewAssert(t)
+	circuit := ShiftCircuit{A: Placeholder(8), Left: Placeholder(8), Right: Placeholder(8)}
+	in := []byte("abcde")
+	for s := 0; s <= 3; s++ {
+		right := append(make([]byte, s), in...)
+		witness := ShiftCircuit{
+			A:     NewArray(in, 8),
+			S:     s,
+			Left:  NewArray(in[s:], 8),
+			Right: NewArray(right, 8),
+		}
+		err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, s)
+	}
+	// shifting right over the capacity must fail.
+	err := test.IsSolved(&circuit, &ShiftCircuit{
+		A:     NewArray(in, 8),
+		S:     4,
+		Left:  NewArray(in[4:], 8),
+		Right: NewArray(in, 8),
+	}, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type PrefixCircuit struct {
+	A, B     [8]frontend.Variable
+	N        frontend.Variable
+	Expected frontend.Variable
+}
+
+func (c *PrefixCircuit) Define(api frontend.API) error {
+	a, b := Placeholder(8), Placeholder(8)
+	for i := range c.A {
+		a.Data[i].Val = c.A[i]
+		b.Data[i].Val = c.B[i]
+	}
+	api.AssertIsEqual(IsEqualPrefix(api, a.Data, b.Data, c.N), c.Expected)
+	return nil
+}
+
+func TestIsEqualPrefix(t *testing.T) {
+	assert := test.NewAssert(t)
+	a, b := []byte("abcdefgh"), []byte("abcdxfgh")
+	for n := 0; n <= 8; n++ {
+		var witness PrefixCircuit
+		for i := range a {
+			witness.A[i], witness.B[i] = a[i], b[i]
+		}
+		witness.N = n
+		witness.Expected = 1
+		if n > 4 {
+			witness.Expected = 0
+		}
+		err := test.IsSolved(&PrefixCircuit{}, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, n)
+	}
+}
+
+type IndexCircuit struct {
+	Haystack, Needle Array
+	Expected         frontend.Variable
+}
+
+func (c *IndexCircuit) Define(api frontend.API) error {
+	api.AssertIsEqual(IndexOf(api, c.Haystack, c.Needle), c.Expected)
+	return nil
+}
+
+func TestIndexOf(t *testing.T) {
+	assert := test.NewAssert(t)
+	circuit := IndexCircuit{Haystack: Placeholder(16), Needle: Placeholder(4)}
+	p := ecc.BN254.ScalarField()
+	haystack := []byte(`{"sub":"ab","a":`)
+	for _, needle := range []string{`"a"`, `sub"`, `":`, `ab`, ``, `x`, `a":1`, `,"a"`} {
+		expected := int64(stdbytes.Index(haystack, []byte(needle)))
+		witness := IndexCircuit{
+			Haystack: NewArray(haystack, 16),
+			Needle:   NewArray([]byte(needle), 4),
+			Expected: expected,
+		}
+		if expected < 0 {
+			witness.Expected = new(big.Int).Sub(p, big.NewInt(1))
+		}
+		err := test.IsSolved(&circuit, &witness, p)
+		assert.NoError(err, needle)
+	}
+	// the occurrence after the length of the haystack is ignored.
+	err := test.IsSolved(&circuit, &IndexCircuit{
+		Haystack: NewArray(haystack[:10], 16),
+		Needle:   NewArray([]byte(`"a"`), 4),
+		Expected: new(big.Int).Sub(p, big.NewInt(1)),
+	}, p)
+	assert.NoError(err)
+	// wrong index must fail.
+	err = test.IsSolved(&circuit, &IndexCircuit{
+		Haystack: NewArray(haystack, 16),
+		Needle:   NewArray([]byte(`"a"`), 4),
+		Expected: 1,
+	}, p)
+	assert.Error(err)
+	assert.ProverSucceeded(&circuit, &IndexCircuit{
+		Haystack: NewArray(haystack, 16),
+		Needle:   NewArray([]byte(`"a"`), 4),
+		Expected: 12,
+	}, test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK), test.NoFuzzing())
+}
+
+type SliceCircuit struct {
+	A             Array
+	Start, Length frontend.Variable
+	Expected      Array
+}
+
+func (c *SliceCircuit) Define(api frontend.API) error {
+	assertArrayEqual(api, Slice(api, c.A, c.Start, c.Length, len(c.Expected.Data)), c.Expected)
+	return nil
+}
+
+func TestSlice(t *testing.T) {
+	assert := test.NewAssert(t)
+	circuit := SliceCircuit{A: Placeholder(8), Expected: Placeholder(4)}
+	in := []byte("abcdef")
+	for _, tc := range []struct {
+		start, length int
+		success       bool
+	}{{0, 4, true}, {2, 4, true}, {5, 1, true}, {6, 0, true}, {0, 5, false}, {4, 3, false}} {
+		end := tc.start + tc.length
+		if end > len(in) {
+			end = len(in)
+		}
+		expected := in[tc.start:end]
+		if len(expected) > 4 {
+			expected = expected[:4]
+		}
+		err := test.IsSolved(&circuit, &SliceCircuit{
+			A:        NewArray(in, 8),
+			Start:    tc.start,
+			Length:   tc.length,
+			Expected: Array{Data: NewArray(expected, 4).Data, Length: tc.length},
+		}, ecc.BN254.ScalarField())
+		if tc.success {
+			assert.NoError(err, tc)
+		} else {
+			assert.Error(err, tc)
+		}
+	}
+}
+
+type MaskCircuit struct {
+	A        Array
+	Expected Array
+}
+
+func (c *MaskCircuit) Define(api frontend.API) error {
+	assertArrayEqual(api, Mask(api, c.A), c.Expected)
+	// the indicators of a constant length are computed directly.
+	for i, v := range Indicators(api, 4, 2) {
+		api.AssertIsEqual(v, boolToInt(i < 2))
+	}
+	return nil
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func TestMask(t *testing.T) {
+	assert := test.NewAssert(t)
+	circuit := MaskCircuit{A: Placeholder(8), Expected: Placeholder(8)}
+	witness := MaskCircuit{A: NewArray([]byte("abcde"), 8), Expected: NewArray([]byte("abcde"), 8)}
+	witness.A.Data[6].Val = 'x'
+	assert.ProverSucceeded(&circuit, &witness, test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK), test.NoFuzzing())
+	wrong := MaskCircuit{A: NewArray([]byte("abcde"), 8), Expected: NewArray([]byte("abcde"), 8)}
+	wrong.A.Data[6].Val = 'x'
+	wrong.Expected.Data[6].Val = 'x'
+	assert.ProverFailed(&circuit, &wrong, test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK), test.NoFuzzing())
+}
@@ -0,0 +1,156 @@
+package bytes
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/internal/multicommit"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hints used in this package
+func GetHints() []solver.Hint {
+	return []solver.Hint{indexHint}
+}
+
+// IndexOf returns the index of the first occurrence of needle in haystack, or
+// -1 if needle is not present in haystack. The length of needle must be at most
+// the length of haystack, otherwise a proof cannot be generated.
+//
+// The index is computed in a hint and checked by comparing the polynomial
+// hashes h_p = sum_j haystack[p+j] r^j of all the windows of haystack against
+// the hash of needle, where r is a random challenge. The hashes of the windows
+// are obtained from the prefix sums of sum_i haystack[i] r^i, so that the cost
+// is linear in the capacity of haystack.
+func IndexOf(api frontend.API, haystack, needle Array) frontend.Variable {
+	capH, capN := len(haystack.Data), len(needle.Data)
+	if capH == 0 {
+		panic("empty haystack")
+	}
+	nbBits := bits.Len(uint(capH + 1))
+	rangecheck.CheckVariableBound(api, needle.Length, api.Add(haystack.Length, 1), nbBits)
+
+	hs := make([]frontend.Variable, capH)
+	for i := range hs {
+		hs[i] = haystack.Data[i].Val
+	}
+	ns := mask(api, needle)
+	// mask of the first m positions of haystack, where m is the length of
+	// needle.
+	nm := Indicators(api, capH, needle.Length)
+	// the haystack shifted left by m, i.e. shifted[i] = haystack[i+m].
+	entries := make([]frontend.Variable, 0, 2*capH)
+	entries = append(entries, hs...)
+	entries = appendZeros(entries, capH)
+	shifted := lookupShifted(api, entries, needle.Length, capH)
+	// mask of the valid start positions p <= haystack.Length-m of the windows.
+	valid := Indicators(api, capH+1, api.Sub(api.Add(haystack.Length, 1), needle.Length))
+
+	hintInputs := make([]frontend.Variable, 0, 4+capH+capN)
+	hintInputs = append(hintInputs, capH, capN, haystack.Length, needle.Length)
+	hintInputs = append(hintInputs, hs...)
+	hintInputs = append(hintInputs, ns...)
+	res, err := api.Compiler().NewHint(indexHint, 1, hintInputs...)
+	if err != nil {
+		panic(fmt.Sprintf("index hint: %v", err))
+	}
+	idx := res[0]
+
+	committed := make([]frontend.Variable, 0, 3+2*capH+capN)
+	committed = append(committed, haystack.Length, needle.Length, idx)
+	committed = append(committed, hs...)
+	committed = append(committed, ns...)
+	committed = append(committed, shifted...)
+	// we defer scheduling the commitment callback, so that the lookup tables
+	// created above and in later gadgets can still obtain their commitments.
+	api.Compiler().Defer(func(api frontend.API) error {
+		multicommit.WithCommitment(api, func(api frontend.API, r frontend.Variable) error {
+			assertIndex(api, r, idx, hs, ns, nm, shifted, valid)
+			return nil
+		}, committed...)
+		return nil
+	})
+	return idx
+}
+
+// assertIndex asserts that idx is the first start position p of a window of
+// hs which is equal to the needle ns. For the needle length m, we have
+//
+//	sum_{p <= i < p+m} hs[i] r^i = P[p+m] - P[p] = P[m] + r^m P'[p] - P[p]
+//
+// where P and P' are the prefix sums of hs[i] r^i and shifted[i] r^i. The
+// window at p matches if this is equal to r^p times the hash of the needle.
+func assertIndex(api frontend.API, r, idx frontend.Variable, hs, ns, nm, shifted, valid []frontend.Variable) {
+	n := len(hs)
+	if len(ns) > n {
+		n = len(ns)
+	}
+	pows := make([]frontend.Variable, n+1)
+	pows[0] = 1
+	for i := 1; i < len(pows); i++ {
+		pows[i] = api.Mul(pows[i-1], r)
+	}
+	// hash of the needle.
+	var hn frontend.Variable = 0
+	for j := range ns {
+		hn = api.MulAcc(hn, ns[j], pows[j])
+	}
+	// P[m] and r^m = 1 + sum_{i < m} (r^{i+1} - r^i).
+	var pm frontend.Variable = 0
+	var rm frontend.Variable = 1
+	for i := range hs {
+		pm = api.Add(pm, api.Mul(nm[i], hs[i], pows[i]))
+		rm = api.MulAcc(rm, nm[i], api.Sub(pows[i+1], pows[i]))
+	}
+	var p, ps frontend.Variable = 0, 0
+	var seen, res frontend.Variable = 0, 0
+	for i := range hs {
+		d := api.Sub(api.Add(pm, api.Mul(rm, ps)), p, api.Mul(hn, pows[i]))
+		match := api.Mul(valid[i], api.IsZero(d))
+		first := api.Mul(match, api.Sub(1, seen))
+		res = api.MulAcc(res, first, i)
+		seen = api.Add(seen, first)
+		p = api.MulAcc(p, hs[i], pows[i])
+		ps = api.MulAcc(ps, shifted[i], pows[i])
+	}
+	// if there is no match, then the result is -1.
+	api.AssertIsEqual(idx, api.Sub(api.Add(res, seen), 1))
+}
+
+// indexHint returns the index of the first occurrence of the needle in the
+// haystack or -1 (as a field element) if there is no occurrence. The inputs
+// are the capacities of the haystack and the needle, their lengths, followed
+// by the bytes of the haystack and the needle.
+func indexHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) < 4 || len(outputs) != 1 {
+		return fmt.Errorf("expected at least 4 inputs and 1 output")
+	}
+	capH, capN := int(inputs[0].Int64()), int(inputs[1].Int64())
+	if len(inputs) != 4+capH+capN {
+		return fmt.Errorf("expected %d inputs", 4+capH+capN)
+	}
+	lh, ln := int(inputs[2].Int64()), int(inputs[3].Int64())
+	if lh > capH || ln > capN {
+		return fmt.Errorf("length exceeds capacity")
+	}
+	hs, ns := inputs[4:4+lh], inputs[4+capH:4+capH+ln]
+	outputs[0].Sub(mod, big.NewInt(1))
+	for p := 0; p+ln <= lh; p++ {
+		found := true
+		for j := 0; j < ln && found; j++ {
+			found = hs[p+j].Cmp(ns[j]) == 0
+		}
+		if found {
+			outputs[0].SetInt64(int64(p))
+			break
+		}
+	}
+	return nil
+}
@@ -0,0 +1,308 @@
+// Package kzg implements KZG polynomial commitment verification.
+//
+// KZG polynomial commitment allows for the prover to commit to a polynomial and
+// then selectively prove evaluations of the said polynomial. The size of the
+// commitment is a single G1 element and the size of the evaluation proof is
+// also a single G1 element. However, KZG polynomial commitment scheme requires
+// a trusted SRS.
+//
+// Contrary to the type-specific packages kzg_bls12377 and kzg_bls24315, the
+// verifier in this package is generic over the [algebra.Curve] and
+// [algebra.Pairing] interfaces and can be instantiated both over the native
+// 2-chains and the emulated curves. Use [algebra.GetCurve] and
+// [algebra.GetPairing] to obtain the implementations in a generic circuit.
+package kzg
+
+import (
+	"fmt"
+
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr/kzg"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr/kzg"
+	bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315"
+	fr_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr/kzg"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/kzg"
+	bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761"
+	fr_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr"
+	kzg_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/fr/kzg"
+	"github.com/consensys/gnark/std/algebra"
+	emulated_bls12377 "github.com/consensys/gnark/std/algebra/emulated/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bw6761"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+)
+
+// Commitment is an KZG commitment to a polynomial. Use [ValueOfCommitment] to
+// initialize a witness from the native commitment.
+type Commitment[G1El any] struct {
+	G1El G1El
+}
+
+// ValueOfCommitment initializes a KZG commitment witness from a native
+// commitment. It returns an error if there is a conflict between the type
+// parameters and provided native commitment type.
+func ValueOfCommitment[G1El any](cmt any) (Commitment[G1El], error) {
+	var ret Commitment[G1El]
+	switch s := any(&ret).(type) {
+	case *Commitment[sw_bn254.G1Affine]:
+		tCmt, ok := cmt.(bn254.G1Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, cmt)
+		}
+		s.G1El = sw_bn254.NewG1Affine(tCmt)
+	case *Commitment[sw_bls12381.G1Affine]:
+		tCmt, ok := cmt.(bls12381.G1Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, cmt)
+		}
+		s.G1El = sw_bls12381.NewG1Affine(tCmt)
+	case *Commitment[sw_bw6761.G1Affine]:
+		tCmt, ok := cmt.(bw6761.G1Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, cmt)
+		}
+		s.G1El = sw_bw6761.NewG1Affine(tCmt)
+	case *Commitment[emulated_bls12377.G1Affine]:
+		tCmt, ok := cmt.(bls12377.G1Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, cmt)
+		}
+		s.G1El = emulated_bls12377.NewG1Affine(tCmt)
+	case *Commitment[sw_bls12377.G1Affine]:
+		tCmt, ok := cmt.(bls12377.G1Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, cmt)
+		}
+		s.G1El.Assign(&tCmt)
+	case *Commitment[sw_bls24315.G1Affine]:
+		tCmt, ok := cmt.(bls24315.G1Affine)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, cmt)
+		}
+		s.G1El.Assign(&tCmt)
+	default:
+		return ret, fmt.Errorf("unknown type parametrization")
+	}
+	return ret, nil
+}
+
+// OpeningProof embeds the opening proof that polynomial evaluated at Point is
+// equal to ClaimedValue. Use [ValueOfOpeningProof] to initialize a witness from
+// a native opening proof.
+type OpeningProof[S any, G1El any] struct {
+	QuotientPoly G1El
+	ClaimedValue S
+}
+
+// ValueOfOpeningProof initializes an opening proof witness from a native proof.
+// It returns an error if there is a mismatch between the type parameters and
+// the provided native proof type.
+func ValueOfOpeningProof[S any, G1El any](proof any) (OpeningProof[S, G1El], error) {
+	var ret OpeningProof[S, G1El]
+	switch s := any(&ret).(type) {
+	case *OpeningProof[sw_bn254.Scalar, sw_bn254.G1Affine]:
+		tProof, ok := proof.(kzg_bn254.OpeningProof)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, proof)
+		}
+		s.QuotientPoly = sw_bn254.NewG1Affine(tProof.H)
+		s.ClaimedValue = sw_bn254.NewScalar(tProof.ClaimedValue)
+	case *OpeningProof[sw_bls12381.Scalar, sw_bls12381.G1Affine]:
+		tProof, ok := proof.(kzg_bls12381.OpeningProof)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, proof)
+		}
+		s.QuotientPoly = sw_bls12381.NewG1Affine(tProof.H)
+		s.ClaimedValue = sw_bls12381.NewScalar(tProof.ClaimedValue)
+	case *OpeningProof[sw_bw6761.Scalar, sw_bw6761.G1Affine]:
+		tProof, ok := proof.(kzg_bw6761.OpeningProof)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, proof)
+		}
+		s.QuotientPoly = sw_bw6761.NewG1Affine(tProof.H)
+		s.ClaimedValue = sw_bw6761.NewScalar(tProof.ClaimedValue)
+	case *OpeningProof[emulated_bls12377.Scalar, emulated_bls12377.G1Affine]:
+		tProof, ok := proof.(kzg_bls12377.OpeningProof)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, proof)
+		}
+		s.QuotientPoly = emulated_bls12377.NewG1Affine(tProof.H)
+		s.ClaimedValue = emulated_bls12377.NewScalar(tProof.ClaimedValue)
+	case *OpeningProof[sw_bls12377.Scalar, sw_bls12377.G1Affine]:
+		tProof, ok := proof.(kzg_bls12377.OpeningProof)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, proof)
+		}
+		s.QuotientPoly.Assign(&tProof.H)
+		s.ClaimedValue = sw_bls12377.NewScalar(tProof.ClaimedValue)
+	case *OpeningProof[sw_bls24315.Scalar, sw_bls24315.G1Affine]:
+		tProof, ok := proof.(kzg_bls24315.OpeningProof)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, proof)
+		}
+		s.QuotientPoly.Assign(&tProof.H)
+		s.ClaimedValue = sw_bls24315.NewScalar(tProof.ClaimedValue)
+	default:
+		return ret, fmt.Errorf("unknown type parametrization")
+	}
+	return ret, nil
+}
+
+// ValueOfScalar initializes a scalar witness (for example the evaluation point)
+// from a native scalar. It returns an error if there is a mismatch between the
+// type parameter and the provided native scalar type.
+func ValueOfScalar[S any](scalar any) (S, error) {
+	var ret S
+	switch s := any(&ret).(type) {
+	case *sw_bn254.Scalar:
+		tScalar, ok := scalar.(fr_bn254.Element)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, scalar)
+		}
+		*s = sw_bn254.NewScalar(tScalar)
+	case *sw_bls12381.Scalar:
+		tScalar, ok := scalar.(fr_bls12381.Element)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, scalar)
+		}
+		*s = sw_bls12381.NewScalar(tScalar)
+	case *sw_bw6761.Scalar:
+		tScalar, ok := scalar.(fr_bw6761.Element)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, scalar)
+		}
+		*s = sw_bw6761.NewScalar(tScalar)
+	case *sw_bls12377.Scalar:
+		// the scalars of the native and emulated BLS12-377 curves have the
+		// same type.
+		tScalar, ok := scalar.(fr_bls12377.Element)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, scalar)
+		}
+		*s = sw_bls12377.NewScalar(tScalar)
+	case *sw_bls24315.Scalar:
+		tScalar, ok := scalar.(fr_bls24315.Element)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, scalar)
+		}
+		*s = sw_bls24315.NewScalar(tScalar)
+	default:
+		return ret, fmt.Errorf("unknown type parametrization")
+	}
+	return ret, nil
+}
+
+// VerifyingKey is the trusted setup for KZG polynomial commitment scheme. Use
+// [ValueOfVerifyingKey] to initialize a witness from the native VerifyingKey.
+type VerifyingKey[G2El any] struct {
+	SRS [2]G2El // [G₂, [α]G₂]
+}
+
+// ValueOfVerifyingKey initializes verifying key witness from the native
+// verifying key. It returns an error if there is a mismatch between the type
+// parameters and the provided verifying key type.
+func ValueOfVerifyingKey[G2El any](vk any) (VerifyingKey[G2El], error) {
+	var ret VerifyingKey[G2El]
+	switch s := any(&ret).(type) {
+	case *VerifyingKey[sw_bn254.G2Affine]:
+		tVk, ok := vk.(kzg_bn254.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, vk)
+		}
+		s.SRS[0] = sw_bn254.NewG2Affine(tVk.G2[0])
+		s.SRS[1] = sw_bn254.NewG2Affine(tVk.G2[1])
+	case *VerifyingKey[sw_bls12381.G2Affine]:
+		tVk, ok := vk.(kzg_bls12381.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, vk)
+		}
+		s.SRS[0] = sw_bls12381.NewG2Affine(tVk.G2[0])
+		s.SRS[1] = sw_bls12381.NewG2Affine(tVk.G2[1])
+	case *VerifyingKey[sw_bw6761.G2Affine]:
+		tVk, ok := vk.(kzg_bw6761.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, vk)
+		}
+		s.SRS[0] = sw_bw6761.NewG2Affine(tVk.G2[0])
+		s.SRS[1] = sw_bw6761.NewG2Affine(tVk.G2[1])
+	case *VerifyingKey[emulated_bls12377.G2Affine]:
+		tVk, ok := vk.(kzg_bls12377.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, vk)
+		}
+		s.SRS[0] = emulated_bls12377.NewG2Affine(tVk.G2[0])
+		s.SRS[1] = emulated_bls12377.NewG2Affine(tVk.G2[1])
+	case *VerifyingKey[sw_bls12377.G2Affine]:
+		tVk, ok := vk.(kzg_bls12377.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, vk)
+		}
+		s.SRS[0].Assign(&tVk.G2[0])
+		s.SRS[1].Assign(&tVk.G2[1])
+	case *VerifyingKey[sw_bls24315.G2Affine]:
+		tVk, ok := vk.(kzg_bls24315.VerifyingKey)
+		if !ok {
+			return ret, fmt.Errorf("mismatching types %T %T", ret, vk)
+		}
+		s.SRS[0].Assign(&tVk.G2[0])
+		s.SRS[1].Assign(&tVk.G2[1])
+	default:
+		return ret, fmt.Errorf("unknown type parametrization")
+	}
+	return ret, nil
+}
+
+// Verifier allows verifying KZG opening proofs.
+type Verifier[S any, G1El any, G2El any, GtEl any] struct {
+	curve   algebra.Curve[G1El, S]
+	pairing algebra.Pairing[G1El, G2El, GtEl]
+}
+
+// NewVerifier initializes a new Verifier instance.
+func NewVerifier[S any, G1El any, G2El any, GtEl any](curve algebra.Curve[G1El, S], pairing algebra.Pairing[G1El, G2El, GtEl]) *Verifier[S, G1El, G2El, GtEl] {
+	return &Verifier[S, G1El, G2El, GtEl]{
+		curve:   curve,
+		pairing: pairing,
+	}
+}
+
+// AssertProof asserts the validity of the opening proof for the given
+// commitment at the point.
+//
+// The points are added with the complete addition [algebra.Curve.AddUnified],
+// so the claimed value and the point can be zero and the commitment can be
+// (0,0). The pairing does not accept (0,0) as input, so the quotient H(α) and
+// [f(α)-f(a)+a*H(α)]G₁ must be non-zero. This excludes constant polynomials,
+// for which the proof is rejected.
+func (v *Verifier[S, G1El, G2El, GtEl]) AssertProof(vk VerifyingKey[G2El], commitment Commitment[G1El], point S, proof OpeningProof[S, G1El]) error {
+	// [f(a)]G₁
+	claimedValueG1 := v.curve.ScalarMulBase(&proof.ClaimedValue)
+
+	// [f(α) - f(a)]G₁
+	fminusfaG1 := v.curve.Neg(claimedValueG1)
+	fminusfaG1 = v.curve.AddUnified(fminusfaG1, &commitment.G1El)
+
+	// [-H(α)]G₁
+	negQuotientPoly := v.curve.Neg(&proof.QuotientPoly)
+
+	// [f(α) - f(a) + a*H(α)]G₁
+	totalG1 := v.curve.ScalarMul(&proof.QuotientPoly, &point)
+	totalG1 = v.curve.AddUnified(totalG1, fminusfaG1)
+
+	// e([f(α)-f(a)+aH(α)]G₁], G₂).e([-H(α)]G₁, [α]G₂) == 1
+	if err := v.pairing.PairingCheck(
+		[]*G1El{totalG1, negQuotientPoly},
+		[]*G2El{&vk.SRS[0], &vk.SRS[1]},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,404 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	fr_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/fr/kzg"
+	fr_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/fr/kzg"
+	fr_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr/kzg"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra"
+	emulated_bls12377 "github.com/consensys/gnark/std/algebra/emulated/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	kzgSize        = 128
+	polynomialSize = 100
+)
+
+type KZGVerificationCircuit[S any, G1El any, G2El any, GTEl any] struct {
+	VerifyingKey[G2El]
+	Commitment[G1El]
+	OpeningProof[S, G1El]
+	Point S
+}
+
+func (c *KZGVerificationCircuit[S, G1El, G2El, GTEl]) Define(api frontend.API) error {
+	curve, err := algebra.GetCurve[G1El, S](api)
+	if err != nil {
+		return err
+	}
+	pairing, err := algebra.GetPairing[G1El, G2El, GTEl](api)
+	if err != nil {
+		return err
+	}
+	verifier := NewVerifier(curve, pairing)
+	return verifier.AssertProof(c.VerifyingKey, c.Commitment, c.Point, c.OpeningProof)
+}
+
+func TestKZGVerificationEmulated(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bn254.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	com, err := kzg_bn254.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bn254.Element
+	point.SetRandom()
+	proof, err := kzg_bn254.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	err = kzg_bn254.Verify(&com, &proof, point, srs.Vk)
+	assert.NoError(err)
+
+	wCmt, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bn254.Scalar, sw_bn254.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKey[sw_bn254.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[sw_bn254.Scalar](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationCircuit[sw_bn254.Scalar, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+	}
+	err = test.IsSolved(&KZGVerificationCircuit[sw_bn254.Scalar, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// wrong claimed value
+	wrongProof := proof
+	wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, new(fr_bn254.Element).SetOne())
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.Scalar, sw_bn254.G1Affine](wrongProof)
+	assert.NoError(err)
+	err = test.IsSolved(&KZGVerificationCircuit[sw_bn254.Scalar, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.Error(err)
+
+	// wrong quotient
+	wrongProof = proof
+	wrongProof.H.Add(&wrongProof.H, &srs.Pk.G1[0])
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.Scalar, sw_bn254.G1Affine](wrongProof)
+	assert.NoError(err)
+	err = test.IsSolved(&KZGVerificationCircuit[sw_bn254.Scalar, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestKZGVerificationEmulatedZero(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bn254.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	var alphaFr fr_bn254.Element
+	alphaFr.SetBigInt(alpha)
+
+	eval := func(f []fr_bn254.Element, x fr_bn254.Element) fr_bn254.Element {
+		var res fr_bn254.Element
+		for i := len(f) - 1; i >= 0; i-- {
+			res.Mul(&res, &x).Add(&res, &f[i])
+		}
+		return res
+	}
+
+	var zero, random fr_bn254.Element
+	random.SetRandom()
+	for _, tc := range []struct {
+		name   string
+		point  fr_bn254.Element
+		rootAt *fr_bn254.Element // f is shifted to have a root at rootAt
+	}{
+		{name: "point=0", point: zero},
+		{name: "claimed=0", point: random, rootAt: &random},
+		{name: "point=0/claimed=0", point: zero, rootAt: &zero},
+		{name: "commitment=0", point: random, rootAt: &alphaFr},
+	} {
+		assert.Run(func(assert *test.Assert) {
+			f := make([]fr_bn254.Element, polynomialSize)
+			for i := range f {
+				f[i].SetRandom()
+			}
+			if tc.rootAt != nil {
+				v := eval(f, *tc.rootAt)
+				f[0].Sub(&f[0], &v)
+			}
+
+			com, err := kzg_bn254.Commit(f, srs.Pk)
+			assert.NoError(err)
+			proof, err := kzg_bn254.Open(f, tc.point, srs.Pk)
+			assert.NoError(err)
+			err = kzg_bn254.Verify(&com, &proof, tc.point, srs.Vk)
+			assert.NoError(err)
+
+			wCmt, err := ValueOfCommitment[sw_bn254.G1Affine](com)
+			assert.NoError(err)
+			wProof, err := ValueOfOpeningProof[sw_bn254.Scalar, sw_bn254.G1Affine](proof)
+			assert.NoError(err)
+			wVk, err := ValueOfVerifyingKey[sw_bn254.G2Affine](srs.Vk)
+			assert.NoError(err)
+			wPt, err := ValueOfScalar[sw_bn254.Scalar](tc.point)
+			assert.NoError(err)
+
+			assignment := KZGVerificationCircuit[sw_bn254.Scalar, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{
+				VerifyingKey: wVk,
+				Commitment:   wCmt,
+				OpeningProof: wProof,
+				Point:        wPt,
+			}
+			err = test.IsSolved(&KZGVerificationCircuit[sw_bn254.Scalar, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+			assert.NoError(err)
+
+			// wrong claimed value
+			wrongProof := proof
+			wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, new(fr_bn254.Element).SetOne())
+			assignment.OpeningProof, err = ValueOfOpeningProof[sw_bn254.Scalar, sw_bn254.G1Affine](wrongProof)
+			assert.NoError(err)
+			err = test.IsSolved(&KZGVerificationCircuit[sw_bn254.Scalar, sw_bn254.G1Affine, sw_bn254.G2Affine, sw_bn254.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+			assert.Error(err)
+		}, tc.name)
+	}
+}
+
+func TestKZGVerificationEmulatedBLS12377(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS12_377.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls12377.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bls12377.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	com, err := kzg_bls12377.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bls12377.Element
+	point.SetRandom()
+	proof, err := kzg_bls12377.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	err = kzg_bls12377.Verify(&com, &proof, point, srs.Vk)
+	assert.NoError(err)
+
+	wCmt, err := ValueOfCommitment[emulated_bls12377.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[emulated_bls12377.Scalar, emulated_bls12377.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKey[emulated_bls12377.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[emulated_bls12377.Scalar](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationCircuit[emulated_bls12377.Scalar, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{
+		VerifyingKey: wVk,
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+	}
+	err = test.IsSolved(&KZGVerificationCircuit[emulated_bls12377.Scalar, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// wrong claimed value
+	wrongProof := proof
+	wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, new(fr_bls12377.Element).SetOne())
+	assignment.OpeningProof, err = ValueOfOpeningProof[emulated_bls12377.Scalar, emulated_bls12377.G1Affine](wrongProof)
+	assert.NoError(err)
+	err = test.IsSolved(&KZGVerificationCircuit[emulated_bls12377.Scalar, emulated_bls12377.G1Affine, emulated_bls12377.G2Affine, emulated_bls12377.GTEl]{}, &assignment, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestKZGVerificationTwoChain(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS12_377.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls12377.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bls12377.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	com, err := kzg_bls12377.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bls12377.Element
+	point.SetRandom()
+	proof, err := kzg_bls12377.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	err = kzg_bls12377.Verify(&com, &proof, point, srs.Vk)
+	assert.NoError(err)
+
+	wCmt, err := ValueOfCommitment[sw_bls12377.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bls12377.Scalar, sw_bls12377.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKey[sw_bls12377.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[sw_bls12377.Scalar](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationCircuit[sw_bls12377.Scalar, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]{
+		VerifyingKey: wVk,
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+	}
+	assert.SolvingSucceeded(&KZGVerificationCircuit[sw_bls12377.Scalar, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]{}, &assignment, test.WithCurves(ecc.BW6_761))
+
+	// wrong quotient
+	wrongProof := proof
+	wrongProof.H.Add(&wrongProof.H, &srs.Pk.G1[0])
+	assignment.OpeningProof, err = ValueOfOpeningProof[sw_bls12377.Scalar, sw_bls12377.G1Affine](wrongProof)
+	assert.NoError(err)
+	assert.SolvingFailed(&KZGVerificationCircuit[sw_bls12377.Scalar, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]{}, &assignment, test.WithCurves(ecc.BW6_761))
+}
+
+func TestKZGVerificationTwoChainZero(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS12_377.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls12377.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+	var alphaFr fr_bls12377.Element
+	alphaFr.SetBigInt(alpha)
+
+	eval := func(f []fr_bls12377.Element, x fr_bls12377.Element) fr_bls12377.Element {
+		var res fr_bls12377.Element
+		for i := len(f) - 1; i >= 0; i-- {
+			res.Mul(&res, &x).Add(&res, &f[i])
+		}
+		return res
+	}
+
+	var zero, random fr_bls12377.Element
+	random.SetRandom()
+	for _, tc := range []struct {
+		name   string
+		point  fr_bls12377.Element
+		rootAt *fr_bls12377.Element // f is shifted to have a root at rootAt
+	}{
+		{name: "point=0", point: zero},
+		{name: "claimed=0", point: random, rootAt: &random},
+		{name: "point=0/claimed=0", point: zero, rootAt: &zero},
+		{name: "commitment=0", point: random, rootAt: &alphaFr},
+	} {
+		assert.Run(func(assert *test.Assert) {
+			f := make([]fr_bls12377.Element, polynomialSize)
+			for i := range f {
+				f[i].SetRandom()
+			}
+			if tc.rootAt != nil {
+				v := eval(f, *tc.rootAt)
+				f[0].Sub(&f[0], &v)
+			}
+
+			com, err := kzg_bls12377.Commit(f, srs.Pk)
+			assert.NoError(err)
+			proof, err := kzg_bls12377.Open(f, tc.point, srs.Pk)
+			assert.NoError(err)
+			err = kzg_bls12377.Verify(&com, &proof, tc.point, srs.Vk)
+			assert.NoError(err)
+
+			wCmt, err := ValueOfCommitment[sw_bls12377.G1Affine](com)
+			assert.NoError(err)
+			wProof, err := ValueOfOpeningProof[sw_bls12377.Scalar, sw_bls12377.G1Affine](proof)
+			assert.NoError(err)
+			wVk, err := ValueOfVerifyingKey[sw_bls12377.G2Affine](srs.Vk)
+			assert.NoError(err)
+			wPt, err := ValueOfScalar[sw_bls12377.Scalar](tc.point)
+			assert.NoError(err)
+
+			assignment := KZGVerificationCircuit[sw_bls12377.Scalar, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]{
+				VerifyingKey: wVk,
+				Commitment:   wCmt,
+				OpeningProof: wProof,
+				Point:        wPt,
+			}
+			assert.SolvingSucceeded(&KZGVerificationCircuit[sw_bls12377.Scalar, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]{}, &assignment, test.WithCurves(ecc.BW6_761))
+
+			// wrong claimed value
+			wrongProof := proof
+			wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, new(fr_bls12377.Element).SetOne())
+			assignment.OpeningProof, err = ValueOfOpeningProof[sw_bls12377.Scalar, sw_bls12377.G1Affine](wrongProof)
+			assert.NoError(err)
+			assert.SolvingFailed(&KZGVerificationCircuit[sw_bls12377.Scalar, sw_bls12377.G1Affine, sw_bls12377.G2Affine, sw_bls12377.GT]{}, &assignment, test.WithCurves(ecc.BW6_761))
+		}, tc.name)
+	}
+}
+
+func TestKZGVerificationTwoChain2(t *testing.T) {
+	assert := test.NewAssert(t)
+
+	alpha, err := rand.Int(rand.Reader, ecc.BLS24_315.ScalarField())
+	assert.NoError(err)
+	srs, err := kzg_bls24315.NewSRS(kzgSize, alpha)
+	assert.NoError(err)
+
+	f := make([]fr_bls24315.Element, polynomialSize)
+	for i := range f {
+		f[i].SetRandom()
+	}
+
+	com, err := kzg_bls24315.Commit(f, srs.Pk)
+	assert.NoError(err)
+
+	var point fr_bls24315.Element
+	point.SetRandom()
+	proof, err := kzg_bls24315.Open(f, point, srs.Pk)
+	assert.NoError(err)
+
+	err = kzg_bls24315.Verify(&com, &proof, point, srs.Vk)
+	assert.NoError(err)
+
+	wCmt, err := ValueOfCommitment[sw_bls24315.G1Affine](com)
+	assert.NoError(err)
+	wProof, err := ValueOfOpeningProof[sw_bls24315.Scalar, sw_bls24315.G1Affine](proof)
+	assert.NoError(err)
+	wVk, err := ValueOfVerifyingKey[sw_bls24315.G2Affine](srs.Vk)
+	assert.NoError(err)
+	wPt, err := ValueOfScalar[sw_bls24315.Scalar](point)
+	assert.NoError(err)
+
+	assignment := KZGVerificationCircuit[sw_bls24315.Scalar, sw_bls24315.G1Affine, sw_bls24315.G2Affine, sw_bls24315.GT]{
+		VerifyingKey: wVk,
+		Commitment:   wCmt,
+		OpeningProof: wProof,
+		Point:        wPt,
+	}
+	assert.SolvingSucceeded(&KZGVerificationCircuit[sw_bls24315.Scalar, sw_bls24315.G1Affine, sw_bls24315.G2Affine, sw_bls24315.GT]{}, &assignment, test.WithCurves(ecc.BW6_633))
+}
+
+func TestValueOfMismatch(t *testing.T) {
+	assert := test.NewAssert(t)
+	var point fr_bls12377.Element
+	_, err := ValueOfScalar[sw_bn254.Scalar](point)
+	assert.Error(err)
+}
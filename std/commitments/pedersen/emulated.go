@@ -0,0 +1,58 @@
+package pedersen
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// EmulatedCommitter computes Pedersen commitments to vectors of scalars over a
+// prime order curve in short Weierstrass form using field emulation.
+type EmulatedCommitter[B, S emulated.FieldParams] struct {
+	curve      *sw_emulated.Curve[B, S]
+	generators []*sw_emulated.AffinePoint[B]
+}
+
+// NewEmulated returns a new [EmulatedCommitter] for the generators with the
+// given affine coordinates. The curve parameters are obtained using
+// [sw_emulated.GetCurveParams]. The generators are fixed at circuit compile
+// time and must be points of the curve of prime order S. The number of
+// generators bounds the length of the committed vectors.
+//
+// It returns an error if no generators are given or initialising the curve
+// fails.
+func NewEmulated[B, S emulated.FieldParams](api frontend.API, generators [][2]*big.Int) (*EmulatedCommitter[B, S], error) {
+	if len(generators) == 0 {
+		return nil, errors.New("no generators")
+	}
+	curve, err := sw_emulated.New[B, S](api, sw_emulated.GetCurveParams[B]())
+	if err != nil {
+		return nil, fmt.Errorf("new curve: %w", err)
+	}
+	gens := make([]*sw_emulated.AffinePoint[B], len(generators))
+	for i := range generators {
+		gens[i] = &sw_emulated.AffinePoint[B]{
+			X: emulated.ValueOf[B](generators[i][0]),
+			Y: emulated.ValueOf[B](generators[i][1]),
+		}
+	}
+	return &EmulatedCommitter[B, S]{
+		curve:      curve,
+		generators: gens,
+	}, nil
+}
+
+// Commit returns the commitment to the values. If the commitment is the point
+// at infinity, then it returns (0,0).
+//
+// It returns an error if there are more values than generators.
+func (c *EmulatedCommitter[B, S]) Commit(values []*emulated.Element[S]) (*sw_emulated.AffinePoint[B], error) {
+	if len(values) > len(c.generators) {
+		return nil, fmt.Errorf("got %d values for %d generators", len(values), len(c.generators))
+	}
+	return c.curve.MultiScalarMul(c.generators[:len(values)], values)
+}
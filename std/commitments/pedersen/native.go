@@ -0,0 +1,322 @@
+package pedersen
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	tedwards "github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Generators returns n generators of the prime order subgroup of the twisted
+// Edwards curve id, derived deterministically from the domain separation tag
+// dst. The discrete logarithm relations between the generators are unknown.
+//
+// It returns an error if the curve is unknown.
+func Generators(id tedwards.ID, dst []byte, n int) ([][2]*big.Int, error) {
+	params, err := twistededwards.GetCurveParams(id)
+	if err != nil {
+		return nil, err
+	}
+	field, err := twistededwards.GetSnarkField(id)
+	if err != nil {
+		return nil, err
+	}
+	ec := newTECurve(params, field)
+	res := make([][2]*big.Int, n)
+	for i := range res {
+		for ctr := uint32(0); ; ctr++ {
+			// y = H(dst || i || ctr) mod p and x² = (1 - y²) / (a - d*y²)
+			y := hashToInt(dst, uint32(i), ctr, field)
+			yy := new(big.Int).Mul(y, y)
+			num := new(big.Int).Sub(big.NewInt(1), yy)
+			den := new(big.Int).Mul(ec.d, yy)
+			den.Sub(ec.a, den).Mod(den, field)
+			if den.Sign() == 0 {
+				continue
+			}
+			xx := new(big.Int).ModInverse(den, field)
+			xx.Mul(xx, num).Mod(xx, field)
+			x := new(big.Int).ModSqrt(xx, field)
+			if x == nil {
+				continue
+			}
+			if x.Bit(0) == 1 {
+				x.Sub(field, x)
+			}
+			p := ec.scalarMul([2]*big.Int{x, y}, params.Cofactor)
+			if ec.isIdentity(p) {
+				continue
+			}
+			res[i] = p
+			break
+		}
+	}
+	return res, nil
+}
+
+// Commitment computes the commitment to the values as [Committer.Commit]
+// outside of the circuit. The result can be assigned to a
+// [twistededwards.Point] in the witness.
+//
+// It returns an error if the curve is unknown, if there are more values than
+// generators or if a value is not smaller than the order of the subgroup.
+func Commitment(id tedwards.ID, generators [][2]*big.Int, values []*big.Int) (twistededwards.Point, error) {
+	params, err := twistededwards.GetCurveParams(id)
+	if err != nil {
+		return twistededwards.Point{}, err
+	}
+	field, err := twistededwards.GetSnarkField(id)
+	if err != nil {
+		return twistededwards.Point{}, err
+	}
+	if len(values) > len(generators) {
+		return twistededwards.Point{}, fmt.Errorf("got %d values for %d generators", len(values), len(generators))
+	}
+	ec := newTECurve(params, field)
+	res := ec.identity()
+	for i := range values {
+		if values[i].Sign() < 0 || values[i].Cmp(params.Order) >= 0 {
+			return twistededwards.Point{}, fmt.Errorf("value %d not in [0, order)", i)
+		}
+		res = ec.add(res, ec.scalarMul(generators[i], values[i]))
+	}
+	return twistededwards.Point{X: res[0], Y: res[1]}, nil
+}
+
+// CommitmentBits computes the commitment to the bit string b as
+// [Committer.CommitBits] outside of the circuit. The result can be assigned to
+// a [twistededwards.Point] in the witness.
+//
+// It returns an error if the curve is unknown or if there are more chunks than
+// generators.
+func CommitmentBits(id tedwards.ID, generators [][2]*big.Int, b []bool) (twistededwards.Point, error) {
+	params, err := twistededwards.GetCurveParams(id)
+	if err != nil {
+		return twistededwards.Point{}, err
+	}
+	chunkSize := params.Order.BitLen() - 1
+	values := make([]*big.Int, (len(b)+chunkSize-1)/chunkSize)
+	for i := range values {
+		values[i] = new(big.Int)
+		for j := i * chunkSize; j < (i+1)*chunkSize && j < len(b); j++ {
+			if b[j] {
+				values[i].SetBit(values[i], j-i*chunkSize, 1)
+			}
+		}
+	}
+	return Commitment(id, generators, values)
+}
+
+// EmulatedGenerators returns n generators of the curve defined over the field
+// B, derived deterministically from the domain separation tag dst. The
+// discrete logarithm relations between the generators are unknown. The curve
+// parameters are obtained using [sw_emulated.GetCurveParams].
+//
+// It returns an error if the curve does not have prime order S.
+func EmulatedGenerators[B, S emulated.FieldParams](dst []byte, n int) ([][2]*big.Int, error) {
+	var fp B
+	var fr S
+	params := sw_emulated.GetCurveParams[B]()
+	ec := newSWCurve(params, fp.Modulus())
+	res := make([][2]*big.Int, n)
+	for i := range res {
+		for ctr := uint32(0); ; ctr++ {
+			// x = H(dst || i || ctr) mod p and y² = x³ + ax + b
+			x := hashToInt(dst, uint32(i), ctr, ec.p)
+			y := new(big.Int).ModSqrt(ec.rhs(x), ec.p)
+			if y == nil {
+				continue
+			}
+			if y.Bit(0) == 1 {
+				y.Sub(ec.p, y)
+			}
+			p := &[2]*big.Int{x, y}
+			if ec.scalarMul(p, fr.Modulus()) != nil {
+				return nil, errors.New("curve order is not prime")
+			}
+			res[i] = *p
+			break
+		}
+	}
+	return res, nil
+}
+
+// EmulatedCommitment computes the commitment to the values as
+// [EmulatedCommitter.Commit] outside of the circuit. The result can be assigned
+// to a [sw_emulated.AffinePoint] in the witness. If the commitment is the point
+// at infinity, then the result is (0,0).
+//
+// It returns an error if there are more values than generators.
+func EmulatedCommitment[B emulated.FieldParams](generators [][2]*big.Int, values []*big.Int) (sw_emulated.AffinePoint[B], error) {
+	var fp B
+	if len(values) > len(generators) {
+		return sw_emulated.AffinePoint[B]{}, fmt.Errorf("got %d values for %d generators", len(values), len(generators))
+	}
+	ec := newSWCurve(sw_emulated.GetCurveParams[B](), fp.Modulus())
+	var res *[2]*big.Int
+	for i := range values {
+		res = ec.add(res, ec.scalarMul(&generators[i], values[i]))
+	}
+	if res == nil {
+		res = &[2]*big.Int{new(big.Int), new(big.Int)}
+	}
+	return sw_emulated.AffinePoint[B]{
+		X: emulated.ValueOf[B](res[0]),
+		Y: emulated.ValueOf[B](res[1]),
+	}, nil
+}
+
+// hashToInt returns H(dst || i || ctr) mod p for SHA-256 H. The bit length of p
+// must be at most 256.
+func hashToInt(dst []byte, i, ctr uint32, p *big.Int) *big.Int {
+	h := sha256.New()
+	h.Write(dst)
+	var buf [8]byte
+	binary.BigEndian.PutUint32(buf[:4], i)
+	binary.BigEndian.PutUint32(buf[4:], ctr)
+	h.Write(buf[:])
+	res := new(big.Int).SetBytes(h.Sum(nil))
+	return res.Mod(res, p)
+}
+
+// teCurve implements the arithmetic of the twisted Edwards curve
+// ax² + y² = 1 + dx²y² outside of the circuit.
+type teCurve struct {
+	a, d, p *big.Int
+}
+
+func newTECurve(params *twistededwards.CurveParams, p *big.Int) *teCurve {
+	return &teCurve{
+		a: new(big.Int).Mod(params.A, p),
+		d: new(big.Int).Mod(params.D, p),
+		p: p,
+	}
+}
+
+func (ec *teCurve) identity() [2]*big.Int {
+	return [2]*big.Int{big.NewInt(0), big.NewInt(1)}
+}
+
+func (ec *teCurve) isIdentity(q [2]*big.Int) bool {
+	return q[0].Sign() == 0 && q[1].Cmp(big.NewInt(1)) == 0
+}
+
+func (ec *teCurve) isOnCurve(q [2]*big.Int) bool {
+	xx := new(big.Int).Mul(q[0], q[0])
+	yy := new(big.Int).Mul(q[1], q[1])
+	lhs := new(big.Int).Mul(ec.a, xx)
+	lhs.Add(lhs, yy).Mod(lhs, ec.p)
+	rhs := new(big.Int).Mul(ec.d, xx)
+	rhs.Mul(rhs, yy).Add(rhs, big.NewInt(1)).Mod(rhs, ec.p)
+	return lhs.Cmp(rhs) == 0
+}
+
+// add returns q1 + q2 using the complete addition formulas
+//
+//	x3 = (x1y2 + y1x2) / (1 + dx1x2y1y2)
+//	y3 = (y1y2 - ax1x2) / (1 - dx1x2y1y2)
+func (ec *teCurve) add(q1, q2 [2]*big.Int) [2]*big.Int {
+	x1x2 := new(big.Int).Mul(q1[0], q2[0])
+	y1y2 := new(big.Int).Mul(q1[1], q2[1])
+	x1y2 := new(big.Int).Mul(q1[0], q2[1])
+	y1x2 := new(big.Int).Mul(q1[1], q2[0])
+	dxy := new(big.Int).Mul(x1x2, y1y2)
+	dxy.Mul(dxy, ec.d).Mod(dxy, ec.p)
+
+	x3 := new(big.Int).Add(x1y2, y1x2)
+	den := new(big.Int).Add(big.NewInt(1), dxy)
+	den.ModInverse(den, ec.p)
+	x3.Mul(x3, den).Mod(x3, ec.p)
+
+	y3 := new(big.Int).Mul(ec.a, x1x2)
+	y3.Sub(y1y2, y3)
+	den.Sub(big.NewInt(1), dxy).Mod(den, ec.p)
+	den.ModInverse(den, ec.p)
+	y3.Mul(y3, den).Mod(y3, ec.p)
+	return [2]*big.Int{x3, y3}
+}
+
+// scalarMul returns [s]q for s ≥ 0.
+func (ec *teCurve) scalarMul(q [2]*big.Int, s *big.Int) [2]*big.Int {
+	res := ec.identity()
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = ec.add(res, res)
+		if s.Bit(i) == 1 {
+			res = ec.add(res, q)
+		}
+	}
+	return res
+}
+
+// swCurve implements the arithmetic of the short Weierstrass curve
+// y² = x³ + ax + b outside of the circuit. The point at infinity is nil.
+type swCurve struct {
+	a, b, p *big.Int
+}
+
+func newSWCurve(params sw_emulated.CurveParams, p *big.Int) *swCurve {
+	return &swCurve{
+		a: new(big.Int).Mod(params.A, p),
+		b: new(big.Int).Mod(params.B, p),
+		p: p,
+	}
+}
+
+// rhs returns x³ + ax + b.
+func (ec *swCurve) rhs(x *big.Int) *big.Int {
+	res := new(big.Int).Mul(x, x)
+	res.Add(res, ec.a).Mul(res, x).Add(res, ec.b)
+	return res.Mod(res, ec.p)
+}
+
+// add returns q1 + q2.
+func (ec *swCurve) add(q1, q2 *[2]*big.Int) *[2]*big.Int {
+	if q1 == nil {
+		return q2
+	}
+	if q2 == nil {
+		return q1
+	}
+	var λ *big.Int
+	if q1[0].Cmp(q2[0]) == 0 {
+		sum := new(big.Int).Add(q1[1], q2[1])
+		if sum.Mod(sum, ec.p).Sign() == 0 {
+			return nil
+		}
+		// λ = (3x² + a) / 2y
+		λ = new(big.Int).Mul(q1[0], q1[0])
+		λ.Mul(λ, big.NewInt(3)).Add(λ, ec.a)
+		den := new(big.Int).Lsh(q1[1], 1)
+		λ.Mul(λ, den.ModInverse(den, ec.p))
+	} else {
+		// λ = (y2 - y1) / (x2 - x1)
+		λ = new(big.Int).Sub(q2[1], q1[1])
+		den := new(big.Int).Sub(q2[0], q1[0])
+		den.Mod(den, ec.p)
+		λ.Mul(λ, den.ModInverse(den, ec.p))
+	}
+	λ.Mod(λ, ec.p)
+	x3 := new(big.Int).Mul(λ, λ)
+	x3.Sub(x3, q1[0]).Sub(x3, q2[0]).Mod(x3, ec.p)
+	y3 := new(big.Int).Sub(q1[0], x3)
+	y3.Mul(y3, λ).Sub(y3, q1[1]).Mod(y3, ec.p)
+	return &[2]*big.Int{x3, y3}
+}
+
+// scalarMul returns [s]q for s ≥ 0.
+func (ec *swCurve) scalarMul(q *[2]*big.Int, s *big.Int) *[2]*big.Int {
+	var res *[2]*big.Int
+	for i := s.BitLen() - 1; i >= 0; i-- {
+		res = ec.add(res, res)
+		if s.Bit(i) == 1 {
+			res = ec.add(res, q)
+		}
+	}
+	return res
+}
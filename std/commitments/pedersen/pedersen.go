@@ -0,0 +1,225 @@
+/*
+Package pedersen implements Pedersen vector commitments in circuit.
+
+A commitment to the vector of values (v_1, ..., v_n) for the generators (G_1,
+..., G_n) is the point
+
+	C = [v_1]G_1 + ... + [v_n]G_n.
+
+The commitment is binding as long as the discrete logarithm relations between
+the generators are unknown. The package provides deterministic derivation of
+such generators from a domain separation tag ([Generators] and
+[EmulatedGenerators]), but any set of generators can be used.
+
+The package provides two implementations:
+  - [Committer] commits to native field elements over a twisted Edwards curve
+    defined over the native field (for example Baby-Jubjub in a BN254 circuit)
+    using package [github.com/consensys/gnark/std/algebra/native/twistededwards].
+    As the generators are fixed at circuit compile time, the scalar
+    multiplications use precomputed windowed tables in the spirit of the
+    Pedersen hash of Zcash Sapling. The values are either integers smaller
+    than the order of the subgroup ([Committer.Commit]) or chunks of a bit
+    string ([Committer.CommitBits]).
+  - [EmulatedCommitter] commits to scalars over any prime order curve in
+    short Weierstrass form supported by package
+    [github.com/consensys/gnark/std/algebra/emulated/sw_emulated] using
+    multi-scalar multiplication with field emulation.
+
+The functions [Commitment], [CommitmentBits] and [EmulatedCommitment] compute
+the commitments outside of the circuit and return values which can be directly
+used in the witness assignment.
+*/
+package pedersen
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/cmp"
+)
+
+// windowSize is the number of bits of the scalar processed per table lookup in
+// the fixed-base scalar multiplications.
+const windowSize = 3
+
+// Option allows to configure the [Committer].
+type Option func(*config) error
+
+type config struct {
+	nbBits int
+}
+
+// WithNbBits sets the maximal bit length of the committed values. If not set,
+// the values are asserted to be smaller than the order of the subgroup
+// generated by the generators. Setting a bound smaller than the bit length of
+// the order reduces the number of constraints.
+func WithNbBits(nbBits int) Option {
+	return func(cfg *config) error {
+		if nbBits <= 0 {
+			return fmt.Errorf("invalid number of bits %d", nbBits)
+		}
+		cfg.nbBits = nbBits
+		return nil
+	}
+}
+
+// Committer computes Pedersen commitments to vectors of native field elements
+// over a twisted Edwards curve defined over the native field.
+type Committer struct {
+	api       frontend.API
+	curve     twistededwards.Curve
+	nbBits    int
+	order     *big.Int
+	chunkSize int
+	// tables[i][j][k] = [k * 2^(windowSize*j)]G_i for k < 2^windowSize
+	tables [][][][2]*big.Int
+}
+
+// New returns a new [Committer] for the generators with the given affine
+// coordinates. The generators are fixed at circuit compile time and must be
+// points of the curve. The number of generators bounds the length of the
+// committed vectors.
+//
+// It returns an error if no generators are given, a generator is not on the
+// curve or applying the options fails.
+func New(curve twistededwards.Curve, generators [][2]*big.Int, opts ...Option) (*Committer, error) {
+	if len(generators) == 0 {
+		return nil, errors.New("no generators")
+	}
+	api := curve.API()
+	params := curve.Params()
+	field := api.Compiler().Field()
+	cfg := config{nbBits: params.Order.BitLen()}
+	for _, opt := range opts {
+		if err := opt(&cfg); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+	ec := newTECurve(params, field)
+	c := &Committer{
+		api:    api,
+		curve:  curve,
+		nbBits: cfg.nbBits,
+		order:  new(big.Int).Set(params.Order),
+		// the chunks of the bit strings must be smaller than the order of the
+		// subgroup for the commitment to be binding.
+		chunkSize: params.Order.BitLen() - 1,
+		tables:    make([][][][2]*big.Int, len(generators)),
+	}
+	maxBits := c.nbBits
+	if c.chunkSize > maxBits {
+		maxBits = c.chunkSize
+	}
+	nbWindows := (maxBits + windowSize - 1) / windowSize
+	for i := range generators {
+		if !ec.isOnCurve(generators[i]) {
+			return nil, fmt.Errorf("generator %d not on curve", i)
+		}
+		c.tables[i] = make([][][2]*big.Int, nbWindows)
+		base := generators[i]
+		for j := range c.tables[i] {
+			c.tables[i][j] = make([][2]*big.Int, 1<<windowSize)
+			c.tables[i][j][0] = ec.identity()
+			for k := 1; k < len(c.tables[i][j]); k++ {
+				c.tables[i][j][k] = ec.add(c.tables[i][j][k-1], base)
+			}
+			base = ec.add(c.tables[i][j][len(c.tables[i][j])-1], base)
+		}
+	}
+	return c, nil
+}
+
+// Commit returns the commitment to the values. The values are decomposed into
+// bits to the number of bits set with [WithNbBits]. If the values may be
+// larger than the order ℓ of the subgroup, then they are asserted to be
+// smaller than ℓ, as otherwise v and v+ℓ would have the same commitment.
+//
+// It returns an error if there are more values than generators.
+func (c *Committer) Commit(values []frontend.Variable) (twistededwards.Point, error) {
+	if len(values) > len(c.tables) {
+		return twistededwards.Point{}, fmt.Errorf("got %d values for %d generators", len(values), len(c.tables))
+	}
+	vBits := make([][]frontend.Variable, len(values))
+	for i := range values {
+		vBits[i] = bits.ToBinary(c.api, values[i], bits.WithNbDigits(c.nbBits))
+		if c.nbBits >= c.order.BitLen() {
+			c.assertSmallerThanOrder(vBits[i])
+		}
+	}
+	return c.commitBits(vBits), nil
+}
+
+// CommitBits returns the commitment to the bit string b. The bits are split
+// into chunks of size one less than the bit length of the order of the
+// subgroup and the i-th chunk, interpreted as a little-endian integer, is
+// committed with the i-th generator. The elements of b are asserted to be
+// boolean.
+//
+// It returns an error if there are more chunks than generators.
+func (c *Committer) CommitBits(b []frontend.Variable) (twistededwards.Point, error) {
+	nbChunks := (len(b) + c.chunkSize - 1) / c.chunkSize
+	if nbChunks > len(c.tables) {
+		return twistededwards.Point{}, fmt.Errorf("got %d chunks for %d generators", nbChunks, len(c.tables))
+	}
+	chunks := make([][]frontend.Variable, nbChunks)
+	for i := range chunks {
+		end := (i + 1) * c.chunkSize
+		if end > len(b) {
+			end = len(b)
+		}
+		chunks[i] = b[i*c.chunkSize : end]
+		for j := range chunks[i] {
+			c.api.AssertIsBoolean(chunks[i][j])
+		}
+	}
+	return c.commitBits(chunks), nil
+}
+
+// commitBits returns Σ [v_i]G_i where v_i is given by the little-endian bits
+// vBits[i]. Every window of bits selects a precomputed multiple of the
+// generator, so that the commitment costs one addition per window.
+func (c *Committer) commitBits(vBits [][]frontend.Variable) twistededwards.Point {
+	res := twistededwards.Point{X: 0, Y: 1}
+	for i := range vBits {
+		for j := 0; j*windowSize < len(vBits[i]); j++ {
+			var w [windowSize]frontend.Variable
+			for k := range w {
+				if j*windowSize+k < len(vBits[i]) {
+					w[k] = vBits[i][j*windowSize+k]
+				} else {
+					w[k] = 0
+				}
+			}
+			res = c.curve.Add(res, c.lookup(c.tables[i][j], w))
+		}
+	}
+	return res
+}
+
+// lookup returns table[w[0] + 2*w[1] + 4*w[2]].
+func (c *Committer) lookup(table [][2]*big.Int, w [windowSize]frontend.Variable) twistededwards.Point {
+	var res [2]frontend.Variable
+	for i := range res {
+		lo := c.api.Lookup2(w[0], w[1], table[0][i], table[1][i], table[2][i], table[3][i])
+		hi := c.api.Lookup2(w[0], w[1], table[4][i], table[5][i], table[6][i], table[7][i])
+		res[i] = c.api.Select(w[2], hi, lo)
+	}
+	return twistededwards.Point{X: res[0], Y: res[1]}
+}
+
+// assertSmallerThanOrder asserts that the little-endian bits vBits represent
+// an integer smaller than the order of the subgroup. As the order is smaller
+// than the native field modulus, this also asserts that the decomposition is
+// canonical.
+func (c *Committer) assertSmallerThanOrder(vBits []frontend.Variable) {
+	bound := new(big.Int).Sub(c.order, big.NewInt(1))
+	boundBits := make([]frontend.Variable, len(vBits))
+	for i := range boundBits {
+		boundBits[i] = bound.Bit(i)
+	}
+	c.api.AssertIsEqual(cmp.IsLessOrEqualBinary(c.api, vBits, boundBits), 1)
+}
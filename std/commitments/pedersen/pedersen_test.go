@@ -0,0 +1,265 @@
+package pedersen
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	edwardsbn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	tedwards "github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+var testDST = []byte("gnark-pedersen-test")
+
+func TestGenerators(t *testing.T) {
+	assert := test.NewAssert(t)
+	gens, err := Generators(tedwards.BN254, testDST, 4)
+	assert.NoError(err)
+	gens2, err := Generators(tedwards.BN254, testDST, 4)
+	assert.NoError(err)
+	params := edwardsbn254.GetEdwardsCurve()
+	for i := range gens {
+		assert.Equal(0, gens[i][0].Cmp(gens2[i][0]))
+		assert.Equal(0, gens[i][1].Cmp(gens2[i][1]))
+		var p, q edwardsbn254.PointAffine
+		p.X.SetBigInt(gens[i][0])
+		p.Y.SetBigInt(gens[i][1])
+		assert.True(p.IsOnCurve())
+		q.ScalarMultiplication(&p, &params.Order)
+		assert.True(q.IsZero())
+		for j := 0; j < i; j++ {
+			assert.NotEqual(0, gens[i][1].Cmp(gens[j][1]))
+		}
+	}
+
+	// compare the native commitment with gnark-crypto
+	values := make([]*big.Int, len(gens))
+	var expected, t0 edwardsbn254.PointAffine
+	expected.X.SetZero()
+	expected.Y.SetOne()
+	for i := range gens {
+		values[i], err = rand.Int(rand.Reader, &params.Order)
+		assert.NoError(err)
+		var p edwardsbn254.PointAffine
+		p.X.SetBigInt(gens[i][0])
+		p.Y.SetBigInt(gens[i][1])
+		t0.ScalarMultiplication(&p, values[i])
+		expected.Add(&expected, &t0)
+	}
+	res, err := Commitment(tedwards.BN254, gens, values)
+	assert.NoError(err)
+	assert.Equal(0, res.X.(*big.Int).Cmp(expected.X.BigInt(new(big.Int))))
+	assert.Equal(0, res.Y.(*big.Int).Cmp(expected.Y.BigInt(new(big.Int))))
+}
+
+type commitCircuit struct {
+	Values     []frontend.Variable
+	Commitment twistededwards.Point
+	generators [][2]*big.Int
+	opts       []Option
+}
+
+func (c *commitCircuit) Define(api frontend.API) error {
+	curve, err := twistededwards.NewEdCurve(api, tedwards.BN254)
+	if err != nil {
+		return err
+	}
+	committer, err := New(curve, c.generators, c.opts...)
+	if err != nil {
+		return err
+	}
+	res, err := committer.Commit(c.Values)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(res.X, c.Commitment.X)
+	api.AssertIsEqual(res.Y, c.Commitment.Y)
+	return nil
+}
+
+func TestCommit(t *testing.T) {
+	assert := test.NewAssert(t)
+	gens, err := Generators(tedwards.BN254, testDST, 3)
+	assert.NoError(err)
+	params, err := twistededwards.GetCurveParams(tedwards.BN254)
+	assert.NoError(err)
+	values := []*big.Int{big.NewInt(0), new(big.Int).Sub(params.Order, big.NewInt(1)), nil}
+	values[2], err = rand.Int(rand.Reader, params.Order)
+	assert.NoError(err)
+	res, err := Commitment(tedwards.BN254, gens, values)
+	assert.NoError(err)
+
+	circuit := commitCircuit{Values: make([]frontend.Variable, len(values)), generators: gens}
+	witness := commitCircuit{Values: make([]frontend.Variable, len(values)), Commitment: res}
+	for i := range values {
+		witness.Values[i] = values[i]
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// wrong value
+	witness.Values[0] = 1
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestCommitOrderCollision(t *testing.T) {
+	assert := test.NewAssert(t)
+	gens, err := Generators(tedwards.BN254, testDST, 1)
+	assert.NoError(err)
+	params, err := twistededwards.GetCurveParams(tedwards.BN254)
+	assert.NoError(err)
+	v := big.NewInt(12345)
+	res, err := Commitment(tedwards.BN254, gens, []*big.Int{v})
+	assert.NoError(err)
+
+	circuit := commitCircuit{Values: make([]frontend.Variable, 1), generators: gens}
+	witness := commitCircuit{Values: []frontend.Variable{v}, Commitment: res}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// v+ℓ is a native field element with the same commitment as v.
+	vl := new(big.Int).Add(v, params.Order)
+	assert.True(vl.Cmp(fr.Modulus()) < 0)
+	witness.Values[0] = vl
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+	_, err = Commitment(tedwards.BN254, gens, []*big.Int{vl})
+	assert.Error(err)
+}
+
+func TestCommitNbBits(t *testing.T) {
+	assert := test.NewAssert(t)
+	gens, err := Generators(tedwards.BN254, testDST, 2)
+	assert.NoError(err)
+	values := []*big.Int{big.NewInt(0xffffffff), big.NewInt(12345)}
+	res, err := Commitment(tedwards.BN254, gens, values)
+	assert.NoError(err)
+
+	circuit := commitCircuit{Values: make([]frontend.Variable, len(values)), generators: gens, opts: []Option{WithNbBits(32)}}
+	witness := commitCircuit{Values: []frontend.Variable{values[0], values[1]}, Commitment: res}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// value out of range
+	witness.Values[0] = 1 << 32
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type commitBitsCircuit struct {
+	Bits       []frontend.Variable
+	Commitment twistededwards.Point
+	generators [][2]*big.Int
+}
+
+func (c *commitBitsCircuit) Define(api frontend.API) error {
+	curve, err := twistededwards.NewEdCurve(api, tedwards.BN254)
+	if err != nil {
+		return err
+	}
+	committer, err := New(curve, c.generators)
+	if err != nil {
+		return err
+	}
+	res, err := committer.CommitBits(c.Bits)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(res.X, c.Commitment.X)
+	api.AssertIsEqual(res.Y, c.Commitment.Y)
+	return nil
+}
+
+func TestCommitBits(t *testing.T) {
+	assert := test.NewAssert(t)
+	gens, err := Generators(tedwards.BN254, testDST, 2)
+	assert.NoError(err)
+	// 300 bits span two chunks
+	var buf [38]byte
+	_, err = rand.Read(buf[:])
+	assert.NoError(err)
+	b := make([]bool, 300)
+	for i := range b {
+		b[i] = buf[i/8]>>(i%8)&1 == 1
+	}
+	res, err := CommitmentBits(tedwards.BN254, gens, b)
+	assert.NoError(err)
+
+	circuit := commitBitsCircuit{Bits: make([]frontend.Variable, len(b)), generators: gens}
+	witness := commitBitsCircuit{Bits: make([]frontend.Variable, len(b)), Commitment: res}
+	for i := range b {
+		if b[i] {
+			witness.Bits[i] = 1
+		} else {
+			witness.Bits[i] = 0
+		}
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type emulatedCommitCircuit struct {
+	Values     []emulated.Element[emulated.Secp256k1Fr]
+	Commitment sw_emulated.AffinePoint[emulated.Secp256k1Fp]
+	generators [][2]*big.Int
+}
+
+func (c *emulatedCommitCircuit) Define(api frontend.API) error {
+	committer, err := NewEmulated[emulated.Secp256k1Fp, emulated.Secp256k1Fr](api, c.generators)
+	if err != nil {
+		return err
+	}
+	values := make([]*emulated.Element[emulated.Secp256k1Fr], len(c.Values))
+	for i := range values {
+		values[i] = &c.Values[i]
+	}
+	res, err := committer.Commit(values)
+	if err != nil {
+		return err
+	}
+	committer.curve.AssertIsEqual(res, &c.Commitment)
+	return nil
+}
+
+func TestEmulatedCommit(t *testing.T) {
+	assert := test.NewAssert(t)
+	gens, err := EmulatedGenerators[emulated.Secp256k1Fp, emulated.Secp256k1Fr](testDST, 2)
+	assert.NoError(err)
+	for i := range gens {
+		var p secp256k1.G1Affine
+		p.X.SetBigInt(gens[i][0])
+		p.Y.SetBigInt(gens[i][1])
+		assert.True(p.IsOnCurve())
+	}
+	var fr emulated.Secp256k1Fr
+	values := make([]*big.Int, len(gens))
+	for i := range values {
+		values[i], err = rand.Int(rand.Reader, fr.Modulus())
+		assert.NoError(err)
+	}
+	res, err := EmulatedCommitment[emulated.Secp256k1Fp](gens, values)
+	assert.NoError(err)
+
+	circuit := emulatedCommitCircuit{Values: make([]emulated.Element[emulated.Secp256k1Fr], len(values)), generators: gens}
+	witness := emulatedCommitCircuit{Values: make([]emulated.Element[emulated.Secp256k1Fr], len(values)), Commitment: res}
+	for i := range values {
+		witness.Values[i] = emulated.ValueOf[emulated.Secp256k1Fr](values[i])
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+func TestEmulatedGeneratorsCofactor(t *testing.T) {
+	assert := test.NewAssert(t)
+	_, err := EmulatedGenerators[emulated.BLS12381Fp, emulated.BLS12381Fr](testDST, 1)
+	assert.Error(err)
+}
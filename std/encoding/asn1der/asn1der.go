@@ -0,0 +1,139 @@
+// Package asn1der implements parsing of ASN.1 DER encoded data in circuit.
+//
+// DER is the encoding used for X.509 certificates, ECDSA signatures and many
+// other cryptographic objects. Every element is encoded as tag, length and
+// value (TLV). The value of a constructed element (SEQUENCE, SET, explicitly
+// tagged element) is the concatenation of the encodings of its elements.
+//
+// The parser operates on [bytes.Array] with runtime length and returns the tag
+// and the position and length of the value of an element at a variable
+// offset. The value can then be extracted with [Parser.Value], compared with
+// [bytes.IsEqualPrefix] or hashed. For example, for proving that a field of a
+// certificate has been signed, we hash the TBSCertificate element with the
+// hasher of the signature scheme (such as [sha2]), verify the signature with
+// [ecdsa] and then parse the field out of the TBSCertificate element.
+//
+// Only single-byte tags (tag numbers less than 31) and definite lengths are
+// supported. The parser doesn't check that the length is minimally encoded.
+//
+// See [X.690] for the specification of the encoding.
+//
+// [sha2]: https://pkg.go.dev/github.com/consensys/gnark/std/hash/sha2
+// [ecdsa]: https://pkg.go.dev/github.com/consensys/gnark/std/signature/ecdsa
+// [X.690]: https://www.itu.int/rec/T-REC-X.690
+package asn1der
+
+import (
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/encoding/internal/reader"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+)
+
+// Commonly used tags of universal class.
+const (
+	TagBoolean         = 0x01
+	TagInteger         = 0x02
+	TagBitString       = 0x03
+	TagOctetString     = 0x04
+	TagNull            = 0x05
+	TagOID             = 0x06
+	TagUTF8String      = 0x0c
+	TagPrintableString = 0x13
+	TagUTCTime         = 0x17
+	TagGeneralizedTime = 0x18
+	TagSequence        = 0x30
+	TagSet             = 0x31
+)
+
+// Element is a parsed DER element.
+type Element struct {
+	// Tag is the identifier byte of the element.
+	Tag frontend.Variable
+	// Offset is the position of the value in the data.
+	Offset frontend.Variable
+	// Length is the length of the value.
+	Length frontend.Variable
+}
+
+// Parser parses the elements of DER encoded data.
+type Parser struct {
+	api         frontend.API
+	r           *reader.Reader
+	maxLenBytes int
+	// header is indexed by a byte and has columns (tagValid, lenLen,
+	// shortLen) for interpreting the byte as the tag or the first byte of the
+	// length.
+	header *logderivlookup.MultiTable
+}
+
+// New returns a new [*Parser] for the DER encoded data.
+func New(api frontend.API, data bytes.Array) *Parser {
+	maxLenBytes := reader.MaxLenBytes(len(data.Data))
+	p := &Parser{
+		api:         api,
+		r:           reader.New(api, data, maxLenBytes),
+		maxLenBytes: maxLenBytes,
+		header:      logderivlookup.NewMulti(api, 3),
+	}
+	for b := 0; b < 256; b++ {
+		p.header.Insert(p.headerRow(b))
+	}
+	return p
+}
+
+func (p *Parser) headerRow(b int) []frontend.Variable {
+	tagValid := 1
+	if b&0x1f == 0x1f {
+		// high tag number form
+		tagValid = 0
+	}
+	switch {
+	case b < 0x80:
+		return []frontend.Variable{tagValid, 0, b}
+	case b > 0x80 && b-0x80 <= p.maxLenBytes:
+		return []frontend.Variable{tagValid, b - 0x80, 0}
+	default:
+		// indefinite length (0x80) or the length doesn't fit into the data.
+		// The decoding of the length fails for length fields longer than
+		// maxLenBytes.
+		return []frontend.Variable{tagValid, p.maxLenBytes + 1, 0}
+	}
+}
+
+// Parse parses the element at position offset. It asserts that the tag is in
+// the low tag number form and that the element is within the length of the
+// data.
+func (p *Parser) Parse(offset frontend.Variable) Element {
+	tag := p.r.Byte(offset)
+	lenPos := p.api.Add(offset, 1)
+	rows := p.header.Lookup(tag, p.r.Byte(lenPos))
+	p.api.AssertIsEqual(rows[0][0], 1)
+	lenLen, shortLen := rows[1][1], rows[1][2]
+	long := p.r.BigEndian(p.api.Add(lenPos, 1), lenLen)
+	e := Element{
+		Tag:    tag,
+		Offset: p.api.Add(lenPos, 1, lenLen),
+		Length: p.api.Add(shortLen, long),
+	}
+	p.r.AssertInBounds(p.Next(e))
+	return e
+}
+
+// Next returns the position following the element, i.e. the position of the
+// next element in the same constructed element.
+func (p *Parser) Next(e Element) frontend.Variable {
+	return p.api.Add(e.Offset, e.Length)
+}
+
+// AssertTag asserts that the tag of the element is tag.
+func (p *Parser) AssertTag(e Element, tag byte) {
+	p.api.AssertIsEqual(e.Tag, tag)
+}
+
+// Value returns the value of the element as an array of the given capacity.
+// The length of the value must be at most the capacity, otherwise a proof
+// cannot be generated.
+func (p *Parser) Value(e Element, capacity int) bytes.Array {
+	return bytes.Slice(p.api, p.r.Data(), e.Offset, e.Length, capacity)
+}
@@ -0,0 +1,144 @@
+package asn1der
+
+import (
+	"crypto/sha256"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type record struct {
+	Serial  *big.Int
+	Name    string `asn1:"utf8"`
+	Payload []byte
+}
+
+type ParseCircuit struct {
+	Data    bytes.Array
+	Digest  [32]uints.U8
+	Serial  bytes.Array
+	Name    bytes.Array
+	Payload bytes.Array
+}
+
+func (c *ParseCircuit) Define(api frontend.API) error {
+	// the document is hashed as a whole and its fields are parsed.
+	h, err := sha2.New(api)
+	if err != nil {
+		return err
+	}
+	h.Write(c.Data.Data)
+	dgst := h.Sum()
+	for i := range c.Digest {
+		api.AssertIsEqual(dgst[i].Val, c.Digest[i].Val)
+	}
+	p := New(api, c.Data)
+	seq := p.Parse(0)
+	p.AssertTag(seq, TagSequence)
+	serial := p.Parse(seq.Offset)
+	p.AssertTag(serial, TagInteger)
+	name := p.Parse(p.Next(serial))
+	p.AssertTag(name, TagUTF8String)
+	payload := p.Parse(p.Next(name))
+	p.AssertTag(payload, TagOctetString)
+	api.AssertIsEqual(p.Next(payload), p.Next(seq))
+	for _, v := range []struct {
+		e        Element
+		expected bytes.Array
+	}{{serial, c.Serial}, {name, c.Name}, {payload, c.Payload}} {
+		got := p.Value(v.e, len(v.expected.Data))
+		api.AssertIsEqual(got.Length, v.expected.Length)
+		bytes.AssertIsEqualPrefix(api, got.Data, v.expected.Data, got.Length)
+	}
+	return nil
+}
+
+func TestParse(t *testing.T) {
+	assert := test.NewAssert(t)
+	serial, _ := new(big.Int).SetString("deadbeefdeadbeefdeadbeefdeadbeef", 16)
+	payload := make([]byte, 300)
+	for i := range payload {
+		payload[i] = byte(i)
+	}
+	der, err := asn1.Marshal(record{Serial: serial, Name: "gnark", Payload: payload})
+	assert.NoError(err)
+	serialDER, err := asn1.Marshal(serial)
+	assert.NoError(err)
+	dgst := sha256.Sum256(der)
+
+	circuit := ParseCircuit{
+		Data:    bytes.Placeholder(len(der)),
+		Serial:  bytes.Placeholder(20),
+		Name:    bytes.Placeholder(8),
+		Payload: bytes.Placeholder(300),
+	}
+	witness := ParseCircuit{
+		Data:    bytes.NewArray(der, len(der)),
+		Serial:  bytes.NewArray(serialDER[2:], 20),
+		Name:    bytes.NewArray([]byte("gnark"), 8),
+		Payload: bytes.NewArray(payload, 300),
+	}
+	copy(witness.Digest[:], uints.NewU8Array(dgst[:]))
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// modified name must fail.
+	witness.Name = bytes.NewArray([]byte("gnarl"), 8)
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type ElementCircuit struct {
+	Data                bytes.Array
+	Tag, Offset, Length frontend.Variable
+}
+
+func (c *ElementCircuit) Define(api frontend.API) error {
+	p := New(api, c.Data)
+	e := p.Parse(0)
+	api.AssertIsEqual(e.Tag, c.Tag)
+	api.AssertIsEqual(e.Offset, c.Offset)
+	api.AssertIsEqual(e.Length, c.Length)
+	return nil
+}
+
+func TestParseLength(t *testing.T) {
+	assert := test.NewAssert(t)
+	circuit := ElementCircuit{Data: bytes.Placeholder(300)}
+	for _, tc := range []struct {
+		data                []byte
+		tag, offset, length int
+		success             bool
+	}{
+		{[]byte{0x04, 0x02, 0xaa, 0xbb}, 0x04, 2, 2, true},
+		{append([]byte{0x04, 0x81, 0x80}, make([]byte, 128)...), 0x04, 3, 128, true},
+		{append([]byte{0x30, 0x82, 0x01, 0x00}, make([]byte, 256)...), 0x30, 4, 256, true},
+		// value longer than the data
+		{[]byte{0x04, 0x03, 0xaa, 0xbb}, 0x04, 2, 3, false},
+		// indefinite length
+		{[]byte{0x30, 0x80, 0x00, 0x00}, 0x30, 2, 0, false},
+		// length field too long
+		{[]byte{0x04, 0x83, 0x00, 0x00, 0x01, 0x00}, 0x04, 5, 1, false},
+		// high tag number form
+		{[]byte{0x1f, 0x01, 0x00}, 0x1f, 2, 1, false},
+	} {
+		err := test.IsSolved(&circuit, &ElementCircuit{
+			Data:   bytes.NewArray(tc.data, 300),
+			Tag:    tc.tag,
+			Offset: tc.offset,
+			Length: tc.length,
+		}, ecc.BN254.ScalarField())
+		if tc.success {
+			assert.NoError(err, tc.data[:2])
+		} else {
+			assert.Error(err, tc.data[:2])
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// Package base64 implements base64 encoding and decoding in circuit.
+//
+// The package supports the standard and the URL-safe alphabets, with and
+// without padding, as defined in [RFC 4648]. The encodings are given by
+// [StdEncoding], [URLEncoding], [RawStdEncoding] and [RawURLEncoding],
+// similarly to the encoding/base64 package in the standard library.
+//
+// Every group of three bytes is encoded as four characters. The value of every
+// character is obtained from a hint and checked by looking up the character at
+// this value in the alphabet using [logderivlookup.Table]. Thus, the lookup
+// checks both that the character is in the alphabet and that the value is
+// correct.
+//
+// For data with runtime length, use [Encoding.EncodeArray] and
+// [Encoding.DecodeArray] on [bytes.Array]. The decoder accepts encodings where
+// the unused bits of the last character are not zero.
+//
+// [RFC 4648]: https://datatracker.ietf.org/doc/html/rfc4648
+package base64
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hints used in this package
+func GetHints() []solver.Hint {
+	return []solver.Hint{valuesHint, paddingHint, divHint}
+}
+
+const (
+	encodeStd = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+	encodeURL = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+	padChar   = '='
+)
+
+// Encoding is a base64 encoding defined by the alphabet and the padding.
+type Encoding struct {
+	alphabet string
+	padding  bool
+}
+
+var (
+	// StdEncoding is the standard base64 encoding with padding.
+	StdEncoding = &Encoding{alphabet: encodeStd, padding: true}
+	// URLEncoding is the URL-safe base64 encoding with padding.
+	URLEncoding = &Encoding{alphabet: encodeURL, padding: true}
+	// RawStdEncoding is the standard base64 encoding without padding.
+	RawStdEncoding = &Encoding{alphabet: encodeStd}
+	// RawURLEncoding is the URL-safe base64 encoding without padding, used
+	// for example in JWT.
+	RawURLEncoding = &Encoding{alphabet: encodeURL}
+)
+
+// EncodedLen returns the length of the encoding of n bytes.
+func (e *Encoding) EncodedLen(n int) int {
+	if e.padding {
+		return (n + 2) / 3 * 4
+	}
+	return (n*8 + 5) / 6
+}
+
+// DecodedLen returns the maximal length of the decoding of n characters.
+func (e *Encoding) DecodedLen(n int) int {
+	if e.padding {
+		return n / 4 * 3
+	}
+	return n * 6 / 8
+}
+
+// Encode returns the encoding of src.
+func (e *Encoding) Encode(api frontend.API, src []uints.U8) []uints.U8 {
+	return e.EncodeArray(api, bytes.Array{Data: src, Length: len(src)}).Data
+}
+
+// Decode returns the decoding of src. For the encodings with padding the
+// length of the result depends on the number of padding characters and is
+// only known at solving time. It asserts that src is a valid encoding.
+func (e *Encoding) Decode(api frontend.API, src []uints.U8) bytes.Array {
+	return e.DecodeArray(api, bytes.Array{Data: src, Length: len(src)})
+}
+
+// EncodeArray returns the encoding of src with runtime length. The capacity of
+// the result is the length of the encoding of the capacity of src.
+func (e *Encoding) EncodeArray(api frontend.API, src bytes.Array) bytes.Array {
+	capacity := e.EncodedLen(len(src.Data))
+	nbBits := bits.Len(uint(capacity))
+	in := bytes.Mask(api, src).Data
+	tbl := e.table(api)
+	chars := make([]frontend.Variable, 0, (len(in)+2)/3*4)
+	for g := 0; g < len(in); g += 3 {
+		// the group value is b0*2^16 + b1*2^8 + b2, where the missing bytes of
+		// the last group are zero.
+		var v frontend.Variable = 0
+		for j := 0; j < 3; j++ {
+			v = api.Mul(v, 256)
+			if g+j < len(in) {
+				v = api.Add(v, in[g+j].Val)
+			}
+		}
+		// the lookups ensure that the values are less than 64.
+		vals := split(api, v, 6)
+		chars = append(chars, tbl.Lookup(vals...)...)
+	}
+	// the length of the encoding without padding is ceil(4n/3).
+	rawLen, r := div(api, api.Add(api.Mul(src.Length, 4), 2), 3, nbBits)
+	rangecheck.CheckBound(api, r, big.NewInt(3))
+	length := rawLen
+	charMask := bytes.Indicators(api, capacity, rawLen)
+	padMask := charMask
+	if e.padding {
+		// the length of the encoding with padding is 4*ceil(n/3).
+		q, r := div(api, api.Add(src.Length, 2), 3, nbBits)
+		rangecheck.CheckBound(api, r, big.NewInt(3))
+		length = api.Mul(q, 4)
+		padMask = bytes.Indicators(api, capacity, length)
+	}
+	res := make([]uints.U8, capacity)
+	for i := range res {
+		// the padding mask covers also the characters, so we subtract the
+		// padding character at the positions of the characters.
+		c := api.Mul(charMask[i], api.Sub(chars[i], padChar))
+		res[i] = uints.U8{Val: api.Add(c, api.Mul(padMask[i], padChar))}
+	}
+	return bytes.Array{Data: res, Length: length}
+}
+
+// DecodeArray returns the decoding of src with runtime length. The capacity of
+// the result is [Encoding.DecodedLen] of the capacity of src. It asserts that
+// src is a valid encoding.
+func (e *Encoding) DecodeArray(api frontend.API, src bytes.Array) bytes.Array {
+	capacity := len(src.Data)
+	nbBits := bits.Len(uint(capacity))
+	// the number of characters excluding the padding.
+	nbChars := src.Length
+	var padMask []frontend.Variable
+	if e.padding {
+		// the length of the encoding with padding is a multiple of 4 and
+		// there are at most two padding characters.
+		_, r := div(api, src.Length, 4, nbBits)
+		api.AssertIsEqual(r, 0)
+		nbPad := e.nbPadding(api, src)
+		rangecheck.CheckBound(api, nbPad, big.NewInt(3))
+		nbChars = api.Sub(src.Length, nbPad)
+		padMask = bytes.Indicators(api, capacity, src.Length)
+	}
+	charMask := bytes.Indicators(api, capacity, nbChars)
+	if !e.padding {
+		padMask = charMask
+	}
+
+	in := make([]frontend.Variable, capacity)
+	for i := range src.Data {
+		in[i] = api.Mul(charMask[i], src.Data[i].Val)
+	}
+	vals, err := api.Compiler().NewHint(valuesHint, capacity, in...)
+	if err != nil {
+		panic(fmt.Sprintf("values hint: %v", err))
+	}
+	chars := e.table(api).Lookup(vals...)
+	for i := range vals {
+		// the characters are in the alphabet, the value of the positions
+		// after the characters is zero and the padding characters are '='.
+		api.AssertIsEqual(api.Mul(charMask[i], api.Sub(chars[i], src.Data[i].Val)), 0)
+		api.AssertIsEqual(api.Mul(api.Sub(1, charMask[i]), vals[i]), 0)
+		if e.padding {
+			api.AssertIsEqual(api.Mul(api.Sub(padMask[i], charMask[i]), api.Sub(src.Data[i].Val, padChar)), 0)
+		}
+	}
+
+	rchecker := rangecheck.New(api)
+	decoded := make([]frontend.Variable, 0, (capacity+3)/4*3)
+	for g := 0; g < capacity; g += 4 {
+		var v frontend.Variable = 0
+		for j := 0; j < 4; j++ {
+			v = api.Mul(v, 64)
+			if g+j < capacity {
+				v = api.Add(v, vals[g+j])
+			}
+		}
+		bs := split(api, v, 8)
+		for j := range bs {
+			rchecker.Check(bs[j], 8)
+		}
+		decoded = append(decoded, bs...)
+	}
+	// the length of the decoding is floor(3k/4) for k characters. The
+	// remainder 3 corresponds to the invalid case k = 1 mod 4.
+	length, r := div(api, api.Mul(nbChars, 3), 4, nbBits)
+	rangecheck.CheckBound(api, r, big.NewInt(3))
+	res := bytes.Array{Data: make([]uints.U8, e.DecodedLen(capacity)), Length: length}
+	for i := range res.Data {
+		res.Data[i] = uints.U8{Val: decoded[i]}
+	}
+	return bytes.Mask(api, res)
+}
+
+// table returns the lookup table of the alphabet.
+func (e *Encoding) table(api frontend.API) *logderivlookup.Table {
+	t := logderivlookup.New(api)
+	for i := range e.alphabet {
+		t.Insert(e.alphabet[i])
+	}
+	return t
+}
+
+// nbPadding returns the number of padding characters at the end of src. The
+// result is checked by the caller.
+func (e *Encoding) nbPadding(api frontend.API, src bytes.Array) frontend.Variable {
+	in := make([]frontend.Variable, 0, 1+len(src.Data))
+	in = append(in, src.Length)
+	for i := range src.Data {
+		in = append(in, src.Data[i].Val)
+	}
+	res, err := api.Compiler().NewHint(paddingHint, 1, in...)
+	if err != nil {
+		panic(fmt.Sprintf("padding hint: %v", err))
+	}
+	return res[0]
+}
+
+// split returns the four 6-bit or three 8-bit values of the 24-bit value v in
+// big-endian order. The values are not range checked.
+func split(api frontend.API, v frontend.Variable, width int) []frontend.Variable {
+	limbs, err := api.Compiler().NewHint(rangecheck.DecomposeHint, 24/width, 24, width, v)
+	if err != nil {
+		panic(fmt.Sprintf("decompose hint: %v", err))
+	}
+	var composed frontend.Variable = 0
+	res := make([]frontend.Variable, len(limbs))
+	for i := range limbs {
+		res[i] = limbs[len(limbs)-1-i]
+		composed = api.Add(api.Mul(composed, 1<<width), res[i])
+	}
+	api.AssertIsEqual(composed, v)
+	return res
+}
+
+// div returns the quotient and the remainder of the division of x by k. The
+// quotient is range checked to nbBits bits and the remainder has to be checked
+// by the caller. If x is constant, then the results are constant.
+func div(api frontend.API, x frontend.Variable, k int, nbBits int) (q, r frontend.Variable) {
+	if xv, ok := api.Compiler().ConstantValue(x); ok {
+		qv, rv := new(big.Int).DivMod(xv, big.NewInt(int64(k)), new(big.Int))
+		return qv, rv
+	}
+	res, err := api.Compiler().NewHint(divHint, 1, x, k)
+	if err != nil {
+		panic(fmt.Sprintf("division hint: %v", err))
+	}
+	q = res[0]
+	rangecheck.New(api).Check(q, nbBits)
+	return q, api.Sub(x, api.Mul(q, k))
+}
+
+// valuesHint returns the values of the characters given in the inputs. The
+// values of the characters not in the alphabet are zero. It accepts the
+// characters of both the standard and URL-safe alphabets, the lookup checks
+// that the character is in the correct alphabet.
+func valuesHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != len(outputs) {
+		return fmt.Errorf("expected %d inputs", len(outputs))
+	}
+	for i := range outputs {
+		c := inputs[i].Uint64()
+		var v uint64
+		switch {
+		case c >= 'A' && c <= 'Z':
+			v = c - 'A'
+		case c >= 'a' && c <= 'z':
+			v = c - 'a' + 26
+		case c >= '0' && c <= '9':
+			v = c - '0' + 52
+		case c == '+' || c == '-':
+			v = 62
+		case c == '/' || c == '_':
+			v = 63
+		}
+		outputs[i].SetUint64(v)
+	}
+	return nil
+}
+
+// paddingHint returns the number of padding characters at the end of the data
+// with the length given as the first input.
+func paddingHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) < 1 || len(outputs) != 1 {
+		return fmt.Errorf("expected at least 1 input and 1 output")
+	}
+	length := int(inputs[0].Int64())
+	if length > len(inputs)-1 {
+		return fmt.Errorf("length exceeds capacity")
+	}
+	var n uint64
+	for i := length; i > 0 && inputs[i].Uint64() == padChar; i-- {
+		n++
+	}
+	outputs[0].SetUint64(n)
+	return nil
+}
+
+// divHint returns the quotient of the inputs.
+func divHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != 2 || len(outputs) != 1 {
+		return fmt.Errorf("expected 2 inputs and 1 output")
+	}
+	outputs[0].Div(inputs[0], inputs[1])
+	return nil
+}
@@ -0,0 +1,126 @@
+package base64
+
+import (
+	stdbase64 "encoding/base64"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/test"
+)
+
+type EncodeCircuit struct {
+	In       bytes.Array
+	Expected bytes.Array
+	enc      *Encoding
+}
+
+func (c *EncodeCircuit) Define(api frontend.API) error {
+	got := c.enc.EncodeArray(api, c.In)
+	api.AssertIsEqual(got.Length, c.Expected.Length)
+	for i := range got.Data {
+		api.AssertIsEqual(got.Data[i].Val, c.Expected.Data[i].Val)
+	}
+	return nil
+}
+
+type DecodeCircuit struct {
+	In       bytes.Array
+	Expected bytes.Array
+	enc      *Encoding
+}
+
+func (c *DecodeCircuit) Define(api frontend.API) error {
+	got := c.enc.DecodeArray(api, c.In)
+	api.AssertIsEqual(got.Length, c.Expected.Length)
+	for i := range got.Data {
+		api.AssertIsEqual(got.Data[i].Val, c.Expected.Data[i].Val)
+	}
+	return nil
+}
+
+var encodings = []struct {
+	name string
+	enc  *Encoding
+	std  *stdbase64.Encoding
+}{
+	{"std", StdEncoding, stdbase64.StdEncoding},
+	{"url", URLEncoding, stdbase64.URLEncoding},
+	{"rawstd", RawStdEncoding, stdbase64.RawStdEncoding},
+	{"rawurl", RawURLEncoding, stdbase64.RawURLEncoding},
+}
+
+func TestEncode(t *testing.T) {
+	assert := test.NewAssert(t)
+	const capacity = 10
+	data := []byte{0xfb, 0xff, 0x3e, 'g', 'n', 'a', 'r', 'k', 0x00, 0xbf}
+	for _, e := range encodings {
+		for n := 0; n <= capacity; n++ {
+			encoded := []byte(e.std.EncodeToString(data[:n]))
+			ecap := e.enc.EncodedLen(capacity)
+			circuit := EncodeCircuit{In: bytes.Placeholder(capacity), Expected: bytes.Placeholder(ecap), enc: e.enc}
+			witness := EncodeCircuit{In: bytes.NewArray(data[:n], capacity), Expected: bytes.NewArray(encoded, ecap)}
+			// the bytes after the length must be ignored.
+			for i := n; i < capacity; i++ {
+				witness.In.Data[i].Val = 0xaa
+			}
+			err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+			assert.NoError(err, e.name, n)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	assert := test.NewAssert(t)
+	const capacity = 16
+	data := []byte("gnark?>\xfb\xff\x00zk")
+	for _, e := range encodings {
+		for n := 0; n <= len(data); n++ {
+			encoded := []byte(e.std.EncodeToString(data[:n]))
+			dcap := e.enc.DecodedLen(capacity)
+			circuit := DecodeCircuit{In: bytes.Placeholder(capacity), Expected: bytes.Placeholder(dcap), enc: e.enc}
+			witness := DecodeCircuit{In: bytes.NewArray(encoded, capacity), Expected: bytes.NewArray(data[:n], dcap)}
+			for i := len(encoded); i < capacity; i++ {
+				witness.In.Data[i].Val = '='
+			}
+			err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+			assert.NoError(err, e.name, n)
+		}
+	}
+
+	// invalid characters, wrong alphabet, wrong padding and invalid lengths
+	// must fail.
+	for _, tc := range []struct {
+		enc     *Encoding
+		in, out string
+	}{
+		{StdEncoding, "Z24*", "gn"},
+		{URLEncoding, "+/8=", "\xfb\xff"},
+		{RawURLEncoding, "-_8=", "\xfb\xff"},
+		{StdEncoding, "Z2=4", "g"},
+		{StdEncoding, "Z===", ""},
+		{StdEncoding, "Zw==", "h"},
+		{StdEncoding, "Z24", "gn"},
+		{RawStdEncoding, "Z24hc", "gna"},
+		{RawStdEncoding, "Z24", "gm"},
+	} {
+		dcap := tc.enc.DecodedLen(capacity)
+		circuit := DecodeCircuit{In: bytes.Placeholder(capacity), Expected: bytes.Placeholder(dcap), enc: tc.enc}
+		witness := DecodeCircuit{In: bytes.NewArray([]byte(tc.in), capacity), Expected: bytes.NewArray([]byte(tc.out), dcap)}
+		err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.Error(err, tc.in)
+	}
+}
+
+func TestDecodeProver(t *testing.T) {
+	assert := test.NewAssert(t)
+	data := []byte(`{"alg":"RS256","typ":"JWT"}`)
+	encoded := []byte(stdbase64.RawURLEncoding.EncodeToString(data))
+	capacity := 48
+	dcap := RawURLEncoding.DecodedLen(capacity)
+	circuit := DecodeCircuit{In: bytes.Placeholder(capacity), Expected: bytes.Placeholder(dcap), enc: RawURLEncoding}
+	assert.ProverSucceeded(&circuit, &DecodeCircuit{In: bytes.NewArray(encoded, capacity), Expected: bytes.NewArray(data, dcap)},
+		test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK), test.NoFuzzing())
+}
@@ -0,0 +1,190 @@
+// Package hex implements hexadecimal encoding and decoding in circuit.
+//
+// The encoding uses lowercase characters and the decoding accepts both
+// lowercase and uppercase characters. Every byte is encoded as two characters,
+// the high nibble first.
+//
+// The nibbles of the encoded bytes are obtained from a hint and the characters
+// are looked up from a [logderivlookup.Table], which also checks that the
+// nibbles are less than 16. When decoding, the hint returns the position of
+// every character in the table of valid (character, value) pairs and the lookup
+// checks that the character is valid.
+//
+// For data with runtime length, use [EncodeArray] and [DecodeArray] on
+// [bytes.Array].
+package hex
+
+import (
+	"fmt"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hints used in this package
+func GetHints() []solver.Hint {
+	return []solver.Hint{nibblesHint, positionsHint, halfHint}
+}
+
+const (
+	lower = "0123456789abcdef"
+	upper = "ABCDEF"
+)
+
+// Encode returns the lowercase hexadecimal encoding of src.
+func Encode(api frontend.API, src []uints.U8) []uints.U8 {
+	return EncodeArray(api, bytes.Array{Data: src, Length: len(src)}).Data
+}
+
+// Decode returns the decoding of the hexadecimal characters in src. It asserts
+// that the length of src is even and that all the characters are valid.
+func Decode(api frontend.API, src []uints.U8) []uints.U8 {
+	return DecodeArray(api, bytes.Array{Data: src, Length: len(src)}).Data
+}
+
+// EncodeArray returns the lowercase hexadecimal encoding of src with runtime
+// length. The capacity of the result is twice the capacity of src.
+func EncodeArray(api frontend.API, src bytes.Array) bytes.Array {
+	in := bytes.Mask(api, src).Data
+	if len(in) == 0 {
+		return bytes.Array{Length: 0}
+	}
+	vals := make([]frontend.Variable, len(in))
+	for i := range in {
+		vals[i] = in[i].Val
+	}
+	nibbles, err := api.Compiler().NewHint(nibblesHint, 2*len(in), vals...)
+	if err != nil {
+		panic(fmt.Sprintf("nibbles hint: %v", err))
+	}
+	for i := range in {
+		api.AssertIsEqual(api.Add(api.Mul(nibbles[2*i], 16), nibbles[2*i+1]), in[i].Val)
+	}
+	t := logderivlookup.New(api)
+	for i := range lower {
+		t.Insert(lower[i])
+	}
+	// the lookup ensures that the nibbles are less than 16.
+	chars := t.Lookup(nibbles...)
+	mask := bytes.Indicators(api, len(chars), api.Mul(src.Length, 2))
+	res := make([]uints.U8, len(chars))
+	for i := range res {
+		res[i] = uints.U8{Val: api.Mul(mask[i], chars[i])}
+	}
+	return bytes.Array{Data: res, Length: api.Mul(src.Length, 2)}
+}
+
+// DecodeArray returns the decoding of the hexadecimal characters in src with
+// runtime length. The capacity of the result is half of the capacity of src
+// rounded up. It asserts that the length of src is even and that all the
+// characters up to the length are valid.
+func DecodeArray(api frontend.API, src bytes.Array) bytes.Array {
+	capacity := len(src.Data)
+	length := half(api, src.Length, bits.Len(uint(capacity)))
+	mask := bytes.Indicators(api, capacity, src.Length)
+	in := make([]frontend.Variable, capacity)
+	for i := range in {
+		in[i] = api.Mul(mask[i], src.Data[i].Val)
+	}
+	positions, err := api.Compiler().NewHint(positionsHint, capacity, in...)
+	if err != nil {
+		panic(fmt.Sprintf("positions hint: %v", err))
+	}
+	// the table has the rows (character, value) for the valid characters. The
+	// positions of the characters after the length are zero, which gives the
+	// row ('0', 0).
+	t := logderivlookup.NewMulti(api, 2)
+	for i := range lower {
+		t.Insert([]frontend.Variable{lower[i], i})
+	}
+	for i := range upper {
+		t.Insert([]frontend.Variable{upper[i], 10 + i})
+	}
+	rows := t.Lookup(positions...)
+	values := make([]frontend.Variable, capacity)
+	for i := range rows {
+		api.AssertIsEqual(api.Mul(mask[i], api.Sub(rows[i][0], src.Data[i].Val)), 0)
+		values[i] = api.Mul(mask[i], rows[i][1])
+	}
+	res := make([]uints.U8, (capacity+1)/2)
+	for i := range res {
+		v := api.Mul(values[2*i], 16)
+		if 2*i+1 < capacity {
+			v = api.Add(v, values[2*i+1])
+		}
+		res[i] = uints.U8{Val: v}
+	}
+	return bytes.Array{Data: res, Length: length}
+}
+
+// half returns the half of the even value x and asserts that x is even. The
+// result is range checked to nbBits bits.
+func half(api frontend.API, x frontend.Variable, nbBits int) frontend.Variable {
+	if xv, ok := api.Compiler().ConstantValue(x); ok {
+		if xv.Bit(0) != 0 {
+			panic("odd length hex input")
+		}
+		return new(big.Int).Rsh(xv, 1)
+	}
+	res, err := api.Compiler().NewHint(halfHint, 1, x)
+	if err != nil {
+		panic(fmt.Sprintf("half hint: %v", err))
+	}
+	rangecheck.New(api).Check(res[0], nbBits)
+	api.AssertIsEqual(api.Mul(res[0], 2), x)
+	return res[0]
+}
+
+// nibblesHint returns the high and low nibbles of every input byte.
+func nibblesHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(outputs) != 2*len(inputs) {
+		return fmt.Errorf("expected %d outputs", 2*len(inputs))
+	}
+	for i := range inputs {
+		b := inputs[i].Uint64()
+		outputs[2*i].SetUint64(b >> 4)
+		outputs[2*i+1].SetUint64(b & 0xf)
+	}
+	return nil
+}
+
+// positionsHint returns the positions of the input characters in the table of
+// valid characters. The positions of the invalid characters are zero.
+func positionsHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != len(outputs) {
+		return fmt.Errorf("expected %d inputs", len(outputs))
+	}
+	for i := range inputs {
+		c := inputs[i].Uint64()
+		var p uint64
+		switch {
+		case c >= '0' && c <= '9':
+			p = c - '0'
+		case c >= 'a' && c <= 'f':
+			p = c - 'a' + 10
+		case c >= 'A' && c <= 'F':
+			p = c - 'A' + 16
+		}
+		outputs[i].SetUint64(p)
+	}
+	return nil
+}
+
+// halfHint returns the half of the input.
+func halfHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != 1 || len(outputs) != 1 {
+		return fmt.Errorf("expected 1 input and 1 output")
+	}
+	outputs[0].Rsh(inputs[0], 1)
+	return nil
+}
@@ -0,0 +1,81 @@
+package hex
+
+import (
+	stdhex "encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type EncodeCircuit struct {
+	In       []uints.U8
+	Expected []uints.U8
+}
+
+func (c *EncodeCircuit) Define(api frontend.API) error {
+	got := Encode(api, c.In)
+	for i := range got {
+		api.AssertIsEqual(got[i].Val, c.Expected[i].Val)
+	}
+	return nil
+}
+
+func TestEncode(t *testing.T) {
+	assert := test.NewAssert(t)
+	data := []byte{0x00, 0x0f, 0xf0, 0xab, 0x9c, 0xff}
+	circuit := EncodeCircuit{In: make([]uints.U8, len(data)), Expected: make([]uints.U8, 2*len(data))}
+	witness := EncodeCircuit{In: uints.NewU8Array(data), Expected: uints.NewU8Array([]byte(stdhex.EncodeToString(data)))}
+	assert.ProverSucceeded(&circuit, &witness, test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK), test.NoFuzzing())
+}
+
+type DecodeArrayCircuit struct {
+	In       bytes.Array
+	Expected bytes.Array
+}
+
+func (c *DecodeArrayCircuit) Define(api frontend.API) error {
+	got := DecodeArray(api, c.In)
+	api.AssertIsEqual(got.Length, c.Expected.Length)
+	bytes.AssertIsEqualPrefix(api, got.Data, c.Expected.Data, got.Length)
+	// the encoding of the decoded array gives back the lowercase input.
+	enc := EncodeArray(api, got)
+	api.AssertIsEqual(enc.Length, c.In.Length)
+	return nil
+}
+
+func TestDecodeArray(t *testing.T) {
+	assert := test.NewAssert(t)
+	const capacity = 12
+	for _, tc := range []struct {
+		in    string
+		valid bool
+	}{
+		{"", true},
+		{"00", true},
+		{"deadBEEF", true},
+		{"0123456789aF", true},
+		{"abc", false},
+		{"0g", false},
+		{"0G", false},
+		{"a:", false},
+		{"@1", false},
+	} {
+		circuit := DecodeArrayCircuit{In: bytes.Placeholder(capacity), Expected: bytes.Placeholder(capacity / 2)}
+		expected, _ := stdhex.DecodeString(tc.in)
+		witness := DecodeArrayCircuit{In: bytes.NewArray([]byte(tc.in), capacity), Expected: bytes.NewArray(expected, capacity/2)}
+		for i := len(tc.in); i < capacity; i++ {
+			witness.In.Data[i].Val = 'z'
+		}
+		err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		if tc.valid {
+			assert.NoError(err, tc.in)
+		} else {
+			assert.Error(err, tc.in)
+		}
+	}
+}
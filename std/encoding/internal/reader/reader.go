@@ -0,0 +1,87 @@
+// Package reader implements random access reading of a byte array for the
+// parsers in the encoding packages.
+package reader
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/rangecheck"
+	"github.com/consensys/gnark/std/selector"
+)
+
+// Reader reads bytes at variable positions of an array using a lookup table.
+type Reader struct {
+	api  frontend.API
+	data bytes.Array
+	tbl  *logderivlookup.Table
+
+	// maxLenBytes is the maximal number of bytes of a big-endian encoded
+	// length.
+	maxLenBytes int
+	// nbBits is the number of bits for range checking positions.
+	nbBits int
+}
+
+// New returns a new [*Reader] for data. The length fields read with
+// [Reader.BigEndian] can be at most maxLenBytes long.
+func New(api frontend.API, data bytes.Array, maxLenBytes int) *Reader {
+	r := &Reader{
+		api:         api,
+		data:        data,
+		tbl:         logderivlookup.New(api),
+		maxLenBytes: maxLenBytes,
+		nbBits:      bits.Len(uint(len(data.Data) + 1)),
+	}
+	for i := range data.Data {
+		r.tbl.Insert(data.Data[i].Val)
+	}
+	// padding for reading the header of an item at the end of the array. The
+	// reads after the length of the array are rejected by [Reader.AssertInBounds].
+	for i := 0; i < maxLenBytes+1; i++ {
+		r.tbl.Insert(0)
+	}
+	return r
+}
+
+// MaxLenBytes returns the number of bytes needed for encoding any length up to
+// capacity.
+func MaxLenBytes(capacity int) int {
+	return (bits.Len(uint(capacity)) + 7) / 8
+}
+
+// Data returns the array being read.
+func (r *Reader) Data() bytes.Array {
+	return r.data
+}
+
+// Byte returns the byte at position pos.
+func (r *Reader) Byte(pos frontend.Variable) frontend.Variable {
+	return r.tbl.Lookup(pos)[0]
+}
+
+// BigEndian returns the integer encoded in big-endian as n bytes starting at
+// position pos. The length n must be at most maxLenBytes, otherwise a proof
+// cannot be generated.
+func (r *Reader) BigEndian(pos, n frontend.Variable) frontend.Variable {
+	// sel[k] = 1 iff n = k, so the byte j is included if n > j.
+	sel := selector.Decoder(r.api, r.maxLenBytes+1, n)
+	inds := make([]frontend.Variable, r.maxLenBytes)
+	for j := range inds {
+		inds[j] = r.api.Add(pos, j)
+	}
+	bs := r.tbl.Lookup(inds...)
+	var res, included frontend.Variable = 0, 1
+	for j := range bs {
+		included = r.api.Sub(included, sel[j])
+		res = r.api.Add(res, r.api.Mul(included, r.api.Add(r.api.Mul(res, 255), bs[j])))
+	}
+	return res
+}
+
+// AssertInBounds asserts that end is at most the length of the array.
+func (r *Reader) AssertInBounds(end frontend.Variable) {
+	rangecheck.CheckVariableBound(r.api, end, r.api.Add(r.data.Length, 1), r.nbBits)
+}
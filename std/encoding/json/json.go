@@ -0,0 +1,84 @@
+// Package json implements extracting values from JSON objects in circuit.
+//
+// The main use case is proving statements about the claims of signed JSON
+// documents, such as the payload of a JWT. The signature is verified over the
+// encoded document, which is then decoded (see the base64 decoder) and the
+// claims extracted with [Extract].
+//
+// The extractor is bounded: the document is a [bytes.Array] with a fixed
+// capacity and the extracted value is returned as an array with a given
+// capacity. The cost is linear in the capacity of the document.
+//
+// The extractor supports only compact JSON without whitespace between the key,
+// the colon and the value. String values are not unescaped and must not
+// contain escaped quotes. The key is matched at its first occurrence in the
+// document, so the keys of nested objects are not distinguished from the keys
+// of the top-level object.
+package json
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// Extract returns the value of key in the JSON document data as an array of
+// the given capacity. For string values, the returned value doesn't include
+// the quotes. For other values (numbers, booleans, null), the value ends
+// before the following comma, closing brace or closing bracket. It asserts
+// that the document contains the key and that the length of the value is at
+// most the capacity. It panics if the key contains a quote.
+func Extract(api frontend.API, data bytes.Array, key string, capacity int) bytes.Array {
+	for i := range key {
+		if key[i] == '"' {
+			panic(fmt.Sprintf("key %q contains a quote", key))
+		}
+	}
+	pattern := []byte(`"` + key + `":`)
+	needle := bytes.Array{Data: uints.NewU8Array(pattern), Length: len(pattern)}
+	idx := bytes.IndexOf(api, data, needle)
+	// the index is -1 if the key is not found.
+	api.AssertIsDifferent(idx, -1)
+	rest := bytes.ShiftLeft(api, data, api.Add(idx, len(pattern)))
+
+	classes := logderivlookup.NewMulti(api, 2)
+	for b := 0; b < 256; b++ {
+		classes.Insert(classRow(b))
+	}
+	inds := make([]frontend.Variable, len(rest.Data))
+	for i := range inds {
+		inds[i] = rest.Data[i].Val
+	}
+	rows := classes.Lookup(inds...)
+	isString := rows[0][0]
+
+	// the value ends at the first terminator after the first character. For
+	// strings it is the closing quote and for other values it is a
+	// delimiter.
+	var seen, end frontend.Variable = 0, 0
+	for i := 1; i < len(rows); i++ {
+		isEnd := api.Select(isString, rows[i][0], rows[i][1])
+		first := api.Mul(isEnd, api.Sub(1, seen))
+		end = api.MulAcc(end, first, i)
+		seen = api.Add(seen, first)
+	}
+	// the bytes after the length of rest are zero, so the terminator is
+	// within the document.
+	api.AssertIsEqual(seen, 1)
+	return bytes.Slice(api, rest, isString, api.Sub(end, isString), capacity)
+}
+
+// classRow returns (isQuote, isDelimiter) for the byte b.
+func classRow(b int) []frontend.Variable {
+	switch b {
+	case '"':
+		return []frontend.Variable{1, 0}
+	case ',', '}', ']':
+		return []frontend.Variable{0, 1}
+	default:
+		return []frontend.Variable{0, 0}
+	}
+}
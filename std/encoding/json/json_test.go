@@ -0,0 +1,65 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/test"
+)
+
+type ExtractCircuit struct {
+	Data     bytes.Array
+	Expected bytes.Array
+	key      string
+}
+
+func (c *ExtractCircuit) Define(api frontend.API) error {
+	got := Extract(api, c.Data, c.key, len(c.Expected.Data))
+	api.AssertIsEqual(got.Length, c.Expected.Length)
+	for i := range got.Data {
+		api.AssertIsEqual(got.Data[i].Val, c.Expected.Data[i].Val)
+	}
+	return nil
+}
+
+func TestExtract(t *testing.T) {
+	assert := test.NewAssert(t)
+	payload := []byte(`{"iss":"https://example.com","sub":"1234","email_verified":true,"exp":1700000000,"name":"","obj":{"a":1},"arr":[1,"x"]}`)
+	for _, tc := range []struct {
+		key, value string
+	}{
+		{"iss", "https://example.com"},
+		{"sub", "1234"},
+		{"email_verified", "true"},
+		{"exp", "1700000000"},
+		{"name", ""},
+		{"a", "1"},
+	} {
+		circuit := ExtractCircuit{Data: bytes.Placeholder(128), Expected: bytes.Placeholder(20), key: tc.key}
+		witness := ExtractCircuit{Data: bytes.NewArray(payload, 128), Expected: bytes.NewArray([]byte(tc.value), 20), key: tc.key}
+		err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.key)
+	}
+
+	// wrong value, missing key and too long value must fail.
+	for _, tc := range []struct {
+		key, value string
+		capacity   int
+	}{
+		{"sub", "1235", 20},
+		{"aud", "", 20},
+		{"iss", "https://ex", 10},
+	} {
+		circuit := ExtractCircuit{Data: bytes.Placeholder(128), Expected: bytes.Placeholder(tc.capacity), key: tc.key}
+		witness := ExtractCircuit{Data: bytes.NewArray(payload, 128), Expected: bytes.NewArray([]byte(tc.value), tc.capacity), key: tc.key}
+		err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.Error(err, tc.key)
+	}
+
+	circuit := ExtractCircuit{Data: bytes.Placeholder(128), Expected: bytes.Placeholder(20), key: "sub"}
+	assert.ProverSucceeded(&circuit, &ExtractCircuit{Data: bytes.NewArray(payload, 128), Expected: bytes.NewArray([]byte("1234"), 20)},
+		test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK), test.NoFuzzing())
+}
@@ -0,0 +1,110 @@
+// Package rlp implements decoding of Recursive Length Prefix (RLP) encoded
+// data in circuit.
+//
+// RLP is the serialization format used in Ethereum, for example for block
+// headers, transactions and receipts. The encoding of an item is a header
+// followed by the payload. The header consists of a prefix byte and
+// optionally of the big-endian encoded length of the payload. The payload of
+// a list is the concatenation of the encodings of its items.
+//
+// The decoder operates on [bytes.Array] with runtime length and returns the
+// position and length of the payload of an item at a variable offset. The
+// payload can then be extracted with [Decoder.Payload], compared with
+// [bytes.IsEqualPrefix] or hashed. For example, the hash of a block header can
+// be computed using a keccak hasher on the whole array and the fields of the
+// header obtained by iterating over the items of the list.
+//
+// The decoder doesn't check that the encoding is canonical, i.e. it also
+// accepts single bytes encoded as strings and lengths with leading zeros.
+//
+// See the [specification] for details.
+//
+// [specification]: https://ethereum.org/en/developers/docs/data-structures-and-encoding/rlp/
+package rlp
+
+import (
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/encoding/internal/reader"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+)
+
+// Item is a decoded RLP item.
+type Item struct {
+	// IsList is 1 if the item is a list and 0 if the item is a string.
+	IsList frontend.Variable
+	// Offset is the position of the payload in the data.
+	Offset frontend.Variable
+	// Length is the length of the payload.
+	Length frontend.Variable
+}
+
+// Decoder decodes the items of RLP encoded data.
+type Decoder struct {
+	api    frontend.API
+	r      *reader.Reader
+	prefix *logderivlookup.MultiTable
+}
+
+// New returns a new [*Decoder] for the RLP encoded data.
+func New(api frontend.API, data bytes.Array) *Decoder {
+	d := &Decoder{
+		api:    api,
+		r:      reader.New(api, data, reader.MaxLenBytes(len(data.Data))),
+		prefix: logderivlookup.NewMulti(api, 4),
+	}
+	for b := 0; b < 256; b++ {
+		d.prefix.Insert(prefixRow(b))
+	}
+	return d
+}
+
+// prefixRow returns the decoding of the prefix byte b as (isList, headerLen,
+// lenLen, shortLen), where headerLen is the length of the header excluding the
+// length field, lenLen is the length of the length field and shortLen is the
+// length of the payload if it is given by the prefix.
+func prefixRow(b int) []frontend.Variable {
+	switch {
+	case b < 0x80:
+		// single byte, the prefix is the payload.
+		return []frontend.Variable{0, 0, 0, 1}
+	case b < 0xb8:
+		return []frontend.Variable{0, 1, 0, b - 0x80}
+	case b < 0xc0:
+		return []frontend.Variable{0, 1, b - 0xb7, 0}
+	case b < 0xf8:
+		return []frontend.Variable{1, 1, 0, b - 0xc0}
+	default:
+		return []frontend.Variable{1, 1, b - 0xf7, 0}
+	}
+}
+
+// Decode decodes the header of the item at position offset. It asserts that
+// the item is within the length of the data.
+func (d *Decoder) Decode(offset frontend.Variable) Item {
+	row := d.prefix.Lookup(d.r.Byte(offset))[0]
+	isList, headerLen, lenLen, shortLen := row[0], row[1], row[2], row[3]
+	// the length field follows the prefix byte. If there is no length field,
+	// then the decoded length is zero.
+	long := d.r.BigEndian(d.api.Add(offset, 1), lenLen)
+	it := Item{
+		IsList: isList,
+		Offset: d.api.Add(offset, headerLen, lenLen),
+		Length: d.api.Add(shortLen, long),
+	}
+	d.r.AssertInBounds(d.Next(it))
+	return it
+}
+
+// Next returns the position following the item, i.e. the position of the next
+// item in the same list.
+func (d *Decoder) Next(it Item) frontend.Variable {
+	return d.api.Add(it.Offset, it.Length)
+}
+
+// Payload returns the payload of the item as an array of the given capacity.
+// The length of the payload must be at most the capacity, otherwise a proof
+// cannot be generated.
+func (d *Decoder) Payload(it Item, capacity int) bytes.Array {
+	return bytes.Slice(d.api, d.r.Data(), it.Offset, it.Length, capacity)
+}
@@ -0,0 +1,99 @@
+package rlp
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/test"
+)
+
+// encodeString returns the RLP encoding of the byte string s.
+func encodeString(s []byte) []byte {
+	if len(s) == 1 && s[0] < 0x80 {
+		return s
+	}
+	return append(header(0x80, len(s)), s...)
+}
+
+// encodeList returns the RLP encoding of the list of encoded items.
+func encodeList(items ...[]byte) []byte {
+	var payload []byte
+	for i := range items {
+		payload = append(payload, items[i]...)
+	}
+	return append(header(0xc0, len(payload)), payload...)
+}
+
+func header(offset byte, length int) []byte {
+	if length < 56 {
+		return []byte{offset + byte(length)}
+	}
+	var lenBytes []byte
+	for l := length; l > 0; l >>= 8 {
+		lenBytes = append([]byte{byte(l)}, lenBytes...)
+	}
+	return append([]byte{offset + 55 + byte(len(lenBytes))}, lenBytes...)
+}
+
+type DecodeCircuit struct {
+	Data  bytes.Array
+	Items [4]bytes.Array
+	Inner bytes.Array
+}
+
+func (c *DecodeCircuit) Define(api frontend.API) error {
+	d := New(api, c.Data)
+	list := d.Decode(0)
+	api.AssertIsEqual(list.IsList, 1)
+	pos := list.Offset
+	for i := range c.Items {
+		it := d.Decode(pos)
+		pos = d.Next(it)
+		api.AssertIsEqual(it.IsList, 0)
+		got := d.Payload(it, len(c.Items[i].Data))
+		api.AssertIsEqual(got.Length, c.Items[i].Length)
+		bytes.AssertIsEqualPrefix(api, got.Data, c.Items[i].Data, got.Length)
+	}
+	// the last item is a list with a single string.
+	last := d.Decode(pos)
+	api.AssertIsEqual(d.Next(last), d.Next(list))
+	api.AssertIsEqual(last.IsList, 1)
+	inner := d.Decode(last.Offset)
+	api.AssertIsEqual(d.Next(inner), d.Next(last))
+	got := d.Payload(inner, len(c.Inner.Data))
+	api.AssertIsEqual(got.Length, c.Inner.Length)
+	bytes.AssertIsEqualPrefix(api, got.Data, c.Inner.Data, got.Length)
+	return nil
+}
+
+func TestDecode(t *testing.T) {
+	assert := test.NewAssert(t)
+	long := make([]byte, 300)
+	for i := range long {
+		long[i] = byte(i)
+	}
+	items := [][]byte{[]byte("dog"), {}, {0x05}, long}
+	var encoded [][]byte
+	for i := range items {
+		encoded = append(encoded, encodeString(items[i]))
+	}
+	encoded = append(encoded, encodeList(encodeString([]byte("cat"))))
+	data := encodeList(encoded...)
+
+	capacity := len(data) + 10
+	circuit := DecodeCircuit{Data: bytes.Placeholder(capacity), Inner: bytes.Placeholder(4)}
+	witness := DecodeCircuit{Data: bytes.NewArray(data, capacity), Inner: bytes.NewArray([]byte("cat"), 4)}
+	for i := range items {
+		circuit.Items[i] = bytes.Placeholder(len(items[i]) + 1)
+		witness.Items[i] = bytes.NewArray(items[i], len(items[i])+1)
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// truncated data must fail.
+	witness.Data.Length = len(data) - 1
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
@@ -1,3 +1,23 @@
 package evmprecompiles
 
-// will implement later
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// SHA256 implements [SHA256] precompile contract at address 0x02.
+//
+// [SHA256]: https://ethereum.github.io/execution-specs/autoapi/ethereum/cancun/vm/precompiled_contracts/sha256/index.html
+func SHA256(api frontend.API, data []uints.U8) [32]uints.U8 {
+	h, err := sha2.New(api)
+	if err != nil {
+		panic(fmt.Sprintf("new sha2: %v", err))
+	}
+	h.Write(data)
+	var res [32]uints.U8
+	copy(res[:], h.Sum())
+	return res
+}
@@ -0,0 +1,48 @@
+package evmprecompiles
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type sha256Circuit struct {
+	In       []uints.U8
+	Expected [32]uints.U8
+}
+
+func (c *sha256Circuit) Define(api frontend.API) error {
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return err
+	}
+	res := SHA256(api, c.In)
+	for i := range c.Expected {
+		uapi.ByteAssertEq(c.Expected[i], res[i])
+	}
+	return nil
+}
+
+func TestSHA256Vectors(t *testing.T) {
+	assert := test.NewAssert(t)
+	// go-ethereum does not have SHA256 test vectors in testdata, the vector is
+	// from the precompile benchmark.
+	tests, err := loadTestVectors("sha256")
+	assert.NoError(err)
+	for _, tc := range tests {
+		assert.Run(func(assert *test.Assert) {
+			in, err := hex.DecodeString(tc.Input)
+			assert.NoError(err)
+			expected, err := hex.DecodeString(tc.Expected)
+			assert.NoError(err)
+			witness := sha256Circuit{In: uints.NewU8Array(in)}
+			copy(witness.Expected[:], uints.NewU8Array(expected))
+			err = test.IsSolved(&sha256Circuit{In: make([]uints.U8, len(in))}, &witness, ecc.BN254.ScalarField())
+			assert.NoError(err)
+		}, tc.Name)
+	}
+}
@@ -0,0 +1,26 @@
+package evmprecompiles
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash/ripemd160"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// RIPEMD160 implements [RIPEMD160] precompile contract at address 0x03.
+//
+// The function returns the 20-byte digest. The precompile returns the digest
+// left-padded with zeros to 32 bytes.
+//
+// [RIPEMD160]: https://ethereum.github.io/execution-specs/autoapi/ethereum/cancun/vm/precompiled_contracts/ripemd160/index.html
+func RIPEMD160(api frontend.API, data []uints.U8) [20]uints.U8 {
+	h, err := ripemd160.New(api)
+	if err != nil {
+		panic(fmt.Sprintf("new ripemd160: %v", err))
+	}
+	h.Write(data)
+	var res [20]uints.U8
+	copy(res[:], h.Sum())
+	return res
+}
@@ -0,0 +1,49 @@
+package evmprecompiles
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type ripemd160Circuit struct {
+	In       []uints.U8
+	Expected [20]uints.U8
+}
+
+func (c *ripemd160Circuit) Define(api frontend.API) error {
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return err
+	}
+	res := RIPEMD160(api, c.In)
+	for i := range c.Expected {
+		uapi.ByteAssertEq(c.Expected[i], res[i])
+	}
+	return nil
+}
+
+func TestRIPEMD160Vectors(t *testing.T) {
+	assert := test.NewAssert(t)
+	// go-ethereum does not have RIPEMD160 test vectors in testdata, the vector
+	// is from the precompile benchmark.
+	tests, err := loadTestVectors("ripemd160")
+	assert.NoError(err)
+	for _, tc := range tests {
+		assert.Run(func(assert *test.Assert) {
+			in, err := hex.DecodeString(tc.Input)
+			assert.NoError(err)
+			expected, err := hex.DecodeString(tc.Expected)
+			assert.NoError(err)
+			witness := ripemd160Circuit{In: uints.NewU8Array(in)}
+			// the digest is left-padded to 32 bytes
+			copy(witness.Expected[:], uints.NewU8Array(expected[12:]))
+			err = test.IsSolved(&ripemd160Circuit{In: make([]uints.U8, len(in))}, &witness, ecc.BN254.ScalarField())
+			assert.NoError(err)
+		}, tc.Name)
+	}
+}
@@ -1 +1,47 @@
 package evmprecompiles
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// Expmod implements [MODEXP] precompile contract at address 0x05.
+//
+// The type parameter P only defines the limb decomposition of the arguments
+// and bounds their size. For example, [emulated.Mod1e512] allows 512-bit
+// inputs and [emulated.Mod1e4096] allows 4096-bit inputs. The caller has to
+// choose the bound from the input lengths of the precompile call. The
+// exponent is taken as an integer and not reduced modulo the modulus.
+//
+// As in the precompile, if the modulus is 0 or 1, then the result is 0.
+// Otherwise the result is base^exp reduced modulo the modulus.
+//
+// [MODEXP]: https://ethereum.github.io/execution-specs/autoapi/ethereum/cancun/vm/precompiled_contracts/expmod/index.html
+func Expmod[P emulated.FieldParams](api frontend.API, base, exp, modulus *emulated.Element[P]) *emulated.Element[P] {
+	// x^y mod 0 and x^y mod 1 are both defined to be 0, but we cannot perform
+	// arithmetic with such moduli. We replace the modulus with 2 and select
+	// the result to be 0 at the end.
+	var hiZero frontend.Variable = 1
+	for i := 1; i < len(modulus.Limbs); i++ {
+		hiZero = api.Mul(hiZero, api.IsZero(modulus.Limbs[i]))
+	}
+	loSmall := api.IsZero(api.Mul(modulus.Limbs[0], api.Sub(modulus.Limbs[0], 1)))
+	isSmall := api.Mul(hiZero, loSmall)
+	limbs := make([]frontend.Variable, len(modulus.Limbs))
+	copy(limbs, modulus.Limbs)
+	limbs[0] = api.Select(isSmall, 2, limbs[0])
+
+	f, err := emulated.NewVariableModulusField[P](api, &emulated.Element[P]{Limbs: limbs})
+	if err != nil {
+		panic(fmt.Sprintf("new variable modulus field: %v", err))
+	}
+	res := f.Exp(base, exp)
+	// the result of the exponentiation may not be reduced (for example when
+	// exp is 0 or 1), we multiply by one to reduce and then assert it is
+	// canonical.
+	res = f.Mul(res, f.One())
+	f.AssertIsInRange(res)
+	return f.Select(isSmall, f.Zero(), res)
+}
@@ -0,0 +1,143 @@
+package evmprecompiles
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/frontend/cs/scs"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type expmodCircuit[P emulated.FieldParams] struct {
+	Base, Exp, Modulus emulated.Element[P]
+	Expected           emulated.Element[P]
+}
+
+func (c *expmodCircuit[P]) Define(api frontend.API) error {
+	f, err := emulated.NewField[P](api)
+	if err != nil {
+		return err
+	}
+	res := Expmod(api, &c.Base, &c.Exp, &c.Modulus)
+	f.AssertLimbsEquality(res, &c.Expected)
+	return nil
+}
+
+// expmodConstExpCircuit takes the exponent as a constant. The cost of the
+// exponentiation with a variable exponent depends on the bound of the
+// arguments and not on the size of the exponent, which makes it too expensive
+// to check for the 4096-bit bound. As the test engine does not use constants,
+// the circuit has to be compiled and solved.
+type expmodConstExpCircuit[P emulated.FieldParams] struct {
+	Base, Modulus emulated.Element[P]
+	Expected      emulated.Element[P]
+	exp           *big.Int
+}
+
+func (c *expmodConstExpCircuit[P]) Define(api frontend.API) error {
+	f, err := emulated.NewField[P](api)
+	if err != nil {
+		return err
+	}
+	exp := emulated.ValueOf[P](c.exp)
+	res := Expmod(api, &c.Base, &exp, &c.Modulus)
+	f.AssertLimbsEquality(res, &c.Expected)
+	return nil
+}
+
+func TestExpmodVectors(t *testing.T) {
+	assert := test.NewAssert(t)
+	tests, err := loadTestVectors("modexp")
+	assert.NoError(err)
+	var fp512 emulated.Mod1e512
+	var fp4096 emulated.Mod1e4096
+	for _, tc := range tests {
+		in, err := hex.DecodeString(tc.Input)
+		assert.NoError(err)
+		bLen := new(big.Int).SetBytes(in[0:32]).Uint64()
+		eLen := new(big.Int).SetBytes(in[32:64]).Uint64()
+		mLen := new(big.Int).SetBytes(in[64:96]).Uint64()
+		in = in[96:]
+		base := new(big.Int).SetBytes(in[:bLen])
+		exp := new(big.Int).SetBytes(in[bLen : bLen+eLen])
+		mod := new(big.Int).SetBytes(in[bLen+eLen : bLen+eLen+mLen])
+		expected, ok := new(big.Int).SetString(tc.Expected, 16)
+		assert.True(ok)
+		switch {
+		case fitsBits(fp512.Modulus().BitLen(), bLen, eLen, mLen):
+			assert.Run(func(assert *test.Assert) {
+				witness := expmodCircuit[emulated.Mod1e512]{
+					Base:     emulated.ValueOf[emulated.Mod1e512](base),
+					Exp:      emulated.ValueOf[emulated.Mod1e512](exp),
+					Modulus:  emulated.ValueOf[emulated.Mod1e512](mod),
+					Expected: emulated.ValueOf[emulated.Mod1e512](expected),
+				}
+				err := test.IsSolved(&expmodCircuit[emulated.Mod1e512]{}, &witness, ecc.BN254.ScalarField())
+				assert.NoError(err)
+			}, tc.Name)
+		case fitsBits(fp4096.Modulus().BitLen(), bLen, eLen, mLen):
+			assert.Run(func(assert *test.Assert) {
+				ccs, err := frontend.Compile(ecc.BN254.ScalarField(), scs.NewBuilder, &expmodConstExpCircuit[emulated.Mod1e4096]{exp: exp})
+				assert.NoError(err)
+				assignment := expmodConstExpCircuit[emulated.Mod1e4096]{
+					Base:     emulated.ValueOf[emulated.Mod1e4096](base),
+					Modulus:  emulated.ValueOf[emulated.Mod1e4096](mod),
+					Expected: emulated.ValueOf[emulated.Mod1e4096](expected),
+				}
+				w, err := frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+				assert.NoError(err)
+				_, err = ccs.Solve(w)
+				assert.NoError(err)
+				assignment.Expected = emulated.ValueOf[emulated.Mod1e4096](new(big.Int).Add(expected, big.NewInt(1)))
+				w, err = frontend.NewWitness(&assignment, ecc.BN254.ScalarField())
+				assert.NoError(err)
+				_, err = ccs.Solve(w)
+				assert.Error(err)
+			}, tc.Name)
+		default:
+			// the inputs do not fit into the bounds
+		}
+	}
+}
+
+// fitsBits returns true if all the byte lengths fit into nbBits bits.
+func fitsBits(nbBits int, byteLens ...uint64) bool {
+	for _, l := range byteLens {
+		if 8*l > uint64(nbBits) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestExpmodEdgeCases(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, tc := range []struct {
+		name                     string
+		base, exp, mod, expected int64
+	}{
+		{"modulus=0", 3, 5, 0, 0},
+		{"modulus=1", 3, 5, 1, 0},
+		{"exp=0", 3, 0, 7, 1},
+		{"exp=1", 12, 1, 7, 5},
+		{"base=0", 0, 0, 7, 1},
+	} {
+		assert.Run(func(assert *test.Assert) {
+			witness := expmodCircuit[emulated.Mod1e512]{
+				Base:     emulated.ValueOf[emulated.Mod1e512](tc.base),
+				Exp:      emulated.ValueOf[emulated.Mod1e512](tc.exp),
+				Modulus:  emulated.ValueOf[emulated.Mod1e512](tc.mod),
+				Expected: emulated.ValueOf[emulated.Mod1e512](tc.expected),
+			}
+			err := test.IsSolved(&expmodCircuit[emulated.Mod1e512]{}, &witness, ecc.BN254.ScalarField())
+			assert.NoError(err)
+			witness.Expected = emulated.ValueOf[emulated.Mod1e512](tc.expected + 1)
+			err = test.IsSolved(&expmodCircuit[emulated.Mod1e512]{}, &witness, ecc.BN254.ScalarField())
+			assert.Error(err)
+		}, tc.name)
+	}
+}
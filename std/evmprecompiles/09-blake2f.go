@@ -0,0 +1,35 @@
+package evmprecompiles
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/permutation/blake2b"
+)
+
+// Blake2F implements [BLAKE2F] precompile contract at address 0x09.
+//
+// The arguments h, m and t are the state vector, message block and offset
+// counters as 64-bit little-endian words. The final block indicator final has
+// to be 0 or 1. The number of rounds is a variable, but differently from the
+// precompile it is bounded by maxRounds which is fixed at circuit compile
+// time. The circuit size depends on maxRounds and the method asserts that
+// rounds is at most maxRounds. The caller has to choose maxRounds from the
+// rounds of the precompile calls.
+//
+// [BLAKE2F]: https://ethereum.github.io/execution-specs/autoapi/ethereum/cancun/vm/precompiled_contracts/blake2f/index.html
+func Blake2F(api frontend.API, maxRounds int, rounds frontend.Variable, h [8]uints.U64, m [16]uints.U64, t [2]uints.U64, final frontend.Variable) [8]uints.U64 {
+	uapi, err := uints.New[uints.U64](api)
+	if err != nil {
+		panic(fmt.Sprintf("new uints: %v", err))
+	}
+	api.AssertIsBoolean(final)
+	// the finalization flag is the word of all ones for the final block
+	mask := uapi.ByteValueOf(api.Mul(final, 0xff))
+	var f0 uints.U64
+	for i := range f0 {
+		f0[i] = mask
+	}
+	return blake2b.PermuteVariable(api, uapi, maxRounds, rounds, h, m, t, [2]uints.U64{f0, uints.NewU64(0)})
+}
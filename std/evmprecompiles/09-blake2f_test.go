@@ -0,0 +1,103 @@
+package evmprecompiles
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type blake2fCircuit struct {
+	maxRounds int
+	Rounds    frontend.Variable
+	H         [8]uints.U64
+	M         [16]uints.U64
+	T         [2]uints.U64
+	F         frontend.Variable
+	Expected  [8]uints.U64
+}
+
+func (c *blake2fCircuit) Define(api frontend.API) error {
+	uapi, err := uints.New[uints.U64](api)
+	if err != nil {
+		return err
+	}
+	res := Blake2F(api, c.maxRounds, c.Rounds, c.H, c.M, c.T, c.F)
+	for i := range c.Expected {
+		uapi.AssertEq(c.Expected[i], res[i])
+	}
+	return nil
+}
+
+func bytesToU64s(b []byte) []uints.U64 {
+	res := make([]uints.U64, len(b)/8)
+	for i := range res {
+		res[i] = uints.NewU64(binary.LittleEndian.Uint64(b[8*i:]))
+	}
+	return res
+}
+
+func TestBlake2FVectors(t *testing.T) {
+	assert := test.NewAssert(t)
+	tests, err := loadTestVectors("blake2F")
+	assert.NoError(err)
+	const maxRounds = 12
+	for _, tc := range tests {
+		in, err := hex.DecodeString(tc.Input)
+		assert.NoError(err)
+		assert.Equal(213, len(in))
+		rounds := binary.BigEndian.Uint32(in[0:4])
+		expected, err := hex.DecodeString(tc.Expected)
+		assert.NoError(err)
+		assert.Run(func(assert *test.Assert) {
+			circuit := blake2fCircuit{maxRounds: maxRounds}
+			witness := blake2fCircuit{Rounds: rounds, F: in[212]}
+			copy(witness.H[:], bytesToU64s(in[4:68]))
+			copy(witness.M[:], bytesToU64s(in[68:196]))
+			copy(witness.T[:], bytesToU64s(in[196:212]))
+			copy(witness.Expected[:], bytesToU64s(expected))
+			err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+			if rounds > maxRounds {
+				// the number of rounds is larger than the compile-time bound
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+		}, tc.Name)
+	}
+}
+
+func TestBlake2FRoundsBound(t *testing.T) {
+	assert := test.NewAssert(t)
+	tests, err := loadTestVectors("blake2F")
+	assert.NoError(err)
+	for _, tc := range tests {
+		in, err := hex.DecodeString(tc.Input)
+		assert.NoError(err)
+		rounds := binary.BigEndian.Uint32(in[0:4])
+		if rounds == 0 || rounds > 12 {
+			continue
+		}
+		expected, err := hex.DecodeString(tc.Expected)
+		assert.NoError(err)
+		// the circuit computes at most maxRounds rounds, so claiming more
+		// rounds would otherwise give the result for maxRounds rounds.
+		circuit := blake2fCircuit{maxRounds: int(rounds)}
+		witness := blake2fCircuit{Rounds: rounds + 1, F: in[212]}
+		copy(witness.H[:], bytesToU64s(in[4:68]))
+		copy(witness.M[:], bytesToU64s(in[68:196]))
+		copy(witness.T[:], bytesToU64s(in[196:212]))
+		copy(witness.Expected[:], bytesToU64s(expected))
+		err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.Error(err, tc.Name)
+		witness.Rounds = rounds
+		err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.Name)
+		return
+	}
+	t.Fatal("no test vector with non-zero rounds")
+}
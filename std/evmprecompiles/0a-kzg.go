@@ -0,0 +1,149 @@
+package evmprecompiles
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/fields_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// kzgSetupG2 is the compressed [τ]G₂ of the Ethereum KZG ceremony trusted
+// setup.
+const kzgSetupG2 = "b5bfd7dd8cdeb128843bc287230af38926187075cbfbefa81009a2ce615ac53d2914e5870cb452d2afaaab24f3499f72185cbfee53492714734429b7b38608e23926c911cceceac9a36851477ba4c60b087041de621000edc98edada20c1def2"
+
+// kzgVersionedHashVersion is the version byte of the versioned hash of a KZG
+// commitment.
+const kzgVersionedHashVersion = 0x01
+
+// KZGPointEvaluation implements [POINT_EVALUATION] precompile contract at
+// address 0x0a.
+//
+// It asserts that versionedHash is the versioned hash of the commitment and
+// that the proof is a valid KZG opening proof of the commitment at the
+// evaluation point to the claimed value. The commitment and proof are given
+// as affine points which are compressed in-circuit for computing the versioned
+// hash. The point at infinity is given as (0,0), which allows to open
+// constant polynomials.
+//
+// The output of the precompile is constant (the number of field elements in a
+// blob and the scalar field modulus) and is not returned.
+//
+// [POINT_EVALUATION]: https://ethereum.github.io/execution-specs/autoapi/ethereum/cancun/vm/precompiled_contracts/point_evaluation/index.html
+func KZGPointEvaluation(api frontend.API, versionedHash [32]uints.U8, evaluationPoint, claimedValue *sw_bls12381.Scalar, commitment, proof *sw_bls12381.G1Affine) {
+	fr, err := emulated.NewField[sw_bls12381.ScalarField](api)
+	if err != nil {
+		panic(fmt.Sprintf("new scalar field: %v", err))
+	}
+	fp, err := emulated.NewField[emulated.BLS12381Fp](api)
+	if err != nil {
+		panic(fmt.Sprintf("new base field: %v", err))
+	}
+	curve, err := sw_emulated.New[emulated.BLS12381Fp, emulated.BLS12381Fr](api, sw_emulated.GetBLS12381Params())
+	if err != nil {
+		panic(fmt.Sprintf("new curve: %v", err))
+	}
+	pairing, err := sw_bls12381.NewPairing(api)
+	if err != nil {
+		panic(fmt.Sprintf("new pairing: %v", err))
+	}
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		panic(fmt.Sprintf("new uints: %v", err))
+	}
+	// 1- Check that the evaluation point and the claimed value are canonical
+	fr.AssertIsInRange(evaluationPoint)
+	fr.AssertIsInRange(claimedValue)
+	// 2- Check that the commitment and the proof are on G1
+	pairing.AssertIsOnG1(commitment)
+	pairing.AssertIsOnG1(proof)
+
+	// 3- Check that the versioned hash corresponds to the commitment
+	h, err := sha2.New(api)
+	if err != nil {
+		panic(fmt.Sprintf("new sha2: %v", err))
+	}
+	h.Write(compressG1(api, fp, uapi, commitment))
+	digest := h.Sum()
+	uapi.ByteAssertEq(versionedHash[0], uints.NewU8(kzgVersionedHashVersion))
+	for i := 1; i < len(versionedHash); i++ {
+		uapi.ByteAssertEq(versionedHash[i], digest[i])
+	}
+
+	// 4- Check the opening proof
+	//
+	// The proof is valid iff e(C - [y]G₁ + [z]π, G₂)·e(-π, [τ]G₂) == 1. We
+	// compute the left point with complete formulas as y and z can be zero and
+	// any of the points can collide.
+	lhs := curve.AddUnified(commitment, curve.Neg(curve.ScalarMulBase(claimedValue)))
+	lhs = curve.AddUnified(lhs, curve.ScalarMul(proof, evaluationPoint))
+	// The pairing does not accept (0,0). As the commitment and the proof are
+	// in G1, the check holds when exactly one of lhs and π is (0,0) only if
+	// the other is also (0,0). So we assert that either both are (0,0) or
+	// none. In the former case we replace the inputs of the pairing check with
+	// (G₁, -G₁) and (G₂, G₂) for which the check holds.
+	isLhsInf := api.And(fp.IsZero(&lhs.X), fp.IsZero(&lhs.Y))
+	isProofInf := api.And(fp.IsZero(&proof.X), fp.IsZero(&proof.Y))
+	api.AssertIsEqual(isLhsInf, isProofInf)
+
+	var tauG2 bls12381.G2Affine
+	bTauG2, err := hex.DecodeString(kzgSetupG2)
+	if err != nil {
+		panic(fmt.Sprintf("decode setup: %v", err))
+	}
+	if _, err := tauG2.SetBytes(bTauG2); err != nil {
+		panic(fmt.Sprintf("set setup: %v", err))
+	}
+	_, _, g1, g2 := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+	wG1, wNegG1 := sw_bls12381.NewG1Affine(g1), sw_bls12381.NewG1Affine(negG1)
+	wG2, wTauG2 := sw_bls12381.NewG2Affine(g2), sw_bls12381.NewG2Affine(tauG2)
+	ext2 := fields_bls12381.NewExt2(api)
+	P0 := curve.Select(isProofInf, &wG1, lhs)
+	P1 := curve.Select(isProofInf, &wNegG1, curve.Neg(proof))
+	Q1 := &sw_bls12381.G2Affine{
+		X: *ext2.Select(isProofInf, &wG2.X, &wTauG2.X),
+		Y: *ext2.Select(isProofInf, &wG2.Y, &wTauG2.Y),
+	}
+	if err := pairing.PairingCheck(
+		[]*sw_bls12381.G1Affine{P0, P1},
+		[]*sw_bls12381.G2Affine{&wG2, Q1},
+	); err != nil {
+		panic(fmt.Sprintf("pairing check: %v", err))
+	}
+}
+
+// compressG1 returns the 48-byte compressed encoding of the point P as defined
+// in the ZCash serialization format. The encoding is the big-endian canonical x
+// coordinate, where the three most significant bits are the compression flag
+// (set), the infinity flag (set if P is (0,0)) and the sign flag (set if y is
+// lexicographically larger than -y). For (0,0) the other bits are zero.
+func compressG1(api frontend.API, fp *emulated.Field[emulated.BLS12381Fp], uapi *uints.BinaryField[uints.U32], P *sw_bls12381.G1Affine) []uints.U8 {
+	x := fp.Reduce(&P.X)
+	fp.AssertIsInRange(x)
+	xBits := fp.ToBits(x)
+	// y > -y iff 2y > p. As p is odd, then this holds iff 2y mod p is odd.
+	y2 := fp.Reduce(fp.Add(&P.Y, &P.Y))
+	fp.AssertIsInRange(y2)
+	sign := fp.ToBits(y2)[0]
+	isInf := api.And(fp.IsZero(x), fp.IsZero(&P.Y))
+
+	res := make([]uints.U8, 48)
+	for i := range res {
+		b := bits.FromBinary(api, xBits[8*(47-i):8*(48-i)])
+		if i == 0 {
+			// the top three bits of the canonical x are zero
+			b = api.Add(b, 0x80, api.Mul(isInf, 0x40), api.Mul(sign, 0x20))
+		}
+		res[i] = uapi.ByteValueOf(b)
+	}
+	return res
+}
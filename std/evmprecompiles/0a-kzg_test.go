@@ -0,0 +1,125 @@
+package evmprecompiles
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	fr_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type kzgPointEvaluationCircuit struct {
+	VersionedHash   [32]uints.U8
+	EvaluationPoint sw_bls12381.Scalar
+	ClaimedValue    sw_bls12381.Scalar
+	Commitment      sw_bls12381.G1Affine
+	Proof           sw_bls12381.G1Affine
+}
+
+func (c *kzgPointEvaluationCircuit) Define(api frontend.API) error {
+	KZGPointEvaluation(api, c.VersionedHash, &c.EvaluationPoint, &c.ClaimedValue, &c.Commitment, &c.Proof)
+	return nil
+}
+
+func TestKZGPointEvaluationVectors(t *testing.T) {
+	assert := test.NewAssert(t)
+	tests, err := loadTestVectors("pointEvaluation")
+	assert.NoError(err)
+	for _, tc := range tests {
+		assert.Run(func(assert *test.Assert) {
+			in, err := hex.DecodeString(tc.Input)
+			assert.NoError(err)
+			assert.Equal(192, len(in))
+			var commitment, proof bls12381.G1Affine
+			_, err = commitment.SetBytes(in[96:144])
+			assert.NoError(err)
+			_, err = proof.SetBytes(in[144:192])
+			assert.NoError(err)
+			witness := kzgPointEvaluationCircuit{
+				EvaluationPoint: emulated.ValueOf[sw_bls12381.ScalarField](new(big.Int).SetBytes(in[32:64])),
+				ClaimedValue:    emulated.ValueOf[sw_bls12381.ScalarField](new(big.Int).SetBytes(in[64:96])),
+				Commitment:      sw_bls12381.NewG1Affine(commitment),
+				Proof:           sw_bls12381.NewG1Affine(proof),
+			}
+			copy(witness.VersionedHash[:], uints.NewU8Array(in[0:32]))
+			err = test.IsSolved(&kzgPointEvaluationCircuit{}, &witness, ecc.BN254.ScalarField())
+			assert.NoError(err)
+			// wrong versioned hash
+			witness.VersionedHash[31] = uints.NewU8(in[31] ^ 1)
+			err = test.IsSolved(&kzgPointEvaluationCircuit{}, &witness, ecc.BN254.ScalarField())
+			assert.Error(err)
+		}, tc.Name)
+	}
+}
+
+func TestKZGPointEvaluationEdgeCases(t *testing.T) {
+	assert := test.NewAssert(t)
+	tests, err := loadTestVectors("pointEvaluation")
+	assert.NoError(err)
+	assert.NotEmpty(tests)
+	// we derive the edge cases from the first valid vector (C, z, y, π) for
+	// which C - [y]G₁ = [τ-z]π.
+	in, err := hex.DecodeString(tests[0].Input)
+	assert.NoError(err)
+	var C, pi bls12381.G1Affine
+	_, err = C.SetBytes(in[96:144])
+	assert.NoError(err)
+	_, err = pi.SetBytes(in[144:192])
+	assert.NoError(err)
+	var z, y fr_bls12381.Element
+	z.SetBytes(in[32:64])
+	y.SetBytes(in[64:96])
+
+	var yG, zPi, inf bls12381.G1Affine
+	yG.ScalarMultiplicationBase(y.BigInt(new(big.Int)))
+	zPi.ScalarMultiplication(&pi, z.BigInt(new(big.Int)))
+	var cMinusYG, cPlusZPi, cMinusYGPlusZPi, yGMinusZPi bls12381.G1Affine
+	cMinusYG.Sub(&C, &yG)
+	cPlusZPi.Add(&C, &zPi)
+	cMinusYGPlusZPi.Add(&cMinusYG, &zPi)
+	yGMinusZPi.Sub(&yG, &zPi)
+	var zero fr_bls12381.Element
+
+	for _, tc := range []struct {
+		name       string
+		commitment bls12381.G1Affine
+		z, y       fr_bls12381.Element
+		proof      bls12381.G1Affine
+		valid      bool
+	}{
+		{name: "y=0", commitment: cMinusYG, z: z, y: zero, proof: pi, valid: true},
+		{name: "z=0", commitment: cPlusZPi, z: zero, y: y, proof: pi, valid: true},
+		{name: "z=0/y=0", commitment: cMinusYGPlusZPi, z: zero, y: zero, proof: pi, valid: true},
+		{name: "constant", commitment: yG, z: z, y: y, proof: inf, valid: true},
+		{name: "zero", commitment: inf, z: z, y: zero, proof: inf, valid: true},
+		{name: "proof=inf", commitment: C, z: z, y: y, proof: inf, valid: false},
+		{name: "lhs=inf", commitment: yGMinusZPi, z: z, y: y, proof: pi, valid: false},
+	} {
+		assert.Run(func(assert *test.Assert) {
+			cBytes := tc.commitment.Bytes()
+			versionedHash := sha256.Sum256(cBytes[:])
+			versionedHash[0] = kzgVersionedHashVersion
+			witness := kzgPointEvaluationCircuit{
+				EvaluationPoint: sw_bls12381.NewScalar(tc.z),
+				ClaimedValue:    sw_bls12381.NewScalar(tc.y),
+				Commitment:      sw_bls12381.NewG1Affine(tc.commitment),
+				Proof:           sw_bls12381.NewG1Affine(tc.proof),
+			}
+			copy(witness.VersionedHash[:], uints.NewU8Array(versionedHash[:]))
+			err = test.IsSolved(&kzgPointEvaluationCircuit{}, &witness, ecc.BN254.ScalarField())
+			if tc.valid {
+				assert.NoError(err)
+			} else {
+				assert.Error(err)
+			}
+		}, tc.name)
+	}
+}
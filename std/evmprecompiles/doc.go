@@ -4,14 +4,18 @@
 // easier integration. The main functionality is implemented elsewhere. This
 // package right now implements:
 //  1. ECRECOVER ✅ -- function [ECRecover]
-//  2. SHA256 ❌ -- in progress
-//  3. RIPEMD160 ❌ -- postponed
+//  2. SHA256 ✅ -- function [SHA256]
+//  3. RIPEMD160 ✅ -- function [RIPEMD160]
 //  4. ID ❌ -- trivial to implement without function
-//  5. EXPMOD ❌ -- in progress
+//  5. EXPMOD ✅ -- function [Expmod]
 //  6. BN_ADD ✅ -- function [ECAdd]
 //  7. BN_MUL ✅ -- function [ECMul]
 //  8. SNARKV ✅ -- function [ECPair]
-//  9. BLAKE2F ❌ -- postponed
+//  9. BLAKE2F ✅ -- function [Blake2F]
+//  10. POINT_EVALUATION ✅ -- function [KZGPointEvaluation]
+//
+// The functions are tested against the precompile test vectors of go-ethereum
+// in the testdata directory.
 //
 // This package uses local representation for the arguments. It is up to the
 // user to instantiate corresponding types from their application-specific data.
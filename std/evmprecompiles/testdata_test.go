@@ -0,0 +1,29 @@
+package evmprecompiles
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// precompileTest is a precompile test vector in the format of go-ethereum
+// (core/vm/testdata/precompiles).
+type precompileTest struct {
+	Input, Expected string
+	Gas             uint64
+	Name            string
+	NoBenchmark     bool
+}
+
+func loadTestVectors(name string) ([]precompileTest, error) {
+	data, err := os.ReadFile(filepath.Join("testdata", fmt.Sprintf("%s.json", name)))
+	if err != nil {
+		return nil, err
+	}
+	var tests []precompileTest
+	if err := json.Unmarshal(data, &tests); err != nil {
+		return nil, err
+	}
+	return tests, nil
+}
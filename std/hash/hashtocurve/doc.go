@@ -0,0 +1,30 @@
+/*
+Package hashtocurve implements hashing to elliptic curves as defined in
+[RFC 9380].
+
+The messages are expanded using expand_message_xmd with SHA-256
+([ExpandMsgXMD]) and hashed to base field elements ([HashToField]). The field
+elements are then mapped to the curve using the map of the corresponding
+ciphersuite:
+  - secp256k1: secp256k1_XMD:SHA-256_SSWU_RO_ (simplified SWU map to an
+    isogenous curve and 3-isogeny), see [HashToSecp256k1];
+  - BN254 G1: BN254G1_XMD:SHA-256_SVDW_RO_ (Shallue-van de Woestijne map), see
+    [HashToBN254G1]. The curve has no suitable isogenous curve for the
+    simplified SWU map;
+  - BLS12-381 G1: BLS12381G1_XMD:SHA-256_SSWU_RO_ (simplified SWU map to an
+    isogenous curve, 11-isogeny and cofactor clearing), see
+    [HashToBLS12381G1];
+  - BLS12-381 G2: BLS12381G2_XMD:SHA-256_SSWU_RO_ (simplified SWU map to an
+    isogenous curve, 3-isogeny and cofactor clearing), see
+    [HashToBLS12381G2].
+
+The curves are not defined over the native field and all the operations use
+field emulation. The outputs are compatible with the implementations in
+gnark-crypto, except for secp256k1 for which gnark-crypto uses the
+Shallue-van de Woestijne map instead of the map of the RFC ciphersuite.
+
+The length of the message is fixed at circuit compile time.
+
+[RFC 9380]: https://www.rfc-editor.org/rfc/rfc9380.html
+*/
+package hashtocurve
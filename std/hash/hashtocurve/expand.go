@@ -0,0 +1,83 @@
+package hashtocurve
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// ExpandMsgXMD expands the message msg into lenInBytes uniformly random bytes
+// using SHA-256 and the domain separation tag dst as defined in RFC 9380
+// Section 5.3.1.
+//
+// It returns an error if the requested length or the length of dst are out of
+// bounds.
+func ExpandMsgXMD(api frontend.API, msg []uints.U8, dst []byte, lenInBytes int) ([]uints.U8, error) {
+	const bInBytes = 32 // SHA-256 output size
+	const rInBytes = 64 // SHA-256 block size
+	ell := (lenInBytes + bInBytes - 1) / bInBytes
+	if ell > 255 || lenInBytes > 65535 || lenInBytes <= 0 {
+		return nil, fmt.Errorf("invalid output length %d", lenInBytes)
+	}
+	if len(dst) > 255 {
+		return nil, errors.New("domain separation tag too long")
+	}
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return nil, fmt.Errorf("new uints api: %w", err)
+	}
+	// DST_prime = DST || I2OSP(len(DST), 1)
+	dstPrime := uints.NewU8Array(append(append([]byte{}, dst...), byte(len(dst))))
+
+	// b_0 = H(Z_pad || msg || l_i_b_str || I2OSP(0, 1) || DST_prime)
+	b0, err := sum(api,
+		uints.NewU8Array(make([]byte, rInBytes)),
+		msg,
+		uints.NewU8Array([]byte{byte(lenInBytes >> 8), byte(lenInBytes), 0}),
+		dstPrime)
+	if err != nil {
+		return nil, err
+	}
+	// b_1 = H(b_0 || I2OSP(1, 1) || DST_prime)
+	bi, err := sum(api, b0, []uints.U8{uints.NewU8(1)}, dstPrime)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]uints.U8, 0, ell*bInBytes)
+	res = append(res, bi...)
+	for i := 2; i <= ell; i++ {
+		// b_i = H(strxor(b_0, b_(i - 1)) || I2OSP(i, 1) || DST_prime)
+		bi, err = sum(api, xorBytes(uapi, b0, bi), []uints.U8{uints.NewU8(uint8(i))}, dstPrime)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, bi...)
+	}
+	return res[:lenInBytes], nil
+}
+
+// sum returns the SHA-256 digest of the concatenation of the inputs.
+func sum(api frontend.API, data ...[]uints.U8) ([]uints.U8, error) {
+	h, err := sha2.New(api)
+	if err != nil {
+		return nil, fmt.Errorf("new hash: %w", err)
+	}
+	for i := range data {
+		h.Write(data[i])
+	}
+	return h.Sum(), nil
+}
+
+// xorBytes returns the bytewise XOR of a and b. The length of the inputs must
+// be a multiple of 4.
+func xorBytes(uapi *uints.BinaryField[uints.U32], a, b []uints.U8) []uints.U8 {
+	res := make([]uints.U8, 0, len(a))
+	for i := 0; i < len(a); i += 4 {
+		x := uapi.Xor(uapi.PackMSB(a[i:i+4]...), uapi.PackMSB(b[i:i+4]...))
+		res = append(res, uapi.UnpackMSB(x)...)
+	}
+	return res
+}
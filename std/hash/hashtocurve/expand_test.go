@@ -0,0 +1,87 @@
+package hashtocurve
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/field/hash"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+const testDST = "QUUX-V01-CS02-with-expander-SHA256-128"
+
+type expandMsgXMDCircuit struct {
+	Msg      []uints.U8
+	Expected []uints.U8
+}
+
+func (c *expandMsgXMDCircuit) Define(api frontend.API) error {
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return err
+	}
+	res, err := ExpandMsgXMD(api, c.Msg, []byte(testDST), len(c.Expected))
+	if err != nil {
+		return err
+	}
+	for i := range c.Expected {
+		uapi.ByteAssertEq(c.Expected[i], res[i])
+	}
+	return nil
+}
+
+func TestExpandMsgXMD(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, tc := range []struct {
+		msg        string
+		lenInBytes int
+	}{
+		{"", 0x20},
+		{"abc", 0x20},
+		{"abcdef0123456789", 0x80},
+	} {
+		assert.Run(func(assert *test.Assert) {
+			expected, err := hash.ExpandMsgXmd([]byte(tc.msg), []byte(testDST), tc.lenInBytes)
+			assert.NoError(err)
+			circuit := expandMsgXMDCircuit{
+				Msg:      make([]uints.U8, len(tc.msg)),
+				Expected: make([]uints.U8, tc.lenInBytes),
+			}
+			witness := expandMsgXMDCircuit{
+				Msg:      uints.NewU8Array([]byte(tc.msg)),
+				Expected: uints.NewU8Array(expected),
+			}
+			err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+			assert.NoError(err)
+		}, fmt.Sprintf("msg=%q/len=%d", tc.msg, tc.lenInBytes))
+	}
+}
+
+func TestExpandMsgXMDVectors(t *testing.T) {
+	// test vectors from RFC 9380 Appendix K.1
+	assert := test.NewAssert(t)
+	for _, tc := range []struct {
+		msg      string
+		expected string
+	}{
+		{"", "68a985b87eb6b46952128911f2a4412bbc302a9d759667f87f7a21d803f07235"},
+		{"abc", "d8ccab23b5985ccea865c6c97b6e5b8350e794e603b4b97902f53a8a0d605615"},
+	} {
+		expected, err := hex.DecodeString(tc.expected)
+		assert.NoError(err)
+		circuit := expandMsgXMDCircuit{
+			Msg:      make([]uints.U8, len(tc.msg)),
+			Expected: make([]uints.U8, len(expected)),
+		}
+		witness := expandMsgXMDCircuit{
+			Msg:      uints.NewU8Array([]byte(tc.msg)),
+			Expected: uints.NewU8Array(expected),
+		}
+		err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.msg)
+	}
+}
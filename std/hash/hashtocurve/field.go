@@ -0,0 +1,58 @@
+package hashtocurve
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// HashToField hashes the message msg to count elements of the field T as
+// defined in RFC 9380 Section 5.2, using [ExpandMsgXMD] with the domain
+// separation tag dst and the security parameter k = 128.
+//
+// It returns an error if initialising the field emulation or expanding the
+// message fails.
+func HashToField[T emulated.FieldParams](api frontend.API, msg []uints.U8, dst []byte, count int) ([]*emulated.Element[T], error) {
+	f, err := emulated.NewField[T](api)
+	if err != nil {
+		return nil, fmt.Errorf("new field: %w", err)
+	}
+	var fp T
+	// L = ceil((ceil(log2(p)) + k) / 8)
+	L := (fp.Modulus().BitLen() + 128 + 7) / 8
+	uniformBytes, err := ExpandMsgXMD(api, msg, dst, count*L)
+	if err != nil {
+		return nil, fmt.Errorf("expand message: %w", err)
+	}
+	// we split the bytes at the width of the emulated element and recombine
+	// the parts modulo p using the constant 2^width mod p.
+	width := int(fp.NbLimbs() * fp.BitsPerLimb())
+	shift := new(big.Int).Lsh(big.NewInt(1), uint(width))
+	shift.Mod(shift, fp.Modulus())
+	shiftEl := f.NewElement(shift)
+
+	res := make([]*emulated.Element[T], count)
+	for i := range res {
+		// the bytes are interpreted as a big-endian integer. We decompose them
+		// into little-endian bits.
+		tv := uniformBytes[i*L : (i+1)*L]
+		tvBits := make([]frontend.Variable, 0, 8*L)
+		for j := len(tv) - 1; j >= 0; j-- {
+			tvBits = append(tvBits, bits.ToBinary(api, tv[j].Val, bits.WithNbDigits(8))...)
+		}
+		// e = lo + hi * 2^width mod p
+		lo := f.FromBits(tvBits[:width]...)
+		hiBits := make([]frontend.Variable, width)
+		for j := range hiBits {
+			hiBits[j] = 0
+		}
+		copy(hiBits, tvBits[width:])
+		hi := f.FromBits(hiBits...)
+		res[i] = f.Add(f.Mul(hi, shiftEl), lo)
+	}
+	return res, nil
+}
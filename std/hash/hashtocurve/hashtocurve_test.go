@@ -0,0 +1,234 @@
+package hashtocurve
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	bls12381fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	bn254fp "github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bn254"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type hashToFieldCircuit struct {
+	Msg      []uints.U8
+	Expected []emulated.Element[emulated.BN254Fp]
+	dst      []byte
+}
+
+func (c *hashToFieldCircuit) Define(api frontend.API) error {
+	f, err := emulated.NewField[emulated.BN254Fp](api)
+	if err != nil {
+		return err
+	}
+	res, err := HashToField[emulated.BN254Fp](api, c.Msg, c.dst, len(c.Expected))
+	if err != nil {
+		return err
+	}
+	for i := range c.Expected {
+		f.AssertIsEqual(res[i], &c.Expected[i])
+	}
+	return nil
+}
+
+func TestHashToField(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("abc")
+	dst := []byte("QUUX-V01-CS02-with-BN254G1_XMD:SHA-256_SVDW_RO_")
+	u, err := bn254fp.Hash(msg, dst, 2)
+	assert.NoError(err)
+	circuit := hashToFieldCircuit{
+		Msg:      make([]uints.U8, len(msg)),
+		Expected: make([]emulated.Element[emulated.BN254Fp], len(u)),
+		dst:      dst,
+	}
+	witness := hashToFieldCircuit{
+		Msg:      uints.NewU8Array(msg),
+		Expected: make([]emulated.Element[emulated.BN254Fp], len(u)),
+	}
+	for i := range u {
+		witness.Expected[i] = emulated.ValueOf[emulated.BN254Fp](u[i])
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type hashToSecp256k1Circuit struct {
+	Msg      []uints.U8
+	Expected sw_emulated.AffinePoint[emulated.Secp256k1Fp]
+	dst      []byte
+}
+
+func (c *hashToSecp256k1Circuit) Define(api frontend.API) error {
+	curve, err := sw_emulated.New[emulated.Secp256k1Fp, emulated.Secp256k1Fr](api, sw_emulated.GetSecp256k1Params())
+	if err != nil {
+		return err
+	}
+	res, err := HashToSecp256k1(api, c.Msg, c.dst)
+	if err != nil {
+		return err
+	}
+	curve.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestHashToSecp256k1(t *testing.T) {
+	// test vectors from RFC 9380 Appendix J.8.1
+	assert := test.NewAssert(t)
+	dst := []byte("QUUX-V01-CS02-with-secp256k1_XMD:SHA-256_SSWU_RO_")
+	for _, tc := range []struct {
+		msg  string
+		x, y string
+	}{
+		{"", "0xc1cae290e291aee617ebaef1be6d73861479c48b841eaba9b7b5852ddfeb1346", "0x64fa678e07ae116126f08b022a94af6de15985c996c3a91b64c406a960e51067"},
+		{"abc", "0x3377e01eab42db296b512293120c6cee72b6ecf9f9205760bd9ff11fb3cb2c4b", "0x7f95890f33efebd1044d382a01b1bee0900fb6116f94688d487c6c7b9c8371f6"},
+	} {
+		circuit := hashToSecp256k1Circuit{
+			Msg: make([]uints.U8, len(tc.msg)),
+			dst: dst,
+		}
+		witness := hashToSecp256k1Circuit{
+			Msg: uints.NewU8Array([]byte(tc.msg)),
+			Expected: sw_emulated.AffinePoint[emulated.Secp256k1Fp]{
+				X: emulated.ValueOf[emulated.Secp256k1Fp](tc.x),
+				Y: emulated.ValueOf[emulated.Secp256k1Fp](tc.y),
+			},
+		}
+		err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, tc.msg)
+	}
+}
+
+type hashToBN254G1Circuit struct {
+	Msg      []uints.U8
+	Expected sw_bn254.G1Affine
+	dst      []byte
+}
+
+func (c *hashToBN254G1Circuit) Define(api frontend.API) error {
+	curve, err := sw_emulated.New[emulated.BN254Fp, emulated.BN254Fr](api, sw_emulated.GetBN254Params())
+	if err != nil {
+		return err
+	}
+	res, err := HashToBN254G1(api, c.Msg, c.dst)
+	if err != nil {
+		return err
+	}
+	curve.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestHashToBN254G1(t *testing.T) {
+	assert := test.NewAssert(t)
+	dst := []byte("QUUX-V01-CS02-with-BN254G1_XMD:SHA-256_SVDW_RO_")
+	for _, msg := range []string{"", "abc"} {
+		expected, err := bn254.HashToG1([]byte(msg), dst)
+		assert.NoError(err)
+		circuit := hashToBN254G1Circuit{
+			Msg: make([]uints.U8, len(msg)),
+			dst: dst,
+		}
+		witness := hashToBN254G1Circuit{
+			Msg:      uints.NewU8Array([]byte(msg)),
+			Expected: sw_bn254.NewG1Affine(expected),
+		}
+		err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		assert.NoError(err, msg)
+	}
+}
+
+type hashToBLS12381G1Circuit struct {
+	Msg      []uints.U8
+	Expected sw_bls12381.G1Affine
+	dst      []byte
+}
+
+func (c *hashToBLS12381G1Circuit) Define(api frontend.API) error {
+	curve, err := sw_emulated.New[emulated.BLS12381Fp, emulated.BLS12381Fr](api, sw_emulated.GetBLS12381Params())
+	if err != nil {
+		return err
+	}
+	res, err := HashToBLS12381G1(api, c.Msg, c.dst)
+	if err != nil {
+		return err
+	}
+	curve.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestHashToBLS12381G1(t *testing.T) {
+	assert := test.NewAssert(t)
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_")
+	msg := []byte("abc")
+	expected, err := bls12381.HashToG1(msg, dst)
+	assert.NoError(err)
+	circuit := hashToBLS12381G1Circuit{
+		Msg: make([]uints.U8, len(msg)),
+		dst: dst,
+	}
+	witness := hashToBLS12381G1Circuit{
+		Msg:      uints.NewU8Array(msg),
+		Expected: sw_bls12381.NewG1Affine(expected),
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+func TestHashToBLS12381G1Vector(t *testing.T) {
+	// test vector from RFC 9380 Appendix J.9.1
+	assert := test.NewAssert(t)
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_")
+	var x, y bls12381fp.Element
+	_, err := x.SetString("0x052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1")
+	assert.NoError(err)
+	_, err = y.SetString("0x08ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265")
+	assert.NoError(err)
+	circuit := hashToBLS12381G1Circuit{
+		dst: dst,
+	}
+	witness := hashToBLS12381G1Circuit{
+		Expected: sw_bls12381.NewG1Affine(bls12381.G1Affine{X: x, Y: y}),
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type hashToBLS12381G2Circuit struct {
+	Msg      []uints.U8
+	Expected sw_bls12381.G2Affine
+	dst      []byte
+}
+
+func (c *hashToBLS12381G2Circuit) Define(api frontend.API) error {
+	res, err := HashToBLS12381G2(api, c.Msg, c.dst)
+	if err != nil {
+		return err
+	}
+	sw_bls12381.NewG2(api).AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestHashToBLS12381G2(t *testing.T) {
+	assert := test.NewAssert(t)
+	dst := []byte("QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_")
+	msg := []byte("abc")
+	expected, err := bls12381.HashToG2(msg, dst)
+	assert.NoError(err)
+	circuit := hashToBLS12381G2Circuit{
+		Msg: make([]uints.U8, len(msg)),
+		dst: dst,
+	}
+	witness := hashToBLS12381G2Circuit{
+		Msg:      uints.NewU8Array(msg),
+		Expected: sw_bls12381.NewG2Affine(expected),
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
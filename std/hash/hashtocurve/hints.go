@@ -0,0 +1,91 @@
+package hashtocurve
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hint functions used in the package.
+func GetHints() []solver.Hint {
+	return []solver.Hint{
+		sswuSqrtHint,
+		svdwSqrtHint,
+	}
+}
+
+// sswuSqrtHint computes the square root used in the simplified SWU map. It
+// returns a square root of gx1 if gx1 is a square and a square root of gx2
+// otherwise. The sign of the returned root matches the sign of u.
+func sswuSqrtHint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) error {
+	return emulated.UnwrapHint(nativeInputs, nativeOutputs,
+		func(mod *big.Int, inputs, outputs []*big.Int) error {
+			if len(inputs) != 3 || len(outputs) != 1 {
+				return fmt.Errorf("expected 3 inputs and 1 output")
+			}
+			gx1 := new(big.Int).Mod(inputs[0], mod)
+			gx2 := new(big.Int).Mod(inputs[1], mod)
+			u := new(big.Int).Mod(inputs[2], mod)
+			gx := gx2
+			if big.Jacobi(gx1, mod) != -1 {
+				gx = gx1
+			}
+			if outputs[0].ModSqrt(gx, mod) == nil {
+				return fmt.Errorf("no square root")
+			}
+			if outputs[0].Bit(0) != u.Bit(0) {
+				outputs[0].Sub(mod, outputs[0]).Mod(outputs[0], mod)
+			}
+			return nil
+		})
+}
+
+// svdwSqrtHint computes the square roots used in the Shallue-van de Woestijne
+// map. Given a non-square c, it returns for gx1 and gx2 a square root of gxi if
+// gxi is a square and a square root of c*gxi otherwise. Finally, it returns a
+// square root of the first square of gx1, gx2, gx3 with the sign matching the
+// sign of u.
+func svdwSqrtHint(nativeMod *big.Int, nativeInputs, nativeOutputs []*big.Int) error {
+	return emulated.UnwrapHint(nativeInputs, nativeOutputs,
+		func(mod *big.Int, inputs, outputs []*big.Int) error {
+			if len(inputs) != 5 || len(outputs) != 3 {
+				return fmt.Errorf("expected 5 inputs and 3 outputs")
+			}
+			c := new(big.Int).Mod(inputs[0], mod)
+			gx := [3]*big.Int{
+				new(big.Int).Mod(inputs[1], mod),
+				new(big.Int).Mod(inputs[2], mod),
+				new(big.Int).Mod(inputs[3], mod),
+			}
+			u := new(big.Int).Mod(inputs[4], mod)
+			var y *big.Int
+			for i := 0; i < 2; i++ {
+				t := gx[i]
+				if big.Jacobi(t, mod) == -1 {
+					t = new(big.Int).Mul(c, t)
+					t.Mod(t, mod)
+				} else if y == nil {
+					y = gx[i]
+				}
+				if outputs[i].ModSqrt(t, mod) == nil {
+					return fmt.Errorf("no square root")
+				}
+			}
+			if y == nil {
+				y = gx[2]
+			}
+			if outputs[2].ModSqrt(y, mod) == nil {
+				return fmt.Errorf("no square root")
+			}
+			if outputs[2].Bit(0) != u.Bit(0) {
+				outputs[2].Sub(mod, outputs[2]).Mod(outputs[2], mod)
+			}
+			return nil
+		})
+}
@@ -0,0 +1,288 @@
+package hashtocurve
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+)
+
+// sswuParams are the constants of the simplified SWU map to the curve E'
+// isogenous to the target curve and of the isogeny E' → E. The isogeny
+// coefficients are given in increasing degree order and the leading
+// coefficient 1 of the denominators is omitted.
+type sswuParams struct {
+	a, b, z                string
+	xNum, xDen, yNum, yDen []string
+}
+
+// mapper maps base field elements to points of a curve in short Weierstrass
+// form.
+type mapper[B, S emulated.FieldParams] struct {
+	api   frontend.API
+	fp    *emulated.Field[B]
+	curve *sw_emulated.Curve[B, S]
+}
+
+func newMapper[B, S emulated.FieldParams](api frontend.API) (*mapper[B, S], error) {
+	fp, err := emulated.NewField[B](api)
+	if err != nil {
+		return nil, fmt.Errorf("new base field: %w", err)
+	}
+	curve, err := sw_emulated.New[B, S](api, sw_emulated.GetCurveParams[B]())
+	if err != nil {
+		return nil, fmt.Errorf("new curve: %w", err)
+	}
+	return &mapper[B, S]{
+		api:   api,
+		fp:    fp,
+		curve: curve,
+	}, nil
+}
+
+// sswu implements the simplified SWU map to the curve E' as defined in RFC 9380
+// Section 6.6.2.
+//
+// The square root is computed in a hint and we only check that it is the
+// square root of either gx1 or gx2. This is sound as Z is not a square and
+// gx2 = Z³u⁶gx1, so that exactly one of gx1 and gx2 is a square.
+func (m *mapper[B, S]) sswu(params *sswuParams, u *emulated.Element[B]) *sw_emulated.AffinePoint[B] {
+	var fp B
+	p := fp.Modulus()
+	a := m.fp.NewElement(params.a)
+	b := m.fp.NewElement(params.b)
+	z := m.fp.NewElement(params.z)
+
+	// c1 = -B/A and c2 = B/(Z*A) are constants computed out of circuit.
+	nA, nB, nZ := bigFromString(params.a), bigFromString(params.b), bigFromString(params.z)
+	c1 := new(big.Int).ModInverse(nA, p)
+	c1.Mul(c1, nB).Neg(c1).Mod(c1, p)
+	c2 := new(big.Int).Mul(nZ, nA)
+	c2.ModInverse(c2, p).Mul(c2, nB).Mod(c2, p)
+	c1El := m.fp.NewElement(c1)
+	c2El := m.fp.NewElement(c2)
+
+	// tv1 = Z²u⁴ + Zu²
+	zu2 := m.fp.Mul(z, m.fp.Mul(u, u))
+	tv1 := m.fp.Add(m.fp.Mul(zu2, zu2), zu2)
+	// x1 = (-B/A) * (1 + 1/tv1), or B/(Z*A) if tv1 = 0
+	tv1IsZero := m.fp.IsZero(tv1)
+	den := m.fp.Select(tv1IsZero, m.fp.One(), tv1)
+	num := m.fp.Mul(c1El, m.fp.Add(den, m.fp.One()))
+	x1 := m.fp.Div(num, den)
+	x1 = m.fp.Select(tv1IsZero, c2El, x1)
+	gx1 := m.evalCurve(a, b, x1)
+	// x2 = Zu² * x1
+	x2 := m.fp.Mul(zu2, x1)
+	gx2 := m.evalCurve(a, b, x2)
+
+	res, err := m.fp.NewHint(sswuSqrtHint, 1, gx1, gx2, u)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+	y := res[0]
+
+	// y² = gx1 if gx1 is square and y² = gx2 otherwise
+	yy := m.fp.Mul(y, y)
+	isGx1 := m.fp.IsZero(m.fp.Sub(yy, gx1))
+	m.fp.AssertIsEqual(yy, m.fp.Select(isGx1, gx1, gx2))
+	x := m.fp.Select(isGx1, x1, x2)
+
+	// sgn0(u) = sgn0(y)
+	m.api.AssertIsEqual(m.sgn0(u), m.sgn0(y))
+
+	return &sw_emulated.AffinePoint[B]{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// svdw implements the Shallue-van de Woestijne map to the curve y² = x³ + ax +
+// b as defined in RFC 9380 Section 6.6.1.
+//
+// The square roots are computed in a hint. To show that gx1 (resp. gx2) is not
+// a square, the hint returns a square root of c*gx1 (resp. c*gx2) for a
+// constant non-square c.
+func (m *mapper[B, S]) svdw(a, b, z string, u *emulated.Element[B]) *sw_emulated.AffinePoint[B] {
+	var fp B
+	p := fp.Modulus()
+	c1, c2, c3, c4 := svdwConstants(p, bigFromString(a), bigFromString(b), bigFromString(z))
+	c1El := m.fp.NewElement(c1)
+	c2El := m.fp.NewElement(c2)
+	c3El := m.fp.NewElement(c3)
+	c4El := m.fp.NewElement(c4)
+	aEl := m.fp.NewElement(a)
+	bEl := m.fp.NewElement(b)
+	zEl := m.fp.NewElement(z)
+	nonSquare := m.fp.NewElement(smallestNonSquare(p))
+	one := m.fp.One()
+
+	// tv1 = 1 - u² * c1, tv2 = 1 + u² * c1
+	tv1 := m.fp.Mul(m.fp.Mul(u, u), c1El)
+	tv2 := m.fp.Add(one, tv1)
+	tv1 = m.fp.Sub(one, tv1)
+	// tv3 = inv0(tv1 * tv2)
+	tv3 := m.fp.Mul(tv1, tv2)
+	tv3IsZero := m.fp.IsZero(tv3)
+	tv3 = m.fp.Inverse(m.fp.Select(tv3IsZero, one, tv3))
+	tv3 = m.fp.Select(tv3IsZero, m.fp.Zero(), tv3)
+	// tv4 = u * tv1 * tv3 * c3
+	tv4 := m.fp.Mul(m.fp.Mul(m.fp.Mul(u, tv1), tv3), c3El)
+	// x1 = c2 - tv4, x2 = c2 + tv4
+	x1 := m.fp.Sub(c2El, tv4)
+	gx1 := m.evalCurve(aEl, bEl, x1)
+	x2 := m.fp.Add(c2El, tv4)
+	gx2 := m.evalCurve(aEl, bEl, x2)
+	// x3 = (tv2² * tv3)² * c4 + Z
+	x3 := m.fp.Mul(m.fp.Mul(tv2, tv2), tv3)
+	x3 = m.fp.Add(m.fp.Mul(m.fp.Mul(x3, x3), c4El), zEl)
+	gx3 := m.evalCurve(aEl, bEl, x3)
+
+	res, err := m.fp.NewHint(svdwSqrtHint, 3, nonSquare, gx1, gx2, gx3, u)
+	if err != nil {
+		// err is non-nil only for invalid number of inputs
+		panic(err)
+	}
+	e1 := m.isSquare(res[0], gx1, m.fp.Mul(nonSquare, gx1))
+	e2 := m.isSquare(res[1], gx2, m.fp.Mul(nonSquare, gx2))
+	// x = x1 if gx1 is square, else x2 if gx2 is square, else x3
+	x := m.fp.Select(e2, x2, x3)
+	gx := m.fp.Select(e2, gx2, gx3)
+	x = m.fp.Select(e1, x1, x)
+	gx = m.fp.Select(e1, gx1, gx)
+
+	y := res[2]
+	m.fp.AssertIsEqual(m.fp.Mul(y, y), gx)
+	// sgn0(u) = sgn0(y)
+	m.api.AssertIsEqual(m.sgn0(u), m.sgn0(y))
+
+	return &sw_emulated.AffinePoint[B]{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// isSquare returns 1 if gx is a square and 0 otherwise. The input r must be a
+// square root of either gx or cgx = c*gx for a non-square c. As exactly one of
+// gx and c*gx is a square when gx is non-zero, the result is determined by gx.
+func (m *mapper[B, S]) isSquare(r, gx, cgx *emulated.Element[B]) frontend.Variable {
+	rr := m.fp.Mul(r, r)
+	res := m.fp.IsZero(m.fp.Sub(rr, gx))
+	m.fp.AssertIsEqual(rr, m.fp.Select(res, gx, cgx))
+	return res
+}
+
+// isogeny maps the point p on the curve E' to the target curve using the
+// isogeny with the coefficients in params.
+func (m *mapper[B, S]) isogeny(params *sswuParams, p *sw_emulated.AffinePoint[B]) *sw_emulated.AffinePoint[B] {
+	xNum := m.evalPolynomial(false, params.xNum, &p.X)
+	xDen := m.evalPolynomial(true, params.xDen, &p.X)
+	yNum := m.evalPolynomial(false, params.yNum, &p.X)
+	yDen := m.evalPolynomial(true, params.yDen, &p.X)
+
+	x := m.fp.Div(xNum, xDen)
+	y := m.fp.Div(m.fp.Mul(&p.Y, yNum), yDen)
+
+	return &sw_emulated.AffinePoint[B]{
+		X: *x,
+		Y: *y,
+	}
+}
+
+// clearCofactor returns [h]p for a constant h > 1 using double-and-add. The
+// additions use complete formulas.
+func (m *mapper[B, S]) clearCofactor(h *big.Int, p *sw_emulated.AffinePoint[B]) *sw_emulated.AffinePoint[B] {
+	res := p
+	for i := h.BitLen() - 2; i >= 0; i-- {
+		res = m.curve.Double(res)
+		if h.Bit(i) == 1 {
+			res = m.curve.AddUnified(res, p)
+		}
+	}
+	return res
+}
+
+// evalCurve returns x³ + ax + b.
+func (m *mapper[B, S]) evalCurve(a, b, x *emulated.Element[B]) *emulated.Element[B] {
+	res := m.fp.Mul(m.fp.Mul(x, x), x)
+	res = m.fp.Add(res, m.fp.Mul(a, x))
+	return m.fp.Add(res, b)
+}
+
+// evalPolynomial evaluates the polynomial with the given coefficients in
+// increasing degree order at x using the Horner's method. If monic is set,
+// then the leading coefficient 1 is omitted from coefficients.
+func (m *mapper[B, S]) evalPolynomial(monic bool, coefficients []string, x *emulated.Element[B]) *emulated.Element[B] {
+	dst := m.fp.NewElement(coefficients[len(coefficients)-1])
+	if monic {
+		dst = m.fp.Add(dst, x)
+	}
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		dst = m.fp.Mul(dst, x)
+		dst = m.fp.Add(dst, m.fp.NewElement(coefficients[i]))
+	}
+	return dst
+}
+
+// sgn0 returns the sign of x as defined in RFC 9380 Section 4.1, that is the
+// parity of x. The element x is asserted to be in canonical form.
+func (m *mapper[B, S]) sgn0(x *emulated.Element[B]) frontend.Variable {
+	var fp B
+	r := m.fp.Reduce(x)
+	m.fp.AssertIsInRange(r)
+	// the limbs of the reduced element are range checked, so the parity of the
+	// element is the parity of its least significant limb.
+	return bits.ToBinary(m.api, r.Limbs[0], bits.WithNbDigits(int(fp.BitsPerLimb())))[0]
+}
+
+// svdwConstants returns the constants of the Shallue-van de Woestijne map as
+// defined in RFC 9380 Section 6.6.1:
+//
+//	c1 = g(Z)
+//	c2 = -Z / 2
+//	c3 = sqrt(-g(Z) * (3 * Z² + 4 * A)) with sgn0(c3) = 0
+//	c4 = -4 * g(Z) / (3 * Z² + 4 * A)
+func svdwConstants(p, a, b, z *big.Int) (c1, c2, c3, c4 *big.Int) {
+	// g(Z) = Z³ + aZ + b
+	c1 = new(big.Int).Mul(z, z)
+	c1.Add(c1, a).Mul(c1, z).Add(c1, b).Mod(c1, p)
+	c2 = new(big.Int).ModInverse(big.NewInt(2), p)
+	c2.Mul(c2, z).Neg(c2).Mod(c2, p)
+	// t = 3 * Z² + 4 * A
+	t := new(big.Int).Mul(z, z)
+	t.Mul(t, big.NewInt(3))
+	t.Add(t, new(big.Int).Lsh(a, 2)).Mod(t, p)
+	c3 = new(big.Int).Mul(c1, t)
+	c3.Neg(c3).Mod(c3, p)
+	if c3.ModSqrt(c3, p) == nil {
+		panic("invalid Shallue-van de Woestijne constant")
+	}
+	if c3.Bit(0) == 1 {
+		c3.Sub(p, c3)
+	}
+	c4 = new(big.Int).ModInverse(t, p)
+	c4.Mul(c4, c1).Lsh(c4, 2).Neg(c4).Mod(c4, p)
+	return
+}
+
+// smallestNonSquare returns the smallest positive integer which is not a square
+// modulo p.
+func smallestNonSquare(p *big.Int) *big.Int {
+	c := big.NewInt(2)
+	for big.Jacobi(c, p) != -1 {
+		c.Add(c, big.NewInt(1))
+	}
+	return c
+}
+
+func bigFromString(s string) *big.Int {
+	v, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		panic("invalid constant " + s)
+	}
+	return v
+}
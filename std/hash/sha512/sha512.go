@@ -0,0 +1,76 @@
+// Package sha512 implements SHA-512 hash computation.
+//
+// This package extends the SHA-512 compression function [sha512] into a full
+// SHA-512 hash.
+package sha512
+
+import (
+	"encoding/binary"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/permutation/sha512"
+)
+
+var _seed = uints.NewU64Array([]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+})
+
+type digest struct {
+	uapi *uints.BinaryField[uints.U64]
+	in   []uints.U8
+}
+
+func New(api frontend.API) (hash.BinaryHasher, error) {
+	uapi, err := uints.New[uints.U64](api)
+	if err != nil {
+		return nil, err
+	}
+	return &digest{uapi: uapi}, nil
+}
+
+func (d *digest) Write(data []uints.U8) {
+	d.in = append(d.in, data...)
+}
+
+func (d *digest) padded(bytesLen int) []uints.U8 {
+	zeroPadLen := 111 - bytesLen%128
+	if zeroPadLen < 0 {
+		zeroPadLen += 128
+	}
+	buf := make([]uints.U8, 0, len(d.in)+17+zeroPadLen)
+	buf = append(buf, d.in...)
+	buf = append(buf, uints.NewU8(0x80))
+	buf = append(buf, uints.NewU8Array(make([]uint8, zeroPadLen))...)
+	// the message length is encoded as 128-bit big-endian integer. We do not
+	// support inputs which have more than 2^64 bits, so the upper half is
+	// always zero.
+	lenbuf := make([]uint8, 16)
+	binary.BigEndian.PutUint64(lenbuf[8:], uint64(8*bytesLen))
+	buf = append(buf, uints.NewU8Array(lenbuf)...)
+	return buf
+}
+
+func (d *digest) Sum() []uints.U8 {
+	var runningDigest [8]uints.U64
+	var buf [128]uints.U8
+	copy(runningDigest[:], _seed)
+	padded := d.padded(len(d.in))
+	for i := 0; i < len(padded)/128; i++ {
+		copy(buf[:], padded[i*128:(i+1)*128])
+		runningDigest = sha512.Permute(d.uapi, runningDigest, buf)
+	}
+	var ret []uints.U8
+	for i := range runningDigest {
+		ret = append(ret, d.uapi.UnpackMSB(runningDigest[i])...)
+	}
+	return ret
+}
+
+func (d *digest) Reset() {
+	d.in = nil
+}
+
+func (d *digest) Size() int { return 64 }
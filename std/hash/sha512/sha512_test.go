@@ -0,0 +1,55 @@
+package sha512
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type sha512Circuit struct {
+	In       []uints.U8
+	Expected [64]uints.U8
+}
+
+func (c *sha512Circuit) Define(api frontend.API) error {
+	h, err := New(api)
+	if err != nil {
+		return err
+	}
+	uapi, err := uints.New[uints.U64](api)
+	if err != nil {
+		return err
+	}
+	h.Write(c.In)
+	res := h.Sum()
+	if len(res) != 64 {
+		return fmt.Errorf("not 64 bytes")
+	}
+	for i := range c.Expected {
+		uapi.ByteAssertEq(c.Expected[i], res[i])
+	}
+	return nil
+}
+
+func TestSHA512(t *testing.T) {
+	for _, l := range []int{0, 111, 112, 310} {
+		bts := make([]byte, l)
+		for i := range bts {
+			bts[i] = byte(i)
+		}
+		dgst := sha512.Sum512(bts)
+		witness := sha512Circuit{
+			In: uints.NewU8Array(bts),
+		}
+		copy(witness.Expected[:], uints.NewU8Array(dgst[:]))
+		err := test.IsSolved(&sha512Circuit{In: make([]uints.U8, len(bts))}, &witness, ecc.BN254.ScalarField())
+		if err != nil {
+			t.Fatalf("length %d: %v", l, err)
+		}
+	}
+}
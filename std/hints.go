@@ -5,13 +5,21 @@ import (
 	"sync"
 
 	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
 	"github.com/consensys/gnark/std/algebra/native/sw_bls24315"
+	"github.com/consensys/gnark/std/bytes"
+	"github.com/consensys/gnark/std/encoding/base64"
+	"github.com/consensys/gnark/std/encoding/hex"
 	"github.com/consensys/gnark/std/evmprecompiles"
+	"github.com/consensys/gnark/std/hash/hashtocurve"
 	"github.com/consensys/gnark/std/internal/logderivarg"
 	"github.com/consensys/gnark/std/math/bits"
 	"github.com/consensys/gnark/std/math/bitslice"
 	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/fixedpoint"
+	"github.com/consensys/gnark/std/multiset"
 	"github.com/consensys/gnark/std/rangecheck"
 	"github.com/consensys/gnark/std/selector"
 )
@@ -32,6 +40,10 @@ func registerHints() {
 	solver.RegisterHint(sw_bls12377.DecomposeScalarG1)
 	solver.RegisterHint(sw_bls24315.DecomposeScalarG2)
 	solver.RegisterHint(sw_bls12377.DecomposeScalarG2)
+	solver.RegisterHint(sw_bls12377.SSWUSqrtHint)
+	solver.RegisterHint(sw_bls12381.GetHints()...)
+	solver.RegisterHint(sw_emulated.GetHints()...)
+	solver.RegisterHint(hashtocurve.GetHints()...)
 	solver.RegisterHint(bits.GetHints()...)
 	solver.RegisterHint(cmp.GetHints()...)
 	solver.RegisterHint(selector.GetHints()...)
@@ -40,4 +52,9 @@ func registerHints() {
 	solver.RegisterHint(evmprecompiles.GetHints()...)
 	solver.RegisterHint(logderivarg.GetHints()...)
 	solver.RegisterHint(bitslice.GetHints()...)
+	solver.RegisterHint(multiset.GetHints()...)
+	solver.RegisterHint(fixedpoint.GetHints()...)
+	solver.RegisterHint(bytes.GetHints()...)
+	solver.RegisterHint(base64.GetHints()...)
+	solver.RegisterHint(hex.GetHints()...)
 }
@@ -132,6 +132,78 @@ func Build(api frontend.API, table Table, queries Table) error {
 	return nil
 }
 
+// BuildPermutation builds the argument that the rows of a are a permutation
+// of the rows of b, i.e. a and b are equal as multisets. It checks
+//
+//	∑_{a∈A} 1/(x-∑_{i∈[n]}r_i*a_i) == ∑_{b∈B} 1/(x-∑_{i∈[n]}r_i*b_i).
+//
+// Differently from [Build], the tables may contain duplicate rows.
+func BuildPermutation(api frontend.API, a, b Table) error {
+	if len(a) != len(b) {
+		return fmt.Errorf("table length mismatch")
+	}
+	if len(a) == 0 {
+		return nil
+	}
+	nbRow := len(a[0])
+	var toCommit []frontend.Variable
+	for _, tbl := range []Table{a, b} {
+		for i := range tbl {
+			if len(tbl[i]) != nbRow {
+				return fmt.Errorf("row length mismatch")
+			}
+			for j := range tbl[i] {
+				if _, isConst := api.Compiler().ConstantValue(tbl[i][j]); !isConst {
+					toCommit = append(toCommit, tbl[i][j])
+				}
+			}
+		}
+	}
+	if len(toCommit) == 0 {
+		return buildConstantPermutation(api, a, b)
+	}
+	multicommit.WithCommitment(api, func(api frontend.API, commitment frontend.Variable) error {
+		rowCoeffs, challenge := randLinearCoefficients(api, nbRow, commitment)
+		var lp frontend.Variable = 0
+		for i := range a {
+			lp = api.Add(lp, api.Inverse(api.Sub(challenge, randLinearCombination(api, rowCoeffs, a[i]))))
+		}
+		var rp frontend.Variable = 0
+		for i := range b {
+			rp = api.Add(rp, api.Inverse(api.Sub(challenge, randLinearCombination(api, rowCoeffs, b[i]))))
+		}
+		api.AssertIsEqual(lp, rp)
+		return nil
+	}, toCommit...)
+	return nil
+}
+
+// buildConstantPermutation checks at compile time that the constant tables a
+// and b are equal as multisets.
+func buildConstantPermutation(api frontend.API, a, b Table) error {
+	histo := make(map[string]int)
+	key := func(row []frontend.Variable) string {
+		var k string
+		for i := range row {
+			v, _ := api.Compiler().ConstantValue(row[i])
+			k += v.String() + ","
+		}
+		return k
+	}
+	for i := range a {
+		histo[key(a[i])]++
+	}
+	for i := range b {
+		histo[key(b[i])]--
+	}
+	for _, v := range histo {
+		if v != 0 {
+			return fmt.Errorf("constant tables are not permutations")
+		}
+	}
+	return nil
+}
+
 func randLinearCoefficients(api frontend.API, nbRow int, commitment frontend.Variable) (rowCoeffs []frontend.Variable, challenge frontend.Variable) {
 	if nbRow == 1 {
 		return []frontend.Variable{1}, commitment
@@ -10,6 +10,9 @@ import (
 // It is essentially a hint to the solver, but enables storing the table entries only once.
 type BlueprintLookupHint struct {
 	EntriesCalldata []uint32
+	// Width is the number of values stored per table entry. Every query then
+	// returns Width outputs. Zero value is equivalent to one.
+	Width int
 }
 
 // ensures BlueprintLookupHint implements the BlueprintSolvable interface
@@ -31,13 +34,14 @@ var _ constraint.BlueprintSolvable = (*BlueprintLookupHint)(nil)
 // }
 
 func (b *BlueprintLookupHint) Solve(s constraint.Solver, inst constraint.Instruction) error {
+	width := b.width()
 	nbEntries := int(inst.Calldata[1])
-	entries := make([]constraint.Element, nbEntries)
+	entries := make([]constraint.Element, nbEntries*width)
 
 	// read the static entries from the blueprint
 	// TODO @gbotrel cache that.
 	offset, delta := 0, 0
-	for i := 0; i < nbEntries; i++ {
+	for i := range entries {
 		entries[i], delta = s.Read(b.EntriesCalldata[offset:])
 		offset += delta
 	}
@@ -53,15 +57,15 @@ func (b *BlueprintLookupHint) Solve(s constraint.Solver, inst constraint.Instruc
 	}
 
 	// set the outputs
-	nbOutputs := nbInputs
-
-	for i := 0; i < nbOutputs; i++ {
+	for i := 0; i < nbInputs; i++ {
 		idx, isUint64 := s.Uint64(inputs[i])
-		if !isUint64 || idx >= uint64(len(entries)) {
+		if !isUint64 || idx >= uint64(nbEntries) {
 			return fmt.Errorf("lookup query too large")
 		}
-		// we set the output wire to the value of the entry
-		s.SetValue(uint32(i+int(inst.WireOffset)), entries[idx])
+		// we set the output wires to the values of the entry
+		for j := 0; j < width; j++ {
+			s.SetValue(uint32(i*width+j+int(inst.WireOffset)), entries[int(idx)*width+j])
+		}
 	}
 	return nil
 }
@@ -76,7 +80,14 @@ func (b *BlueprintLookupHint) NbConstraints() int {
 
 // NbOutputs return the number of output wires this blueprint creates.
 func (b *BlueprintLookupHint) NbOutputs(inst constraint.Instruction) int {
-	return int(inst.Calldata[2])
+	return int(inst.Calldata[2]) * b.width()
+}
+
+func (b *BlueprintLookupHint) width() int {
+	if b.Width == 0 {
+		return 1
+	}
+	return b.Width
 }
 
 // Wires returns a function that walks the wires appearing in the blueprint.
@@ -84,7 +95,7 @@ func (b *BlueprintLookupHint) NbOutputs(inst constraint.Instruction) int {
 func (b *BlueprintLookupHint) WireWalker(inst constraint.Instruction) func(cb func(wire uint32)) {
 	return func(cb func(wire uint32)) {
 		// depend on the table UP to the number of entries at time of instruction creation.
-		nbEntries := int(inst.Calldata[1])
+		nbEntries := int(inst.Calldata[1]) * b.width()
 
 		// invoke the callback on each wire appearing in the table
 		j := 0
@@ -118,7 +129,7 @@ func (b *BlueprintLookupHint) WireWalker(inst constraint.Instruction) func(cb fu
 		}
 
 		// finally we have the outputs
-		for i := 0; i < nbInputs; i++ {
+		for i := 0; i < nbInputs*b.width(); i++ {
 			cb(uint32(i + int(inst.WireOffset)))
 		}
 	}
@@ -16,6 +16,9 @@
 //
 // The complexity of the lookups is linear in the size of the table and the
 // number of queries (O(n+m)).
+//
+// For storing several values per index, use [MultiTable] instead, which stores
+// tuples of fixed width and returns a tuple for every query.
 package logderivlookup
 
 import (
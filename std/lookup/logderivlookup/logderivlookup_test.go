@@ -63,3 +63,57 @@ func TestLookup(t *testing.T) {
 		test.WithCurves(ecc.BN254),
 		test.WithBackends(backend.GROTH16, backend.PLONK))
 }
+
+type MultiLookupCircuit struct {
+	Entries  [100][3]frontend.Variable
+	Queries  [20]frontend.Variable
+	Expected [20][3]frontend.Variable
+}
+
+func (c *MultiLookupCircuit) Define(api frontend.API) error {
+	t := NewMulti(api, 3)
+	for i := range c.Entries {
+		t.Insert(c.Entries[i][:])
+	}
+	results := t.Lookup(c.Queries[:]...)
+	if len(results) != len(c.Expected) {
+		return fmt.Errorf("length mismatch")
+	}
+	for i := range results {
+		for j := range results[i] {
+			api.AssertIsEqual(results[i][j], c.Expected[i][j])
+		}
+	}
+	return nil
+}
+
+func TestMultiLookup(t *testing.T) {
+	assert := test.NewAssert(t)
+	field := ecc.BN254.ScalarField()
+	witness := MultiLookupCircuit{}
+	bound := big.NewInt(int64(len(witness.Entries)))
+	for i := range witness.Entries {
+		for j := range witness.Entries[i] {
+			witness.Entries[i][j], _ = rand.Int(rand.Reader, field)
+		}
+	}
+	for i := range witness.Queries {
+		q, _ := rand.Int(rand.Reader, bound)
+		witness.Queries[i] = q
+		for j := range witness.Expected[i] {
+			witness.Expected[i][j] = new(big.Int).Set(witness.Entries[q.Int64()][j].(*big.Int))
+		}
+	}
+	err := test.IsSolved(&MultiLookupCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	assert.ProverSucceeded(&MultiLookupCircuit{}, &witness,
+		test.WithCurves(ecc.BN254),
+		test.WithBackends(backend.GROTH16, backend.PLONK))
+
+	// swapping the columns of a result must fail
+	witness.Expected[0][0], witness.Expected[0][1] = witness.Expected[0][1], witness.Expected[0][0]
+	assert.ProverFailed(&MultiLookupCircuit{}, &witness,
+		test.WithCurves(ecc.BN254),
+		test.WithBackends(backend.GROTH16, backend.PLONK))
+}
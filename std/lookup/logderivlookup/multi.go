@@ -0,0 +1,129 @@
+package logderivlookup
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/internal/logderivarg"
+)
+
+// MultiTable is a lookup table where every index maps to a fixed-width tuple
+// of values. The table is a matrix where the first column is the index and the
+// remaining columns are the stored values:
+//
+//	1 x_1_1 ... x_1_k
+//	2 x_2_1 ... x_2_k
+//	...
+//	n x_n_1 ... x_n_k
+//
+// The rows are compressed using a random linear combination in the
+// log-derivative argument, so that a query costs approximately as much as a
+// query into a single-column [Table].
+type MultiTable struct {
+	api   frontend.API
+	width int
+
+	entries   [][]frontend.Variable
+	immutable bool
+	results   [][]frontend.Variable
+
+	// the blueprint stores the entries of the table row-wise and returns
+	// width outputs per query.
+	bID       constraint.BlueprintID
+	blueprint BlueprintLookupHint
+}
+
+// NewMulti returns a new [*MultiTable] with tuples of width elements. It
+// additionally defers building the log-derivative argument.
+func NewMulti(api frontend.API, width int) *MultiTable {
+	if width < 1 {
+		panic("width must be positive")
+	}
+	t := &MultiTable{api: api, width: width}
+	t.blueprint.Width = width
+	api.Compiler().Defer(t.commit)
+
+	t.bID = api.Compiler().AddBlueprint(&t.blueprint)
+	return t
+}
+
+// Insert inserts the tuple vals into the lookup table and returns its index as
+// a constant. It panics if the table is already committed or if the length of
+// vals is not the width of the table.
+func (t *MultiTable) Insert(vals []frontend.Variable) (index int) {
+	if t.immutable {
+		panic("inserting into committed lookup table")
+	}
+	if len(vals) != t.width {
+		panic(fmt.Sprintf("tuple length %d does not match table width %d", len(vals), t.width))
+	}
+	entry := make([]frontend.Variable, t.width)
+	copy(entry, vals)
+	t.entries = append(t.entries, entry)
+
+	for i := range vals {
+		v := t.api.Compiler().ToCanonicalVariable(vals[i])
+		v.Compress(&t.blueprint.EntriesCalldata)
+	}
+
+	return len(t.entries) - 1
+}
+
+// Lookup lookups up tuples from the lookup table given by the indices inds. It
+// returns a tuple for every index. It panics during compile time when looking
+// up from a committed or empty table. It panics during solving time when the
+// index is out of bounds.
+func (t *MultiTable) Lookup(inds ...frontend.Variable) (vals [][]frontend.Variable) {
+	if t.immutable {
+		panic("looking up from a committed lookup table")
+	}
+	if len(inds) == 0 {
+		return nil
+	}
+	if len(t.entries) == 0 {
+		panic("looking up from empty table")
+	}
+	compiler := t.api.Compiler()
+
+	// the calldata layout is the same as for [Table]. The blueprint returns
+	// width outputs per index.
+	calldata := make([]uint32, 3, 3+len(inds)*2+2)
+	calldata[1] = uint32(len(t.entries))
+	calldata[2] = uint32(len(inds))
+	for _, in := range inds {
+		v := compiler.ToCanonicalVariable(in)
+		v.Compress(&calldata)
+	}
+	calldata[0] = uint32(len(calldata))
+
+	outputs := compiler.AddInstruction(t.bID, calldata)
+	if len(outputs) != len(inds)*t.width {
+		panic("sanity check")
+	}
+
+	vals = make([][]frontend.Variable, len(inds))
+	for i := range inds {
+		vals[i] = make([]frontend.Variable, t.width)
+		row := make([]frontend.Variable, t.width+1)
+		row[0] = inds[i]
+		for j := 0; j < t.width; j++ {
+			vals[i][j] = compiler.InternalVariable(outputs[i*t.width+j])
+			row[j+1] = vals[i][j]
+		}
+		t.results = append(t.results, row)
+	}
+	return vals
+}
+
+func (t *MultiTable) entryTable() [][]frontend.Variable {
+	tbl := make([][]frontend.Variable, len(t.entries))
+	for i := range t.entries {
+		tbl[i] = append([]frontend.Variable{i}, t.entries[i]...)
+	}
+	return tbl
+}
+
+func (t *MultiTable) commit(api frontend.API) error {
+	return logderivarg.Build(api, t.entryTable(), t.results)
+}
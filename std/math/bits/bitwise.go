@@ -0,0 +1,112 @@
+package bits
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+)
+
+// The functions in this file operate on the binary decomposition of the input
+// obtained with [ToBinary] using the options opts. The width of the input is
+// the number of digits of the decomposition, which can be set with the option
+// [WithNbDigits] and defaults to the bit length of the scalar field. The input
+// must fit into the width, otherwise the decomposition is not satisfiable.
+
+// PopCount returns the number of bits set in v.
+func PopCount(api frontend.API, v frontend.Variable, opts ...BaseConversionOption) frontend.Variable {
+	bits := ToBinary(api, v, opts...)
+	var res frontend.Variable = 0
+	for i := range bits {
+		res = api.Add(res, bits[i])
+	}
+	return res
+}
+
+// LeadingZeros returns the number of leading zero bits of v in the given width.
+// The result is the width for v = 0.
+func LeadingZeros(api frontend.API, v frontend.Variable, opts ...BaseConversionOption) frontend.Variable {
+	bits := ToBinary(api, v, opts...)
+	reversed := make([]frontend.Variable, len(bits))
+	for i := range bits {
+		reversed[i] = bits[len(bits)-1-i]
+	}
+	return countZeros(api, reversed)
+}
+
+// TrailingZeros returns the number of trailing zero bits of v in the given
+// width. The result is the width for v = 0.
+func TrailingZeros(api frontend.API, v frontend.Variable, opts ...BaseConversionOption) frontend.Variable {
+	return countZeros(api, ToBinary(api, v, opts...))
+}
+
+// Reverse returns v with the order of the bits reversed in the given width.
+func Reverse(api frontend.API, v frontend.Variable, opts ...BaseConversionOption) frontend.Variable {
+	bits := ToBinary(api, v, opts...)
+	reversed := make([]frontend.Variable, len(bits))
+	for i := range bits {
+		reversed[i] = bits[len(bits)-1-i]
+	}
+	return fromBits(api, reversed, 0)
+}
+
+// Shl returns v shifted left by s bits, truncated to the given width.
+func Shl(api frontend.API, v frontend.Variable, s int, opts ...BaseConversionOption) frontend.Variable {
+	if s < 0 {
+		panic("negative shift amount")
+	}
+	bits := ToBinary(api, v, opts...)
+	if s >= len(bits) {
+		return 0
+	}
+	return fromBits(api, bits[:len(bits)-s], s)
+}
+
+// Shr returns v shifted right by s bits.
+func Shr(api frontend.API, v frontend.Variable, s int, opts ...BaseConversionOption) frontend.Variable {
+	if s < 0 {
+		panic("negative shift amount")
+	}
+	bits := ToBinary(api, v, opts...)
+	if s >= len(bits) {
+		return 0
+	}
+	return fromBits(api, bits[s:], 0)
+}
+
+// RotL returns v rotated left by s bits in the given width. To rotate right by
+// s bits, use the shift width-s.
+func RotL(api frontend.API, v frontend.Variable, s int, opts ...BaseConversionOption) frontend.Variable {
+	bits := ToBinary(api, v, opts...)
+	n := len(bits)
+	s %= n
+	if s < 0 {
+		s += n
+	}
+	lower := fromBits(api, bits[:n-s], s)
+	if s == 0 {
+		return lower
+	}
+	return api.Add(lower, fromBits(api, bits[n-s:], 0))
+}
+
+// countZeros returns the number of zero bits before the first set bit.
+func countZeros(api frontend.API, bits []frontend.Variable) frontend.Variable {
+	// seen is the OR of the bits processed so far.
+	var seen, res frontend.Variable = 0, 0
+	for i := range bits {
+		seen = api.Sub(api.Add(seen, bits[i]), api.Mul(seen, bits[i]))
+		res = api.Add(res, api.Sub(1, seen))
+	}
+	return res
+}
+
+// fromBits returns Σ 2^(i+offset) bits[i]. The bits are not constrained.
+func fromBits(api frontend.API, bits []frontend.Variable, offset int) frontend.Variable {
+	var res frontend.Variable = 0
+	c := new(big.Int).Lsh(big.NewInt(1), uint(offset))
+	for i := range bits {
+		res = api.Add(res, api.Mul(bits[i], c))
+		c.Lsh(c, 1)
+	}
+	return res
+}
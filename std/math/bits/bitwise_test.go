@@ -0,0 +1,54 @@
+package bits_test
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/test"
+)
+
+type bitwiseCircuit struct {
+	A                     frontend.Variable
+	PopCount, Lz, Tz, Rev frontend.Variable
+	Shl, Shr, RotL, RotR  frontend.Variable
+}
+
+func (c *bitwiseCircuit) Define(api frontend.API) error {
+	w := bits.WithNbDigits(16)
+	api.AssertIsEqual(bits.PopCount(api, c.A, w), c.PopCount)
+	api.AssertIsEqual(bits.LeadingZeros(api, c.A, w), c.Lz)
+	api.AssertIsEqual(bits.TrailingZeros(api, c.A, w), c.Tz)
+	api.AssertIsEqual(bits.Reverse(api, c.A, w), c.Rev)
+	api.AssertIsEqual(bits.Shl(api, c.A, 5, w), c.Shl)
+	api.AssertIsEqual(bits.Shr(api, c.A, 5, w), c.Shr)
+	api.AssertIsEqual(bits.RotL(api, c.A, 5, w), c.RotL)
+	api.AssertIsEqual(bits.RotL(api, c.A, -5, w), c.RotR)
+	return nil
+}
+
+func TestBitwise(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.ProverSucceeded(&bitwiseCircuit{}, &bitwiseCircuit{
+		A: 0x0bd0, PopCount: 6, Lz: 4, Tz: 4, Rev: 0x0bd0,
+		Shl: 0x7a00, Shr: 0x005e, RotL: 0x7a01, RotR: 0x805e,
+	}, test.WithBackends(backend.GROTH16, backend.PLONK))
+	assert.ProverSucceeded(&bitwiseCircuit{}, &bitwiseCircuit{
+		A: 0x8001, PopCount: 2, Lz: 0, Tz: 0, Rev: 0x8001,
+		Shl: 0x0020, Shr: 0x0400, RotL: 0x0030, RotR: 0x0c00,
+	}, test.WithBackends(backend.GROTH16, backend.PLONK))
+	assert.ProverSucceeded(&bitwiseCircuit{}, &bitwiseCircuit{
+		A: 0, PopCount: 0, Lz: 16, Tz: 16, Rev: 0,
+		Shl: 0, Shr: 0, RotL: 0, RotR: 0,
+	}, test.WithBackends(backend.GROTH16, backend.PLONK))
+	assert.ProverSucceeded(&bitwiseCircuit{}, &bitwiseCircuit{
+		A: 0x1234, PopCount: 5, Lz: 3, Tz: 2, Rev: 0x2c48,
+		Shl: 0x4680, Shr: 0x0091, RotL: 0x4682, RotR: 0xa091,
+	}, test.WithBackends(backend.GROTH16, backend.PLONK))
+	// input wider than 16 bits.
+	assert.ProverFailed(&bitwiseCircuit{}, &bitwiseCircuit{
+		A: 0x10000, PopCount: 1, Lz: 16, Tz: 16, Rev: 0,
+		Shl: 0, Shr: 0, RotL: 0, RotR: 0,
+	}, test.WithBackends(backend.GROTH16, backend.PLONK))
+}
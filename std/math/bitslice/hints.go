@@ -14,6 +14,7 @@ func init() {
 func GetHints() []solver.Hint {
 	return []solver.Hint{
 		partitionHint,
+		partitionManyHint,
 	}
 }
 
@@ -32,3 +33,31 @@ func partitionHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 	outputs[0].QuoRem(inputs[1], div, outputs[1])
 	return nil
 }
+
+// partitionManyHint returns the parts of the last input split at the bit
+// positions given in the preceding inputs. The parts are in the order from
+// the least significant.
+func partitionManyHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) < 1 {
+		return fmt.Errorf("expecting at least one input")
+	}
+	if len(outputs) != len(inputs) {
+		return fmt.Errorf("expecting %d outputs", len(inputs))
+	}
+	v := new(big.Int).Set(inputs[len(inputs)-1])
+	var prev uint
+	for i := 0; i < len(inputs)-1; i++ {
+		if !inputs[i].IsUint64() {
+			return fmt.Errorf("split location must be int")
+		}
+		split := uint(inputs[i].Uint64())
+		if split < prev {
+			return fmt.Errorf("split locations must be increasing")
+		}
+		div := new(big.Int).Lsh(big.NewInt(1), split-prev)
+		v.QuoRem(v, div, outputs[i])
+		prev = split
+	}
+	outputs[len(outputs)-1].Set(v)
+	return nil
+}
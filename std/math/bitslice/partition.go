@@ -67,3 +67,96 @@ func Partition(api frontend.API, v frontend.Variable, split uint, opts ...Option
 	api.AssertIsEqual(composed, v)
 	return
 }
+
+// PartitionMany partitions v into len(splits)+1 parts splitted at the bits
+// numbered splits, which must be increasing. The parts are returned in the
+// order from the least significant and the following holds
+//
+//	v = parts[0] + 2^splits[0] * parts[1] + ... + 2^splits[k-1] * parts[k]
+//
+// where k = len(splits). The parts are computed in a single hint. The method
+// enforces that parts[i] < 2^(splits[i]-splits[i-1]) and that the last part is
+// less than 2^(nbScalar-splits[k-1]). When giving the option [WithNbDigits], we
+// instead use the bound nbDigits-splits[k-1] for the last part.
+func PartitionMany(api frontend.API, v frontend.Variable, splits []uint, opts ...Option) []frontend.Variable {
+	opt, err := parseOpts(opts...)
+	if err != nil {
+		panic(err)
+	}
+	for i := 1; i < len(splits); i++ {
+		if splits[i] < splits[i-1] {
+			panic("split locations must be increasing")
+		}
+	}
+	if len(splits) > 0 && opt.digits > 0 && splits[len(splits)-1] > uint(opt.digits) {
+		panic("split location larger than number of digits")
+	}
+	// handle constant case
+	if vc, ok := api.Compiler().ConstantValue(v); ok {
+		if opt.digits > 0 && vc.BitLen() > opt.digits {
+			panic("input larger than bound")
+		}
+		res := make([]frontend.Variable, len(splits)+1)
+		rem := new(big.Int).Set(vc)
+		var prev uint
+		for i := range splits {
+			div := new(big.Int).Lsh(big.NewInt(1), splits[i]-prev)
+			l := new(big.Int)
+			rem.QuoRem(rem, div, l)
+			res[i] = l
+			prev = splits[i]
+		}
+		res[len(splits)] = rem
+		return res
+	}
+	rh := rangecheck.New(api)
+	if len(splits) == 0 {
+		if opt.digits > 0 {
+			rh.Check(v, opt.digits)
+		}
+		return []frontend.Variable{v}
+	}
+	hintInputs := make([]frontend.Variable, 0, len(splits)+1)
+	for i := range splits {
+		hintInputs = append(hintInputs, splits[i])
+	}
+	hintInputs = append(hintInputs, v)
+	parts, err := api.Compiler().NewHint(partitionManyHint, len(splits)+1, hintInputs...)
+	if err != nil {
+		panic(err)
+	}
+
+	if opt.nocheck {
+		if opt.digits > 0 {
+			rh.Check(v, opt.digits)
+		}
+		return parts
+	}
+	upperBound := api.Compiler().FieldBitLen()
+	if opt.digits > 0 {
+		upperBound = opt.digits
+	}
+	var composed frontend.Variable = 0
+	var prev uint
+	for i := range splits {
+		checkPart(api, rh, parts[i], int(splits[i]-prev))
+		m := new(big.Int).Lsh(big.NewInt(1), prev)
+		composed = api.Add(composed, api.Mul(parts[i], m))
+		prev = splits[i]
+	}
+	checkPart(api, rh, parts[len(splits)], upperBound-int(prev))
+	m := new(big.Int).Lsh(big.NewInt(1), prev)
+	composed = api.Add(composed, api.Mul(parts[len(splits)], m))
+	api.AssertIsEqual(composed, v)
+	return parts
+}
+
+// checkPart enforces that the part has at most nbBits bits.
+func checkPart(api frontend.API, rh frontend.Rangechecker, part frontend.Variable, nbBits int) {
+	if nbBits <= 0 {
+		// empty part, we avoid range checking to zero bits.
+		api.AssertIsEqual(part, 0)
+		return
+	}
+	rh.Check(part, nbBits)
+}
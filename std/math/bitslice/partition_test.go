@@ -28,3 +28,36 @@ func TestPartition(t *testing.T) {
 	assert.ProverSucceeded(&partitionCircuit{Split: 32}, &partitionCircuit{Split: 32, ExpUpper: 0, ExpLower: 0xffff1234, In: 0xffff1234}, test.WithBackends(backend.GROTH16, backend.PLONK))
 	assert.ProverSucceeded(&partitionCircuit{Split: 4}, &partitionCircuit{Split: 4, ExpUpper: 0xffff123, ExpLower: 4, In: 0xffff1234}, test.WithBackends(backend.GROTH16, backend.PLONK))
 }
+
+type partitionManyCircuit struct {
+	Splits   []uint
+	In       frontend.Variable
+	Expected []frontend.Variable
+}
+
+func (c *partitionManyCircuit) Define(api frontend.API) error {
+	parts := PartitionMany(api, c.In, c.Splits, WithNbDigits(32))
+	for i := range parts {
+		api.AssertIsEqual(parts[i], c.Expected[i])
+	}
+	return nil
+}
+
+func TestPartitionMany(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, tc := range []struct {
+		splits   []uint
+		expected []frontend.Variable
+	}{
+		{[]uint{4, 16, 20}, []frontend.Variable{0x4, 0x123, 0xf, 0xfff}},
+		{[]uint{0, 8, 8, 32}, []frontend.Variable{0, 0x34, 0, 0xffff12, 0}},
+		{[]uint{}, []frontend.Variable{0xffff1234}},
+	} {
+		circuit := partitionManyCircuit{Splits: tc.splits, Expected: make([]frontend.Variable, len(tc.expected))}
+		witness := partitionManyCircuit{Splits: tc.splits, In: 0xffff1234, Expected: tc.expected}
+		assert.ProverSucceeded(&circuit, &witness, test.WithBackends(backend.GROTH16, backend.PLONK))
+	}
+	// input larger than the number of digits.
+	circuit := partitionManyCircuit{Splits: []uint{16}, Expected: make([]frontend.Variable, 2)}
+	assert.ProverFailed(&circuit, &partitionManyCircuit{Splits: []uint{16}, In: 0x1ffff1234, Expected: []frontend.Variable{0x1234, 0x1ffff}}, test.WithBackends(backend.GROTH16, backend.PLONK))
+}
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"github.com/consensys/gnark/constraint/solver"
 	"github.com/consensys/gnark/frontend"
-	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/rangecheck"
 	"math/big"
 )
 
@@ -22,17 +22,19 @@ func GetHints() []solver.Hint {
 // BoundedComparator provides comparison methods, with relatively low circuit
 // complexity, for signed comparison of two integers a and b, when an upper
 // bound for their absolute difference (|a - b|) is known. These methods perform
-// only one binary conversion of length: absDiffUppBitLen.
+// only one range check of length: absDiffUppBitLen. The range checks are
+// performed using [rangecheck.New].
 //
 // a and b can be any signed integers, as long as their absolute difference
 // respects the specified bound: |a - b| <= absDiffUpp. See
 // NewBoundedComparator, for more information.
 type BoundedComparator struct {
 	// absDiffUppBitLen is the assumed maximum length for the binary representation
-	// of |a - b|. Every method preforms exactly one binary decomposition of this
+	// of |a - b|. Every method preforms exactly one range check of this
 	// length.
 	absDiffUppBitLen int
 	api              frontend.API
+	rchecker         frontend.Rangechecker
 
 	// we will use value receiver for methods of this struct,
 	// since: 1) the struct is small. 2) methods should not modify any fields.
@@ -128,12 +130,13 @@ func NewBoundedComparator(api frontend.API, absDiffUpp *big.Int, allowNonDetermi
 	return &BoundedComparator{
 		absDiffUppBitLen: absDiffUpp.BitLen(),
 		api:              api,
+		rchecker:         rangecheck.New(api),
 	}
 }
 
 // assertIsNonNegative defines constraints that ensure x >= 0.
 func (bc BoundedComparator) assertIsNonNegative(x frontend.Variable) {
-	bits.ToBinary(bc.api, x, bits.WithNbDigits(bc.absDiffUppBitLen))
+	bc.rchecker.Check(x, bc.absDiffUppBitLen)
 }
 
 // AssertIsLessEq defines a set of constraints that can be satisfied only
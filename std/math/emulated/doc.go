@@ -253,6 +253,48 @@ We additionally define functions for computing inverse of an element and ratio
 of two elements. Both function compute the actual value using hint and then
 assert the correctness of the operation using multiplication.
 
+# Deferred multiplication checks
+
+When the field is initialized with the option [WithDeferredMulChecks], then the
+multiplications are not checked immediately. Instead, we compute using hint the
+reduced result r, the quotient q and carries c such that
+
+	x(X) y(X) = r(X) + q(X) p(X) + (2^w - X) c(X),
+
+where we consider the elements as polynomials with the limbs as coefficients
+and p(X) is the polynomial of the emulated modulus. Evaluating at X = 2^w gives
+that x*y = r + q*p as integers. We only enforce the bitwidths of r, q and c at
+the multiplication time and store the check.
+
+At the end of the circuit we commit to the limbs of all stored checks using
+[frontend.Committer] and check the polynomial identities at the random
+challenge derived from the commitment. As all coefficients are bounded by the
+bitwidth enforcement to be small compared to the native modulus, the identity
+over the native field implies the identity over the integers with high
+probability.
+
+For checking the result of the operations computed using hint (for example
+inverse and division), we instead use the known result r and add a multiple of
+the modulus to the left-hand side to ensure that the quotient is non-negative.
+
+# Variable modulus
+
+The field initialized using [NewVariableModulusField] takes the modulus p as an
+element instead of a constant. The type parameter only defines the limb
+decomposition and bounds the modulus, and the modulus is asserted to be at least
+2. All multiplications are checked using the deferred multiplication checks,
+where the polynomial p(X) is now given by the limbs of the runtime modulus.
+
+As we cannot add a constant multiple of the modulus, the subtraction padding is
+computed using a hint and asserted to be zero modulo p, and the equality a == b
+is checked by asserting that the remainder of a-b is zero. To keep the
+reduction possible without the constant multiple, the maximal overflow of the
+elements is smaller than for the fixed modulus.
+
+As every multiplication only costs the bitwidth enforcement and evaluation of
+the polynomials, the mode is particularly useful for PLONK-like arithmetizations
+where the linear combinations are not free.
+
 # Constant values
 
 The package currently does not explicitly differentiate between constant and
@@ -9,6 +9,7 @@ import (
 
 	"github.com/consensys/gnark-crypto/ecc"
 	"github.com/consensys/gnark/backend"
+	cs_bn254 "github.com/consensys/gnark/constraint/bn254"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/frontend/cs/scs"
@@ -237,6 +238,52 @@ func testReduceAfterAdd[T FieldParams](t *testing.T) {
 	}, testName[T]())
 }
 
+type ReduceMaxOverflowCircuit[T FieldParams] struct {
+	A        Element[T]
+	Expected Element[T]
+}
+
+func (c *ReduceMaxOverflowCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	// double A without reduction until the element has the maximal overflow.
+	a := &c.A
+	for i := uint(0); i < f.maxOverflow(); i++ {
+		a = f.add(a, a, a.overflow+1)
+	}
+	f.AssertIsEqual(a, &c.Expected)
+	f.AssertIsEqual(&c.Expected, a)
+	r := f.Reduce(a)
+	f.AssertIsEqual(r, &c.Expected)
+	return nil
+}
+
+func TestReduceMaxOverflow(t *testing.T) {
+	testReduceMaxOverflow[Goldilocks](t)
+	testReduceMaxOverflow[Secp256k1Fp](t)
+	testReduceMaxOverflow[BN254Fp](t)
+}
+
+func testReduceMaxOverflow[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		var circuit ReduceMaxOverflowCircuit[T]
+		// the circuit doubles A once per bit of the maximal overflow over the
+		// scalar field of the test curve.
+		nbDoublings := uint(testCurve.ScalarField().BitLen()-2) - fp.BitsPerLimb()
+		val, _ := rand.Int(rand.Reader, fp.Modulus())
+		expected := new(big.Int).Lsh(val, nbDoublings)
+		expected.Mod(expected, fp.Modulus())
+		wrong := new(big.Int).Add(expected, big.NewInt(1))
+		wrong.Mod(wrong, fp.Modulus())
+		assert.ProverSucceeded(&circuit, &ReduceMaxOverflowCircuit[T]{A: ValueOf[T](val), Expected: ValueOf[T](expected)}, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
+		assert.ProverFailed(&circuit, &ReduceMaxOverflowCircuit[T]{A: ValueOf[T](val), Expected: ValueOf[T](wrong)}, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
+	}, testName[T]())
+}
+
 type SubtractCircuit[T FieldParams] struct {
 	A Element[T]
 	B Element[T]
@@ -844,6 +891,47 @@ func testAssertIsInRange[T FieldParams](t *testing.T) {
 	}, testName[T]())
 }
 
+type EnforceWidthCircuit[T FieldParams] struct {
+	X Element[T]
+}
+
+func (c *EnforceWidthCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	f.AssertIsEqual(&c.X, &c.X)
+	return nil
+}
+
+func TestEnforceWidthTopLimb(t *testing.T) {
+	var fp BN254Fp
+	assert := test.NewAssert(t)
+	// the test engine does not enforce the widths of the limbs, so we solve
+	// the compiled circuits instead.
+	top := new(big.Int).Rsh(fp.Modulus(), (fp.NbLimbs()-1)*fp.BitsPerLimb())
+	topNext := new(big.Int).Add(top, big.NewInt(1))
+	assert.Equal(top.BitLen(), topNext.BitLen())
+	for _, builder := range []frontend.NewBuilder{r1cs.NewBuilder, scs.NewBuilder} {
+		ccs, err := frontend.Compile(testCurve.ScalarField(), builder, &EnforceWidthCircuit[BN254Fp]{})
+		assert.NoError(err)
+		// the most significant limb can be equal to the most significant limb
+		// of the modulus.
+		witness := EnforceWidthCircuit[BN254Fp]{X: ValueOf[BN254Fp](0)}
+		witness.X.Limbs[fp.NbLimbs()-1] = top
+		w, err := frontend.NewWitness(&witness, testCurve.ScalarField())
+		assert.NoError(err)
+		_, err = ccs.Solve(w)
+		assert.NoError(err)
+		// but not larger, even if it fits in the width of the modulus.
+		witness.X.Limbs[fp.NbLimbs()-1] = topNext
+		w, err = frontend.NewWitness(&witness, testCurve.ScalarField())
+		assert.NoError(err)
+		_, err = ccs.Solve(w)
+		assert.Error(err)
+	}
+}
+
 type IsZeroCircuit[T FieldParams] struct {
 	X, Y Element[T]
 	Zero frontend.Variable
@@ -874,6 +962,11 @@ func testIsZero[T FieldParams](t *testing.T) {
 		circuit := IsZeroCircuit[T]{}
 		assert.ProverSucceeded(&circuit, &IsZeroCircuit[T]{X: ValueOf[T](X), Y: ValueOf[T](Y), Zero: 1}, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
 		assert.ProverSucceeded(&circuit, &IsZeroCircuit[T]{X: ValueOf[T](X), Y: ValueOf[T](0), Zero: 0}, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
+		if fp.NbLimbs() > 1 {
+			// non-zero element with the lowest limb zero.
+			X := new(big.Int).Lsh(big.NewInt(1), fp.BitsPerLimb())
+			assert.ProverSucceeded(&circuit, &IsZeroCircuit[T]{X: ValueOf[T](X), Y: ValueOf[T](0), Zero: 0}, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
+		}
 	}, testName[T]())
 }
 
@@ -912,3 +1005,394 @@ func testSqrt[T FieldParams](t *testing.T) {
 		assert.ProverSucceeded(&SqrtCircuit[T]{}, &SqrtCircuit[T]{X: ValueOf[T](X), Expected: ValueOf[T](exp)}, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
 	}, testName[T]())
 }
+
+type DeferredMulCircuit[T FieldParams] struct {
+	A, B, C  Element[T]
+	Expected Element[T]
+}
+
+func (c *DeferredMulCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api, WithDeferredMulChecks())
+	if err != nil {
+		return err
+	}
+	// ((A*B + C) * (A - C) * 1/B + A) / C
+	res := f.Mul(&c.A, &c.B)
+	res = f.Add(res, &c.C)
+	res = f.Mul(res, f.Sub(&c.A, &c.C))
+	res = f.Mul(res, f.Inverse(&c.B))
+	res = f.Div(f.Add(res, &c.A), &c.C)
+	res = f.MulMod(res, f.One())
+	f.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestDeferredMul(t *testing.T) {
+	testDeferredMul[Goldilocks](t)
+	testDeferredMul[Secp256k1Fp](t)
+	testDeferredMul[BN254Fp](t)
+	testDeferredMul[BLS12377Fp](t)
+}
+
+func testDeferredMul[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		p := fp.Modulus()
+		a, _ := rand.Int(rand.Reader, p)
+		b, _ := rand.Int(rand.Reader, p)
+		c, _ := rand.Int(rand.Reader, p)
+		exp := new(big.Int).Mul(a, b)
+		exp.Add(exp, c)
+		exp.Mul(exp, new(big.Int).Sub(a, c))
+		exp.Mul(exp, new(big.Int).ModInverse(b, p))
+		exp.Add(exp, a)
+		exp.Mul(exp, new(big.Int).ModInverse(c, p))
+		exp.Mod(exp, p)
+		witness := DeferredMulCircuit[T]{A: ValueOf[T](a), B: ValueOf[T](b), C: ValueOf[T](c), Expected: ValueOf[T](exp)}
+		assert.ProverSucceeded(&DeferredMulCircuit[T]{}, &witness, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
+
+		exp.Add(exp, big.NewInt(1))
+		witness.Expected = ValueOf[T](exp)
+		assert.ProverFailed(&DeferredMulCircuit[T]{}, &witness, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
+	}, testName[T]())
+}
+
+type deferredMulCountCircuit[T FieldParams] struct {
+	A, B     Element[T]
+	deferred bool
+}
+
+func (c *deferredMulCountCircuit[T]) Define(api frontend.API) error {
+	var opts []Option
+	if c.deferred {
+		opts = append(opts, WithDeferredMulChecks())
+	}
+	f, err := NewField[T](api, opts...)
+	if err != nil {
+		return err
+	}
+	res := &c.A
+	for i := 0; i < 100; i++ {
+		res = f.MulMod(res, &c.B)
+	}
+	for i := 0; i < 100; i++ {
+		res = f.Div(f.Sub(res, &c.B), f.Add(res, &c.A))
+	}
+	return nil
+}
+
+func TestDeferredMulConstraintCount(t *testing.T) {
+	assert := test.NewAssert(t)
+	direct, err := frontend.Compile(testCurve.ScalarField(), scs.NewBuilder, &deferredMulCountCircuit[Secp256k1Fp]{})
+	assert.NoError(err)
+	deferred, err := frontend.Compile(testCurve.ScalarField(), scs.NewBuilder, &deferredMulCountCircuit[Secp256k1Fp]{deferred: true})
+	assert.NoError(err)
+	assert.Less(deferred.GetNbConstraints(), direct.GetNbConstraints())
+}
+
+type ExpCircuit[T FieldParams] struct {
+	Base     Element[T]
+	Exp      Element[T]
+	Expected Element[T]
+	constExp *big.Int
+}
+
+func (c *ExpCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	var res *Element[T]
+	if c.constExp != nil {
+		res = f.ExpConst(&c.Base, c.constExp)
+	} else {
+		res = f.Exp(&c.Base, &c.Exp)
+	}
+	f.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestExp(t *testing.T) {
+	testExp[Goldilocks](t)
+	testExp[Secp256k1Fp](t)
+	testExp[BN254Fp](t)
+}
+
+func testExp[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		base, _ := rand.Int(rand.Reader, fp.Modulus())
+		exp, _ := rand.Int(rand.Reader, fp.Modulus())
+		expected := new(big.Int).Exp(base, exp, fp.Modulus())
+		witness := ExpCircuit[T]{Base: ValueOf[T](base), Exp: ValueOf[T](exp), Expected: ValueOf[T](expected)}
+		err := test.IsSolved(&ExpCircuit[T]{}, &witness, testCurve.ScalarField())
+		assert.NoError(err)
+		witness.Expected = ValueOf[T](new(big.Int).Add(expected, big.NewInt(1)))
+		err = test.IsSolved(&ExpCircuit[T]{}, &witness, testCurve.ScalarField())
+		assert.Error(err)
+	}, testName[T]())
+	assert.Run(func(assert *test.Assert) {
+		base, _ := rand.Int(rand.Reader, fp.Modulus())
+		for _, exp := range []*big.Int{big.NewInt(0), big.NewInt(1), big.NewInt(-3), new(big.Int).Sub(fp.Modulus(), big.NewInt(2))} {
+			expected := new(big.Int).Exp(base, exp, fp.Modulus())
+			if exp.Sign() < 0 {
+				expected.Exp(base, new(big.Int).Neg(exp), fp.Modulus())
+				expected.ModInverse(expected, fp.Modulus())
+			}
+			witness := ExpCircuit[T]{Base: ValueOf[T](base), Expected: ValueOf[T](expected)}
+			err := test.IsSolved(&ExpCircuit[T]{constExp: exp}, &witness, testCurve.ScalarField())
+			assert.NoError(err)
+		}
+	}, testName[T](), "const")
+}
+
+type LegendreCircuit[T FieldParams] struct {
+	A        Element[T]
+	Expected frontend.Variable
+}
+
+func (c *LegendreCircuit[T]) Define(api frontend.API) error {
+	f, err := NewField[T](api)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(f.Legendre(&c.A), c.Expected)
+	return nil
+}
+
+func TestLegendre(t *testing.T) {
+	testLegendre[Goldilocks](t)
+	testLegendre[Secp256k1Fp](t)
+	testLegendre[BN254Fp](t)
+}
+
+func testLegendre[T FieldParams](t *testing.T) {
+	var fp T
+	assert := test.NewAssert(t)
+	assert.Run(func(assert *test.Assert) {
+		a, _ := rand.Int(rand.Reader, fp.Modulus())
+		for _, v := range []*big.Int{big.NewInt(0), a, new(big.Int).Add(a, big.NewInt(1))} {
+			witness := LegendreCircuit[T]{A: ValueOf[T](v), Expected: big.Jacobi(v, fp.Modulus())}
+			err := test.IsSolved(&LegendreCircuit[T]{}, &witness, testCurve.ScalarField())
+			assert.NoError(err)
+			witness.Expected = big.Jacobi(v, fp.Modulus()) + 1
+			err = test.IsSolved(&LegendreCircuit[T]{}, &witness, testCurve.ScalarField())
+			assert.Error(err)
+		}
+	}, testName[T]())
+}
+
+type VariableModulusCircuit[T FieldParams] struct {
+	Modulus       Element[T]
+	A, B, E       Element[T]
+	Expected      Element[T]
+	ExpectedLegen frontend.Variable
+}
+
+func (c *VariableModulusCircuit[T]) Define(api frontend.API) error {
+	f, err := NewVariableModulusField[T](api, &c.Modulus)
+	if err != nil {
+		return err
+	}
+	// (A*B + A - B)^E / B
+	res := f.Mul(&c.A, &c.B)
+	res = f.Add(res, &c.A)
+	res = f.Sub(res, &c.B)
+	res = f.Exp(res, &c.E)
+	res = f.Div(res, &c.B)
+	f.AssertIsEqual(res, &c.Expected)
+	res = f.Reduce(res)
+	f.AssertIsInRange(res)
+	api.AssertIsEqual(f.Legendre(&c.A), c.ExpectedLegen)
+	return nil
+}
+
+func TestVariableModulus(t *testing.T) {
+	assert := test.NewAssert(t)
+	var fp Secp256k1Fp
+	for _, nbBits := range []int{64, 200, fp.Modulus().BitLen() - 1} {
+		assert.Run(func(assert *test.Assert) {
+			p, err := rand.Prime(rand.Reader, nbBits)
+			assert.NoError(err)
+			a, _ := rand.Int(rand.Reader, p)
+			b, _ := rand.Int(rand.Reader, p)
+			e, _ := rand.Int(rand.Reader, fp.Modulus())
+			expected := new(big.Int).Mul(a, b)
+			expected.Add(expected, a).Sub(expected, b).Mod(expected, p)
+			expected.Exp(expected, e, p)
+			expected.Mul(expected, new(big.Int).ModInverse(b, p)).Mod(expected, p)
+			witness := VariableModulusCircuit[Secp256k1Fp]{
+				Modulus:       ValueOf[Secp256k1Fp](p),
+				A:             ValueOf[Secp256k1Fp](a),
+				B:             ValueOf[Secp256k1Fp](b),
+				E:             ValueOf[Secp256k1Fp](e),
+				Expected:      ValueOf[Secp256k1Fp](expected),
+				ExpectedLegen: big.Jacobi(a, p),
+			}
+			err = test.IsSolved(&VariableModulusCircuit[Secp256k1Fp]{}, &witness, testCurve.ScalarField())
+			assert.NoError(err)
+			witness.Expected = ValueOf[Secp256k1Fp](new(big.Int).Add(expected, big.NewInt(1)))
+			err = test.IsSolved(&VariableModulusCircuit[Secp256k1Fp]{}, &witness, testCurve.ScalarField())
+			assert.Error(err)
+			// the results are computed for p, so they do not hold for another
+			// modulus.
+			witness.Expected = ValueOf[Secp256k1Fp](expected)
+			witness.Modulus = ValueOf[Secp256k1Fp](new(big.Int).Add(p, big.NewInt(2)))
+			err = test.IsSolved(&VariableModulusCircuit[Secp256k1Fp]{}, &witness, testCurve.ScalarField())
+			assert.Error(err)
+		}, fmt.Sprintf("nbBits=%d", nbBits))
+	}
+	_, err := frontend.Compile(testCurve.ScalarField(), scs.NewBuilder, &VariableModulusCircuit[Secp256k1Fp]{})
+	assert.NoError(err)
+}
+
+func TestVariableModulusCommitted(t *testing.T) {
+	assert := test.NewAssert(t)
+	// the challenge of the deferred multiplication checks must depend on the
+	// modulus, otherwise the prover could choose it after the challenge.
+	ccs, err := frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &VariableModulusCircuit[Secp256k1Fp]{})
+	assert.NoError(err)
+	cs, ok := ccs.(*cs_bn254.R1CS)
+	assert.True(ok)
+	committed := make(map[int]bool)
+	for _, ci := range cs.CommitmentInfo {
+		for _, w := range ci.Committed {
+			committed[w] = true
+		}
+	}
+	// the wire 0 is the constant one and the modulus limbs are the first
+	// secret inputs.
+	var fp Secp256k1Fp
+	for i := 1; i <= int(fp.NbLimbs()); i++ {
+		assert.True(committed[i], "modulus limb %d not committed", i-1)
+	}
+}
+
+type VariableModulusSmallCircuit[T FieldParams] struct {
+	Modulus Element[T]
+	A       Element[T]
+}
+
+func (c *VariableModulusSmallCircuit[T]) Define(api frontend.API) error {
+	f, err := NewVariableModulusField[T](api, &c.Modulus)
+	if err != nil {
+		return err
+	}
+	f.AssertIsEqual(&c.A, &c.A)
+	return nil
+}
+
+type VariableModulusConstantCircuit[T FieldParams] struct {
+	A Element[T]
+}
+
+func (c *VariableModulusConstantCircuit[T]) Define(api frontend.API) error {
+	modulus := ValueOf[T](1)
+	f, err := NewVariableModulusField[T](api, &modulus)
+	if err != nil {
+		return err
+	}
+	f.AssertIsEqual(&c.A, &c.A)
+	return nil
+}
+
+func TestVariableModulusSmall(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, v := range []int64{0, 1, 2, 3} {
+		witness := VariableModulusSmallCircuit[Secp256k1Fp]{
+			Modulus: ValueOf[Secp256k1Fp](v),
+			A:       ValueOf[Secp256k1Fp](1),
+		}
+		err := test.IsSolved(&VariableModulusSmallCircuit[Secp256k1Fp]{}, &witness, testCurve.ScalarField())
+		if v < 2 {
+			assert.Error(err, "modulus %d", v)
+		} else {
+			assert.NoError(err, "modulus %d", v)
+		}
+	}
+	// the modulus is only small when all the higher limbs are zero.
+	witness := VariableModulusSmallCircuit[Secp256k1Fp]{
+		Modulus: ValueOf[Secp256k1Fp](new(big.Int).Lsh(big.NewInt(1), 64)),
+		A:       ValueOf[Secp256k1Fp](1),
+	}
+	err := test.IsSolved(&VariableModulusSmallCircuit[Secp256k1Fp]{}, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+	_, err = frontend.Compile(testCurve.ScalarField(), scs.NewBuilder, &VariableModulusConstantCircuit[Secp256k1Fp]{})
+	assert.Error(err)
+}
+
+type KaratsubaMulCircuit[T FieldParams] struct {
+	A, B, C   Element[T]
+	karatsuba bool
+}
+
+func (c *KaratsubaMulCircuit[T]) Define(api frontend.API) error {
+	var opts []Option
+	if c.karatsuba {
+		opts = append(opts, WithKaratsubaMul())
+	}
+	f, err := NewField[T](api, opts...)
+	if err != nil {
+		return err
+	}
+	res := f.MulMod(&c.A, &c.B)
+	f.AssertIsEqual(res, &c.C)
+	return nil
+}
+
+func TestKaratsubaMul(t *testing.T) {
+	assert := test.NewAssert(t)
+	var fp Mod1e2048
+	a, _ := rand.Int(rand.Reader, fp.Modulus())
+	b, _ := rand.Int(rand.Reader, fp.Modulus())
+	c := new(big.Int).Mul(a, b)
+	c.Mod(c, fp.Modulus())
+	witness := KaratsubaMulCircuit[Mod1e2048]{A: ValueOf[Mod1e2048](a), B: ValueOf[Mod1e2048](b), C: ValueOf[Mod1e2048](c)}
+	err := test.IsSolved(&KaratsubaMulCircuit[Mod1e2048]{karatsuba: true}, &witness, testCurve.ScalarField())
+	assert.NoError(err)
+	witness.C = ValueOf[Mod1e2048](new(big.Int).Add(c, big.NewInt(1)))
+	err = test.IsSolved(&KaratsubaMulCircuit[Mod1e2048]{karatsuba: true}, &witness, testCurve.ScalarField())
+	assert.Error(err)
+
+	direct, err := frontend.Compile(testCurve.ScalarField(), scs.NewBuilder, &KaratsubaMulCircuit[Mod1e2048]{})
+	assert.NoError(err)
+	karatsuba, err := frontend.Compile(testCurve.ScalarField(), scs.NewBuilder, &KaratsubaMulCircuit[Mod1e2048]{karatsuba: true})
+	assert.NoError(err)
+	assert.Less(karatsuba.GetNbConstraints(), direct.GetNbConstraints())
+}
+
+type Mod2e256Circuit struct {
+	A, B, C  Element[Mod2e256]
+	Expected Element[Mod2e256]
+}
+
+func (c *Mod2e256Circuit) Define(api frontend.API) error {
+	f, err := NewField[Mod2e256](api)
+	if err != nil {
+		return err
+	}
+	// A*B + C - A wraps around as 256-bit unsigned integers
+	res := f.Mul(&c.A, &c.B)
+	res = f.Add(res, &c.C)
+	res = f.Sub(res, &c.A)
+	res = f.Reduce(res)
+	f.AssertIsInRange(res)
+	f.AssertIsEqual(res, &c.Expected)
+	return nil
+}
+
+func TestMod2e256(t *testing.T) {
+	assert := test.NewAssert(t)
+	var fp Mod2e256
+	a, _ := rand.Int(rand.Reader, fp.Modulus())
+	b, _ := rand.Int(rand.Reader, fp.Modulus())
+	c, _ := rand.Int(rand.Reader, fp.Modulus())
+	expected := new(big.Int).Mul(a, b)
+	expected.Add(expected, c).Sub(expected, a).Mod(expected, fp.Modulus())
+	witness := Mod2e256Circuit{A: ValueOf[Mod2e256](a), B: ValueOf[Mod2e256](b), C: ValueOf[Mod2e256](c), Expected: ValueOf[Mod2e256](expected)}
+	assert.ProverSucceeded(&Mod2e256Circuit{}, &witness, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
+	witness.Expected = ValueOf[Mod2e256](new(big.Int).Add(expected, big.NewInt(1)))
+	assert.ProverFailed(&Mod2e256Circuit{}, &witness, test.WithCurves(testCurve), test.NoSerialization(), test.WithBackends(backend.GROTH16, backend.PLONK))
+}
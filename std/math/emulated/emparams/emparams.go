@@ -31,6 +31,12 @@ func (sixLimbPrimeField) NbLimbs() uint     { return 6 }
 func (sixLimbPrimeField) BitsPerLimb() uint { return 64 }
 func (sixLimbPrimeField) IsPrime() bool     { return true }
 
+type twelveLimbPrimeField struct{}
+
+func (twelveLimbPrimeField) NbLimbs() uint     { return 12 }
+func (twelveLimbPrimeField) BitsPerLimb() uint { return 64 }
+func (twelveLimbPrimeField) IsPrime() bool     { return true }
+
 // Goldilocks provides type parametrization for field emulation:
 //   - limbs: 1
 //   - limb width: 64 bits
@@ -116,6 +122,20 @@ type BLS12377Fp struct{ sixLimbPrimeField }
 
 func (fp BLS12377Fp) Modulus() *big.Int { return ecc.BLS12_377.BaseField() }
 
+// BLS12377Fr provides type parametrization for field emulation:
+//   - limbs: 4
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x12ab655e9a2ca55660b44d1e5c37b00159aa76fed00000010a11800000000001 (base 16)
+//	8444461749428370424248824938781546531375899335154063827935233455917409239041 (base 10)
+//
+// This is the scalar field of the BLS12-377 curve.
+type BLS12377Fr struct{ fourLimbPrimeField }
+
+func (fp BLS12377Fr) Modulus() *big.Int { return ecc.BLS12_377.ScalarField() }
+
 // BLS12381Fp provides type parametrization for field emulation:
 //   - limbs: 6
 //   - limb width: 64 bits
@@ -144,6 +164,48 @@ type BLS12381Fr struct{ fourLimbPrimeField }
 
 func (fp BLS12381Fr) Modulus() *big.Int { return ecc.BLS12_381.ScalarField() }
 
+// BW6761Fp provides type parametrization for field emulation:
+//   - limbs: 12
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x122e824fb83ce0ad187c94004faff3eb926186a81d14688528275ef8087be41707ba638e584e91903cebaff25b423048689c8ed12f9fd9071dcd3dc73ebff2e98a116c25667a8f8160cf8aeeaf0a437e6913e6870000082f49d00000000008b (base 16)
+//	6891450384315732539396789682275657542479668912536150109513790160209623422243491736087683183289411687640864567753786613451161759120554247759349511699125301598951605099378508850372543631423596795951899700429969112842764913119068299 (base 10)
+//
+// This is the base field of the BW6-761 curve.
+type BW6761Fp struct{ twelveLimbPrimeField }
+
+func (fp BW6761Fp) Modulus() *big.Int { return ecc.BW6_761.BaseField() }
+
+// BW6761Fr provides type parametrization for field emulation:
+//   - limbs: 6
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x1ae3a4617c510eac63b05c06ca1493b1a22d9f300f5138f1ef3622fba094800170b5d44300000008508c00000000001 (base 16)
+//	258664426012969094010652733694893533536393512754914660539884262666720468348340822774968888139573360124440321458177 (base 10)
+//
+// This is the scalar field of the BW6-761 curve.
+type BW6761Fr struct{ sixLimbPrimeField }
+
+func (fp BW6761Fr) Modulus() *big.Int { return ecc.BW6_761.ScalarField() }
+
+// BLS24315Fr provides type parametrization for field emulation:
+//   - limbs: 4
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x196deac24a9da12b25fc7ec9cf927a98c8c480ece644e36419d0c5fd00c00001 (base 16)
+//	11502027791375260645628074404575422495959608200132055716665986169834464870401 (base 10)
+//
+// This is the scalar field of the BLS24-315 curve.
+type BLS24315Fr struct{ fourLimbPrimeField }
+
+func (fp BLS24315Fr) Modulus() *big.Int { return ecc.BLS24_315.ScalarField() }
+
 // P256Fp provides type parametrization for field emulation:
 //   - limbs: 4
 //   - limb width: 64 bits
@@ -199,3 +261,108 @@ func (P384Fp) Modulus() *big.Int { return elliptic.P384().Params().P }
 type P384Fr struct{ sixLimbPrimeField }
 
 func (P384Fr) Modulus() *big.Int { return elliptic.P384().Params().N }
+
+// Ed25519Fp provides type parametrization for field emulation:
+//   - limbs: 4
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed (base 16)
+//	57896044618658097711785492504343953926634992332820282019728792003956564819949 (base 10)
+//
+// This is the base field of the Ed25519 (also Curve25519) curve.
+type Ed25519Fp struct{ fourLimbPrimeField }
+
+func (Ed25519Fp) Modulus() *big.Int { return ed25519Fp }
+
+// Ed25519Fr provides type parametrization for field emulation:
+//   - limbs: 4
+//   - limb width: 64 bits
+//
+// The prime modulus for type parametrisation is:
+//
+//	0x1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed (base 16)
+//	7237005577332262213973186563042994240857116359379907606001950938285454250989 (base 10)
+//
+// This is the order of the prime-order subgroup of the Ed25519 (also
+// Curve25519) curve.
+type Ed25519Fr struct{ fourLimbPrimeField }
+
+func (Ed25519Fr) Modulus() *big.Int { return ed25519Fr }
+
+var (
+	ed25519Fp, _ = new(big.Int).SetString("7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffed", 16)
+	ed25519Fr, _ = new(big.Int).SetString("1000000000000000000000000000000014def9dea2f79cd65812631a5cf5d3ed", 16)
+)
+
+// Mod1e512 provides type parametrization for emulated arithmetic:
+//   - limbs: 8
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^512-1.
+//
+// This is a non-prime modulus. It is mainly targeted for bounding the runtime
+// modulus of the field initialized using emulated.NewVariableModulusField.
+type Mod1e512 struct{}
+
+func (Mod1e512) NbLimbs() uint     { return 8 }
+func (Mod1e512) BitsPerLimb() uint { return 64 }
+func (Mod1e512) IsPrime() bool     { return false }
+func (Mod1e512) Modulus() *big.Int { return mod1e512 }
+
+// Mod1e4096 provides type parametrization for emulated arithmetic:
+//   - limbs: 64
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^4096-1.
+//
+// This is a non-prime modulus. It is mainly targeted for bounding the runtime
+// modulus of the field initialized using emulated.NewVariableModulusField.
+type Mod1e4096 struct{}
+
+func (Mod1e4096) NbLimbs() uint     { return 64 }
+func (Mod1e4096) BitsPerLimb() uint { return 64 }
+func (Mod1e4096) IsPrime() bool     { return false }
+func (Mod1e4096) Modulus() *big.Int { return mod1e4096 }
+
+var (
+	mod1e512  = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 512), big.NewInt(1))
+	mod1e4096 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 4096), big.NewInt(1))
+)
+
+// Mod2e256 provides type parametrization for emulated arithmetic:
+//   - limbs: 4
+//   - limb width: 65 bits
+//
+// The modulus for type parametrisation is 2^256.
+//
+// This is a non-prime modulus. The arithmetic wraps around as for 256-bit
+// unsigned integers, for example in the EVM. The limb width is chosen so that
+// the 257-bit modulus fits into four limbs.
+type Mod2e256 struct{}
+
+func (Mod2e256) NbLimbs() uint     { return 4 }
+func (Mod2e256) BitsPerLimb() uint { return 65 }
+func (Mod2e256) IsPrime() bool     { return false }
+func (Mod2e256) Modulus() *big.Int { return mod2e256 }
+
+// Mod1e2048 provides type parametrization for emulated arithmetic:
+//   - limbs: 32
+//   - limb width: 64 bits
+//
+// The modulus for type parametrisation is 2^2048-1.
+//
+// This is a non-prime modulus. It is mainly targeted for bounding the runtime
+// modulus of RSA-2048 using emulated.NewVariableModulusField.
+type Mod1e2048 struct{}
+
+func (Mod1e2048) NbLimbs() uint     { return 32 }
+func (Mod1e2048) BitsPerLimb() uint { return 64 }
+func (Mod1e2048) IsPrime() bool     { return false }
+func (Mod1e2048) Modulus() *big.Int { return mod1e2048 }
+
+var (
+	mod2e256  = new(big.Int).Lsh(big.NewInt(1), 256)
+	mod1e2048 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 2048), big.NewInt(1))
+)
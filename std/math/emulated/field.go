@@ -42,6 +42,64 @@ type Field[T FieldParams] struct {
 
 	constrainedLimbs map[uint64]struct{}
 	checker          frontend.Rangechecker
+
+	// deferredMulChecks indicates that the multiplications are checked at
+	// once at the end of the circuit. See [WithDeferredMulChecks].
+	deferredMulChecks bool
+	mulChecks         []mulCheck[T]
+	mulChecksClosed   bool
+
+	// karatsuba indicates that the products of the limbs are checked
+	// recursively using Karatsuba decomposition. See [WithKaratsubaMul].
+	karatsuba bool
+
+	// varModulus is the modulus given at circuit runtime. When set, it is used
+	// instead of the modulus of the type parameter. See
+	// [NewVariableModulusField].
+	varModulus *Element[T]
+	// subPaddings caches the paddings for subtraction when the modulus is
+	// variable. The key is the overflow and the number of limbs.
+	subPaddings map[[2]uint]*Element[T]
+}
+
+// Option allows to configure the [Field].
+type Option func(*fieldConfig) error
+
+type fieldConfig struct {
+	deferredMulChecks bool
+	karatsuba         bool
+}
+
+// WithDeferredMulChecks configures the [Field] to check the multiplications
+// together at the end of the circuit instead of checking every multiplication
+// individually. See the package documentation for the description of the
+// technique.
+//
+// In this mode [Field.Mul] returns a reduced element. The builder must
+// implement [frontend.Committer] as the checks use a random challenge derived
+// from the commitment to the results of the multiplications.
+func WithDeferredMulChecks() Option {
+	return func(c *fieldConfig) error {
+		c.deferredMulChecks = true
+		return nil
+	}
+}
+
+// WithKaratsubaMul configures the [Field] to check the products of the limbs
+// using Karatsuba decomposition. Instead of checking the product of k-limb
+// elements at 2k-1 points with linear combinations of k terms each, the
+// product is split recursively into three products of half-sized elements.
+//
+// The option decreases the number of constraints for arithmetizations where
+// the linear combinations are not free (PLONK) and for elements with many
+// limbs, for example for RSA moduli. For R1CS the number of constraints
+// increases. The option has no effect with [WithDeferredMulChecks] as then the
+// multiplication checks are already linear in the number of limbs.
+func WithKaratsubaMul() Option {
+	return func(c *fieldConfig) error {
+		c.karatsuba = true
+		return nil
+	}
 }
 
 // NewField returns an object to be used in-circuit to perform emulated
@@ -52,7 +110,13 @@ type Field[T FieldParams] struct {
 //
 // This is an experimental feature and performing emulated arithmetic in-circuit
 // is extremly costly. See package doc for more info.
-func NewField[T FieldParams](native frontend.API) (*Field[T], error) {
+func NewField[T FieldParams](native frontend.API, opts ...Option) (*Field[T], error) {
+	var cfg fieldConfig
+	for _, o := range opts {
+		if err := o(&cfg); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
 	f := &Field[T]{
 		api:              native,
 		log:              logger.Logger(),
@@ -89,9 +153,70 @@ func NewField[T FieldParams](native frontend.API) (*Field[T], error) {
 		return nil, fmt.Errorf("elements with limb length %d does not fit into scalar field", f.fParams.BitsPerLimb())
 	}
 
+	if cfg.deferredMulChecks {
+		if _, ok := native.Compiler().(frontend.Committer); !ok {
+			return nil, fmt.Errorf("deferred multiplication checks require the builder to implement frontend.Committer")
+		}
+		f.deferredMulChecks = true
+		native.Compiler().Defer(f.performMulChecks)
+	}
+	f.karatsuba = cfg.karatsuba
+
+	return f, nil
+}
+
+// NewVariableModulusField returns an object to be used in-circuit to perform
+// emulated arithmetic modulo the given modulus instead of the modulus defined
+// by the type parameter [FieldParams]. This allows to use a modulus which is
+// only known at proving time, for example a part of the witness.
+//
+// The type parameter defines the limb decomposition and the modulus of the
+// type parameter bounds the runtime modulus. The runtime modulus must be at
+// least 2 and at most the modulus of the type parameter. The method asserts in
+// circuit that the modulus is neither 0 nor 1 and returns an error if the
+// modulus is a constant less than 2. The circuit complexity depends on the type
+// parameter and not on the actual size of the runtime modulus.
+//
+// The multiplications are always checked using deferred multiplication checks,
+// see [WithDeferredMulChecks]. The builder must implement
+// [frontend.Committer].
+//
+// The operations relying on the primality of the modulus ([Field.Inverse],
+// [Field.Div], [Field.Sqrt] and [Field.Legendre]) assume that the runtime
+// modulus is prime if the type parameter is.
+func NewVariableModulusField[T FieldParams](native frontend.API, modulus *Element[T], opts ...Option) (*Field[T], error) {
+	if modulus == nil {
+		return nil, fmt.Errorf("missing modulus")
+	}
+	f, err := NewField[T](native, append(opts, WithDeferredMulChecks())...)
+	if err != nil {
+		return nil, err
+	}
+	if len(modulus.Limbs) != int(f.fParams.NbLimbs()) {
+		return nil, fmt.Errorf("modulus must have %d limbs, got %d", f.fParams.NbLimbs(), len(modulus.Limbs))
+	}
+	if mv, isConst := f.constantValue(modulus); isConst && mv.Cmp(big.NewInt(2)) < 0 {
+		return nil, fmt.Errorf("modulus must be at least 2, got %s", mv)
+	}
+	f.enforceWidthConditional(modulus)
+	f.assertModulusAtLeastTwo(modulus)
+	f.varModulus = modulus
+	f.subPaddings = make(map[[2]uint]*Element[T])
 	return f, nil
 }
 
+// assertModulusAtLeastTwo asserts that the runtime modulus is neither 0 nor 1,
+// i.e. that the least significant limb is not 0 or 1 or some of the higher
+// limbs are non-zero. The limbs of the modulus must be width-constrained.
+func (f *Field[T]) assertModulusAtLeastTwo(modulus *Element[T]) {
+	var hiZero frontend.Variable = 1
+	for i := 1; i < len(modulus.Limbs); i++ {
+		hiZero = f.api.Mul(hiZero, f.api.IsZero(modulus.Limbs[i]))
+	}
+	loSmall := f.api.IsZero(f.api.Mul(modulus.Limbs[0], f.api.Sub(modulus.Limbs[0], 1)))
+	f.api.AssertIsEqual(f.api.Mul(hiZero, loSmall), 0)
+}
+
 // NewElement builds a new Element[T] from input v.
 //   - if v is a Element[T] or *Element[T] it clones it
 //   - if v is a constant this is equivalent to calling emulated.ValueOf[T]
@@ -131,14 +256,28 @@ func (f *Field[T]) One() *Element[T] {
 	return f.oneConst
 }
 
-// Modulus returns the modulus of the emulated ring as a constant.
+// Modulus returns the modulus of the emulated ring as a constant. If the field
+// was initialized using [NewVariableModulusField], then returns the runtime
+// modulus instead.
 func (f *Field[T]) Modulus() *Element[T] {
+	if f.varModulus != nil {
+		return f.varModulus
+	}
 	f.nConstOnce.Do(func() {
 		f.nConst = newConstElement[T](f.fParams.Modulus())
 	})
 	return f.nConst
 }
 
+// constModulus returns the modulus of the emulated ring if it is known at
+// compile time.
+func (f *Field[T]) constModulus() (*big.Int, bool) {
+	if f.varModulus != nil {
+		return f.constantValue(f.varModulus)
+	}
+	return f.fParams.Modulus(), true
+}
+
 // modulusPrev returns modulus-1 as a constant.
 func (f *Field[T]) modulusPrev() *Element[T] {
 	f.nprevConstOnce.Do(func() {
@@ -284,6 +423,18 @@ func (f *Field[T]) compactLimbs(e *Element[T], groupSize, bitsPerLimb uint) []fr
 func (f *Field[T]) maxOverflow() uint {
 	f.maxOfOnce.Do(func() {
 		f.maxOf = uint(f.api.Compiler().FieldBitLen()-2) - f.fParams.BitsPerLimb()
+		if f.varModulus != nil {
+			// with variable modulus we cannot fall back to reducing using the
+			// constant multiple of the modulus. Ensure that we can always
+			// reduce using the deferred multiplication check by one.
+			e := &Element[T]{Limbs: make([]frontend.Variable, f.fParams.NbLimbs())}
+			for e.overflow = f.maxOf; e.overflow > 0; e.overflow-- {
+				if f.mulCheckBounds(e, f.One(), nil).coefBits+5 <= uint(f.api.Compiler().FieldBitLen()) {
+					break
+				}
+			}
+			f.maxOf = e.overflow
+		}
 	})
 	return f.maxOf
 }
@@ -301,3 +452,17 @@ func max[T constraints.Ordered](a ...T) T {
 	}
 	return m
 }
+
+func min[T constraints.Ordered](a ...T) T {
+	if len(a) == 0 {
+		var f T
+		return f
+	}
+	m := a[0]
+	for _, v := range a {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
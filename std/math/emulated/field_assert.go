@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/rangecheck"
 )
 
 // assertLimbsEqualitySlow is the main routine in the package. It asserts that the
@@ -66,8 +67,10 @@ func (f *Field[T]) AssertLimbsEquality(a, b *Element[T]) {
 	ba, aConst := f.constantValue(a)
 	bb, bConst := f.constantValue(b)
 	if aConst && bConst {
-		ba.Mod(ba, f.fParams.Modulus())
-		bb.Mod(bb, f.fParams.Modulus())
+		if p, ok := f.constModulus(); ok {
+			ba.Mod(ba, p)
+			bb.Mod(bb, p)
+		}
 		if ba.Cmp(bb) != 0 {
 			panic(fmt.Errorf("constant values are different: %s != %s", ba.String(), bb.String()))
 		}
@@ -90,9 +93,10 @@ func (f *Field[T]) AssertLimbsEquality(a, b *Element[T]) {
 }
 
 // enforceWidth enforces the width of the limbs. When modWidth is true, then the
-// limbs are asserted to be the width of the modulus (highest limb may be less
-// than full limb width). Otherwise, every limb is assumed to have same width
-// (defined by the field parameter).
+// limbs are asserted to be the width of the modulus and the most significant
+// limb is asserted to be at most the most significant limb of the modulus
+// using [rangecheck.CheckBound]. Otherwise, every limb is assumed to have same
+// width (defined by the field parameter).
 func (f *Field[T]) enforceWidth(a *Element[T], modWidth bool) {
 	if _, aConst := f.constantValue(a); aConst {
 		if len(a.Limbs) != int(f.fParams.NbLimbs()) {
@@ -104,12 +108,16 @@ func (f *Field[T]) enforceWidth(a *Element[T], modWidth bool) {
 	}
 
 	for i := range a.Limbs {
-		limbNbBits := int(f.fParams.BitsPerLimb())
 		if modWidth && i == len(a.Limbs)-1 {
-			// take only required bits from the most significant limb
-			limbNbBits = ((f.fParams.Modulus().BitLen() - 1) % int(f.fParams.BitsPerLimb())) + 1
+			// the most significant limb is at most the most significant limb
+			// of the modulus. When the bound is not a power of two, this is
+			// tighter than checking its width.
+			bound := new(big.Int).Rsh(f.fParams.Modulus(), uint(i)*f.fParams.BitsPerLimb())
+			bound.Add(bound, big.NewInt(1))
+			rangecheck.CheckBound(f.api, a.Limbs[i], bound)
+			continue
 		}
-		f.checker.Check(a.Limbs[i], limbNbBits)
+		f.checker.Check(a.Limbs[i], int(f.fParams.BitsPerLimb()))
 	}
 }
 
@@ -120,15 +128,21 @@ func (f *Field[T]) AssertIsEqual(a, b *Element[T]) {
 	ba, aConst := f.constantValue(a)
 	bb, bConst := f.constantValue(b)
 	if aConst && bConst {
-		ba.Mod(ba, f.fParams.Modulus())
-		bb.Mod(bb, f.fParams.Modulus())
-		if ba.Cmp(bb) != 0 {
-			panic(fmt.Sprintf("%s != %s", ba, bb))
+		if p, ok := f.constModulus(); ok {
+			ba.Mod(ba, p)
+			bb.Mod(bb, p)
+			if ba.Cmp(bb) != 0 {
+				panic(fmt.Sprintf("%s != %s", ba, bb))
+			}
+			return
 		}
-		return
 	}
 
-	diff := f.subNoReduce(b, a)
+	diff := f.Sub(b, a)
+	if f.varModulus != nil {
+		f.checkZero(diff)
+		return
+	}
 
 	// we compute k such that diff / p == k
 	// so essentially, we say "I know an element k such that k*p == diff"
@@ -144,6 +158,15 @@ func (f *Field[T]) AssertIsEqual(a, b *Element[T]) {
 	f.AssertLimbsEquality(diff, kp)
 }
 
+// checkZero asserts that a is zero modulo the modulus using the deferred
+// multiplication check a*1 = r + q*p and asserting that r is zero.
+func (f *Field[T]) checkZero(a *Element[T]) {
+	r := f.mulCheckDeferred(a, f.One(), nil)
+	for i := range r.Limbs {
+		f.api.AssertIsEqual(r.Limbs[i], 0)
+	}
+}
+
 // AssertIsLessOrEqual ensures that e is less or equal than a. For proper
 // bitwise comparison first reduce the element using [Reduce] and then assert
 // that its value is less than the modulus using [AssertIsInRange].
@@ -186,10 +209,30 @@ func (f *Field[T]) AssertIsLessOrEqual(e, a *Element[T]) {
 // it is not. For binary comparison the values have both to be below the
 // modulus.
 func (f *Field[T]) AssertIsInRange(a *Element[T]) {
+	if f.varModulus != nil {
+		f.assertIsInRangeVariable(a)
+		return
+	}
 	// we omit conditional width assertion as is done in ToBits down the calling stack
 	f.AssertIsLessOrEqual(a, f.modulusPrev())
 }
 
+// assertIsInRangeVariable ensures that a is less than the runtime modulus. We
+// compute d = p-1-a in a hint, enforce its width and assert that a+d+1 = p as
+// integers.
+func (f *Field[T]) assertIsInRangeVariable(a *Element[T]) {
+	f.enforceWidthConditional(a)
+	if a.overflow > 0 {
+		panic("input must have 0 overflow")
+	}
+	res, err := f.NewHint(ModulusDiffHint, 1, a)
+	if err != nil {
+		panic(fmt.Sprintf("modulus difference hint: %v", err))
+	}
+	sum := f.add(f.add(a, res[0], 1), f.One(), 2)
+	f.AssertLimbsEquality(sum, f.Modulus())
+}
+
 // IsZero returns a boolean indicating if the element is strictly zero. The
 // method internally reduces the element and asserts that the value is less than
 // the modulus.
@@ -198,7 +241,7 @@ func (f *Field[T]) IsZero(a *Element[T]) frontend.Variable {
 	f.AssertIsInRange(ca)
 	res := f.api.IsZero(ca.Limbs[0])
 	for i := 1; i < len(ca.Limbs); i++ {
-		f.api.Mul(res, f.api.IsZero(ca.Limbs[i]))
+		res = f.api.Mul(res, f.api.IsZero(ca.Limbs[i]))
 	}
 	return res
 }
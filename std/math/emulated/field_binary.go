@@ -14,7 +14,9 @@ func (f *Field[T]) ToBits(a *Element[T]) []frontend.Variable {
 	f.enforceWidthConditional(a)
 	ba, aConst := f.constantValue(a)
 	if aConst {
-		ba.Mod(ba, f.fParams.Modulus())
+		if p, ok := f.constModulus(); ok {
+			ba.Mod(ba, p)
+		}
 		res := make([]frontend.Variable, f.fParams.BitsPerLimb()*f.fParams.NbLimbs())
 		for i := range res {
 			res[i] = ba.Bit(i)
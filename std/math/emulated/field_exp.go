@@ -0,0 +1,96 @@
+package emulated
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+)
+
+// Exp computes a^e and returns it. The exponent is the integer value of e and
+// it is not reduced modulo the modulus. If e is a constant, then uses
+// [Field.ExpConst] which is cheaper.
+func (f *Field[T]) Exp(a, e *Element[T]) *Element[T] {
+	if be, ok := f.constantValue(e); ok {
+		return f.ExpConst(a, be)
+	}
+	return f.expBits(a, f.ToBits(e))
+}
+
+// ExpConst computes a^e for a constant exponent e and returns it. If e is
+// negative, then computes the inverse of a first.
+func (f *Field[T]) ExpConst(a *Element[T], e *big.Int) *Element[T] {
+	switch e.Sign() {
+	case -1:
+		return f.ExpConst(f.Inverse(a), new(big.Int).Neg(e))
+	case 0:
+		return f.One()
+	}
+	res := a
+	for i := e.BitLen() - 2; i >= 0; i-- {
+		res = f.MulMod(res, res)
+		if e.Bit(i) == 1 {
+			res = f.MulMod(res, a)
+		}
+	}
+	return res
+}
+
+// expBits computes a^e where e is given by its bits in little-endian order.
+func (f *Field[T]) expBits(a *Element[T], eBits []frontend.Variable) *Element[T] {
+	res := f.Select(eBits[0], a, f.One())
+	base := a
+	for i := 1; i < len(eBits); i++ {
+		base = f.MulMod(base, base)
+		res = f.Select(eBits[i], f.MulMod(res, base), res)
+	}
+	return res
+}
+
+// Legendre returns the Legendre symbol of a modulo the modulus. The returned
+// value is 1 if a is a non-zero quadratic residue, -1 if a is a quadratic
+// non-residue and 0 if a is zero.
+//
+// The symbol is computed using Euler's criterion a^((p-1)/2) and the modulus
+// must be an odd prime. When it is cheaper to compute the square root, then
+// [Field.Sqrt] should be used instead.
+func (f *Field[T]) Legendre(a *Element[T]) frontend.Variable {
+	if !f.fParams.IsPrime() {
+		panic("modulus not a prime")
+	}
+	p := f.Modulus()
+	var l *Element[T]
+	if bp, ok := f.constModulus(); ok {
+		if bp.Bit(0) == 0 {
+			panic("modulus not odd")
+		}
+		l = f.ExpConst(a, new(big.Int).Rsh(bp, 1))
+	} else {
+		// for odd modulus (p-1)/2 = p >> 1
+		pBits := f.ToBits(p)
+		f.api.AssertIsEqual(pBits[0], 1)
+		l = f.expBits(a, pBits[1:])
+	}
+	l = f.Reduce(l)
+	f.AssertIsInRange(l)
+	// the canonical value of the result is either 0, 1 or p-1. As the modulus
+	// is odd, then p-1 is obtained by decrementing the least significant limb.
+	isEqual := func(limbs []frontend.Variable) frontend.Variable {
+		var res frontend.Variable = 1
+		for i := range l.Limbs {
+			var c frontend.Variable = 0
+			if i < len(limbs) {
+				c = limbs[i]
+			}
+			res = f.api.Mul(res, f.api.IsZero(f.api.Sub(l.Limbs[i], c)))
+		}
+		return res
+	}
+	pPrev := make([]frontend.Variable, len(p.Limbs))
+	copy(pPrev, p.Limbs)
+	pPrev[0] = f.api.Sub(pPrev[0], 1)
+	isZero := isEqual(nil)
+	isOne := isEqual([]frontend.Variable{1})
+	isMinusOne := isEqual(pPrev)
+	f.api.AssertIsEqual(f.api.Add(isZero, isOne, isMinusOne), 1)
+	return f.api.Sub(isOne, isMinusOne)
+}
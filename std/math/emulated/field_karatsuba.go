@@ -0,0 +1,76 @@
+package emulated
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+)
+
+// karatsubaThreshold is the number of limbs below which we check the product
+// directly. For smaller inputs the additional linear combinations for
+// splitting and combining the halves outweigh the savings.
+const karatsubaThreshold = 8
+
+// mulLimbsKaratsuba returns the limbs of the product of the polynomials with
+// coefficients a and b. The limbs of a and b are at most aBits and bBits wide.
+//
+// Writing a = a0 + X^h a1 and b = b0 + X^h b1, we compute the products
+//
+//	z0 = a0 b0,
+//	z1 = (a0 + a1) (b0 + b1),
+//	z2 = a1 b1
+//
+// recursively and return z0 + X^h (z1 - z0 - z2) + X^{2h} z2. As the sums of
+// the halves are one bit wider, we stop the recursion when the coefficients of
+// z1 could overflow the native field.
+func (f *Field[T]) mulLimbsKaratsuba(a, b []frontend.Variable, aBits, bBits uint) []frontend.Variable {
+	if min(len(a), len(b)) < karatsubaThreshold {
+		return f.mulLimbs(a, b)
+	}
+	h := (max(len(a), len(b)) + 1) / 2
+	if len(a) <= h || len(b) <= h {
+		// the inputs are unbalanced, the upper half of one would be empty.
+		return f.mulLimbs(a, b)
+	}
+	if aBits+bBits+2+uint(bits.Len(uint(h))) >= uint(f.api.Compiler().FieldBitLen()) {
+		return f.mulLimbs(a, b)
+	}
+	a0, a1 := a[:h], a[h:]
+	b0, b1 := b[:h], b[h:]
+	z0 := f.mulLimbsKaratsuba(a0, b0, aBits, bBits)
+	z2 := f.mulLimbsKaratsuba(a1, b1, aBits, bBits)
+	z1 := f.mulLimbsKaratsuba(f.addLimbs(a0, a1), f.addLimbs(b0, b1), aBits+1, bBits+1)
+
+	res := make([]frontend.Variable, len(a)+len(b)-1)
+	for i := range res {
+		res[i] = 0
+	}
+	for i := range z0 {
+		res[i] = f.api.Add(res[i], z0[i])
+		res[i+h] = f.api.Sub(res[i+h], z0[i])
+	}
+	for i := range z1 {
+		res[i+h] = f.api.Add(res[i+h], z1[i])
+	}
+	for i := range z2 {
+		res[i+h] = f.api.Sub(res[i+h], z2[i])
+		res[i+2*h] = f.api.Add(res[i+2*h], z2[i])
+	}
+	return res
+}
+
+// addLimbs returns the limb-wise sum of a and b.
+func (f *Field[T]) addLimbs(a, b []frontend.Variable) []frontend.Variable {
+	res := make([]frontend.Variable, max(len(a), len(b)))
+	for i := range res {
+		switch {
+		case i < len(a) && i < len(b):
+			res[i] = f.api.Add(a[i], b[i])
+		case i < len(a):
+			res[i] = a[i]
+		default:
+			res[i] = b[i]
+		}
+	}
+	return res
+}
@@ -0,0 +1,441 @@
+package emulated
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/internal/multicommit"
+)
+
+// mulCheck represents a single deferred multiplication check
+//
+//	a * b + k = r + q * p + (2^w - X) * c
+//
+// where the elements are considered as polynomials in X with the limbs as
+// coefficients. The constant k is a multiple of p ensuring that the quotient q
+// is non-negative and the carries c are shifted by 2^carryBits to be
+// non-negative.
+type mulCheck[T FieldParams] struct {
+	a, b, r   *Element[T]
+	k         []frontend.Variable
+	q, c      []frontend.Variable
+	carryBits uint
+}
+
+// maxQuoExcessBits is the number of bits the most significant limb of the
+// quotient may exceed the limb width.
+const maxQuoExcessBits = 4
+
+// mulCheckParams are the sizes of the hint outputs of a multiplication check.
+type mulCheckParams struct {
+	k          *big.Int // multiple of the modulus added to the product
+	nbQuoLimbs int
+	nbQuoBits  int
+	nbCarries  int
+	coefBits   uint // bound on the coefficients of a*b+k-r-q*p
+	carryBits  uint
+}
+
+// maxValue returns the maximal value of an element with nbLimbs limbs of width
+// nbBits+overflow.
+func maxValue(nbBits uint, nbLimbs int, overflow uint) *big.Int {
+	one := big.NewInt(1)
+	limb := new(big.Int).Lsh(one, nbBits+overflow)
+	limb.Sub(limb, one)
+	res := new(big.Int)
+	for i := 0; i < nbLimbs; i++ {
+		res.Lsh(res, nbBits)
+		res.Add(res, limb)
+	}
+	return res
+}
+
+// mulCheckBounds computes the parameters for checking a*b = r. If r is nil,
+// then the remainder is computed in the hint and is reduced.
+func (f *Field[T]) mulCheckBounds(a, b, r *Element[T]) mulCheckParams {
+	var res mulCheckParams
+	nbBits := f.fParams.BitsPerLimb()
+	nbLimbs := int(f.fParams.NbLimbs())
+	p := f.fParams.Modulus()
+	if f.varModulus != nil {
+		// the runtime modulus may be as small as 2, bound the quotient using
+		// it. We cannot add a constant multiple of the runtime modulus, so r
+		// is never given in this case, see [Field.assertMulDeferred].
+		p = big.NewInt(2)
+	}
+	one := big.NewInt(1)
+	nbRLimbs, rOverflow := nbLimbs, uint(0)
+	res.k = new(big.Int)
+	if r != nil {
+		// when r is given, then a*b-r may be negative. We add a multiple of
+		// the modulus to keep the quotient non-negative.
+		nbRLimbs, rOverflow = len(r.Limbs), r.overflow
+		res.k.Sub(maxValue(nbBits, nbRLimbs, rOverflow), one)
+		res.k.Div(res.k, p).Add(res.k, one).Mul(res.k, p)
+	}
+	nbKLimbs := (res.k.BitLen() + int(nbBits) - 1) / int(nbBits)
+
+	maxQuo := new(big.Int).Mul(maxValue(nbBits, len(a.Limbs), a.overflow), maxValue(nbBits, len(b.Limbs), b.overflow))
+	maxQuo.Add(maxQuo, res.k).Div(maxQuo, p)
+	res.nbQuoBits = max(maxQuo.BitLen(), 1)
+	res.nbQuoLimbs = (res.nbQuoBits + int(nbBits) - 1) / int(nbBits)
+	quoTopBits := res.nbQuoBits - (res.nbQuoLimbs-1)*int(nbBits)
+	if res.nbQuoLimbs > 1 && quoTopBits <= maxQuoExcessBits {
+		// the most significant limb of the quotient would be very small. We
+		// merge it into the previous limb to save a carry.
+		res.nbQuoLimbs--
+		quoTopBits += int(nbBits)
+	}
+	nbCoefs := max(len(a.Limbs)+len(b.Limbs)-1, res.nbQuoLimbs+nbLimbs-1, nbRLimbs, nbKLimbs)
+	res.nbCarries = nbCoefs - 1
+
+	// bound the coefficients of a*b+k and r+q*p, the coefficients of the
+	// difference are bounded by the larger of them.
+	maxLimb := new(big.Int).Lsh(one, nbBits)
+	maxLimb.Sub(maxLimb, one)
+	abMax := maxValue(nbBits, 1, a.overflow)
+	abMax.Mul(abMax, maxValue(nbBits, 1, b.overflow))
+	abMax.Mul(abMax, big.NewInt(int64(min(len(a.Limbs), len(b.Limbs)))))
+	abMax.Add(abMax, maxLimb)
+	qpMax := new(big.Int).Lsh(one, uint(max(quoTopBits, int(nbBits))))
+	qpMax.Sub(qpMax, one).Mul(qpMax, maxLimb)
+	qpMax.Mul(qpMax, big.NewInt(int64(min(res.nbQuoLimbs, nbLimbs))))
+	qpMax.Add(qpMax, maxValue(nbBits, 1, rOverflow))
+	if abMax.Cmp(qpMax) < 0 {
+		abMax = qpMax
+	}
+	res.coefBits = uint(abMax.BitLen())
+	// |c_i| <= max|t_i| / (2^w - 1)
+	abMax.Div(abMax, maxLimb)
+	res.carryBits = uint(abMax.BitLen())
+	return res
+}
+
+func (f *Field[T]) mulCheckPreCond(a, b, r *Element[T]) error {
+	params := f.mulCheckBounds(a, b, r)
+	// the coefficients of the checked polynomial are bounded by
+	// 2^(coefBits+2) and must not wrap around the native modulus.
+	if params.coefBits+5 > uint(f.api.Compiler().FieldBitLen()) {
+		return overflowError{op: "mul", nextOverflow: a.overflow + b.overflow, maxOverflow: f.maxOverflow(), reduceRight: a.overflow < b.overflow}
+	}
+	return nil
+}
+
+func (f *Field[T]) mulDeferredPreCond(a, b *Element[T]) (nextOverflow uint, err error) {
+	return 0, f.mulCheckPreCond(a, b, nil)
+}
+
+// mulDeferred computes a*b reduced modulo the emulated modulus. Instead of
+// checking the result immediately, the check is stored and performed for all
+// multiplications in [Field.performMulChecks].
+func (f *Field[T]) mulDeferred(a, b *Element[T], _ uint) *Element[T] {
+	ba, aConst := f.constantValue(a)
+	bb, bConst := f.constantValue(b)
+	if aConst && bConst {
+		if p, ok := f.constModulus(); ok {
+			ba.Mul(ba, bb).Mod(ba, p)
+			return newConstElement[T](ba)
+		}
+	}
+	return f.mulCheckDeferred(a, b, nil)
+}
+
+// assertMulDeferred asserts that a*b = r modulo the emulated modulus using the
+// deferred multiplication check. The inputs are reduced if the check would
+// overflow the native field. If the modulus is variable, then the product is
+// computed and compared with r instead.
+func (f *Field[T]) assertMulDeferred(a, b, r *Element[T]) {
+	if f.varModulus != nil {
+		f.AssertIsEqual(f.Mul(a, b), r)
+		return
+	}
+	f.enforceWidthConditional(a)
+	f.enforceWidthConditional(b)
+	f.enforceWidthConditional(r)
+	var target overflowError
+	for err := f.mulCheckPreCond(a, b, r); err != nil; err = f.mulCheckPreCond(a, b, r) {
+		if !errors.As(err, &target) {
+			panic(err)
+		}
+		switch {
+		case r.overflow > 0 && r.overflow >= max(a.overflow, b.overflow):
+			r = f.Reduce(r)
+		case target.reduceRight:
+			b = f.Reduce(b)
+		default:
+			a = f.Reduce(a)
+		}
+	}
+	f.mulCheckDeferred(a, b, r)
+}
+
+// mulCheckDeferred stores the check a*b = r. If r is nil, then it is computed
+// in a hint and returned.
+func (f *Field[T]) mulCheckDeferred(a, b, r *Element[T]) *Element[T] {
+	if f.mulChecksClosed {
+		panic("multiplication after the deferred multiplication checks have been performed")
+	}
+	nbBits := f.fParams.BitsPerLimb()
+	nbLimbs := int(f.fParams.NbLimbs())
+	params := f.mulCheckBounds(a, b, r)
+
+	nbKLimbs := (params.k.BitLen() + int(nbBits) - 1) / int(nbBits)
+	kLimbs := make([]*big.Int, nbKLimbs)
+	for i := range kLimbs {
+		kLimbs[i] = new(big.Int)
+	}
+	if err := decompose(params.k, nbBits, kLimbs); err != nil {
+		panic(fmt.Sprintf("decompose multiple of modulus: %v", err))
+	}
+	k := make([]frontend.Variable, nbKLimbs)
+	for i := range k {
+		k[i] = kLimbs[i]
+	}
+	var rLimbs []frontend.Variable
+	nbOutputs := params.nbQuoLimbs + params.nbCarries
+	if r != nil {
+		rLimbs = r.Limbs
+	} else {
+		nbOutputs += nbLimbs
+	}
+
+	modulus := f.Modulus()
+	hintInputs := []frontend.Variable{
+		nbBits,
+		nbLimbs,
+		len(a.Limbs),
+		len(b.Limbs),
+		len(rLimbs),
+		len(k),
+		params.nbQuoLimbs,
+		params.nbCarries,
+		params.carryBits,
+	}
+	hintInputs = append(hintInputs, modulus.Limbs...)
+	hintInputs = append(hintInputs, k...)
+	hintInputs = append(hintInputs, a.Limbs...)
+	hintInputs = append(hintInputs, b.Limbs...)
+	hintInputs = append(hintInputs, rLimbs...)
+	res, err := f.api.NewHint(DeferredMulHint, nbOutputs, hintInputs...)
+	if err != nil {
+		panic(fmt.Sprintf("deferred multiplication hint: %v", err))
+	}
+	if r == nil {
+		r = f.packLimbs(res[:nbLimbs], true)
+		res = res[nbLimbs:]
+	}
+	q := res[:params.nbQuoLimbs]
+	for i := range q {
+		qBits := int(nbBits)
+		if i == len(q)-1 {
+			qBits = params.nbQuoBits - (len(q)-1)*int(nbBits)
+		}
+		f.checker.Check(q[i], qBits)
+	}
+	c := res[params.nbQuoLimbs:]
+	for i := range c {
+		f.checker.Check(c[i], int(params.carryBits)+1)
+	}
+	f.mulChecks = append(f.mulChecks, mulCheck[T]{a: a, b: b, r: r, k: k, q: q, c: c, carryBits: params.carryBits})
+	return r
+}
+
+// performMulChecks checks all deferred multiplications at once. It commits to
+// the limbs of all the multiplication inputs and results (and of the modulus if
+// it is variable) and checks the polynomial identities of all the
+// multiplications at the random challenge derived from the commitment.
+func (f *Field[T]) performMulChecks(api frontend.API) error {
+	f.mulChecksClosed = true
+	if len(f.mulChecks) == 0 {
+		return nil
+	}
+	var toCommit []frontend.Variable
+	maxLen := int(f.fParams.NbLimbs())
+	for _, mc := range f.mulChecks {
+		toCommit = append(toCommit, mc.a.Limbs...)
+		toCommit = append(toCommit, mc.b.Limbs...)
+		toCommit = append(toCommit, mc.r.Limbs...)
+		toCommit = append(toCommit, mc.q...)
+		toCommit = append(toCommit, mc.c...)
+		maxLen = max(maxLen, len(mc.a.Limbs), len(mc.b.Limbs), len(mc.r.Limbs), len(mc.k), len(mc.q), len(mc.c))
+	}
+	// the variable modulus is used in the checks, so the challenge must depend
+	// on it.
+	if f.varModulus != nil {
+		toCommit = append(toCommit, f.varModulus.Limbs...)
+	}
+	multicommit.WithCommitment(api, func(api frontend.API, commitment frontend.Variable) error {
+		// powers[i] = X^i and sums[i] = \sum_{j<i} X^j
+		powers := make([]frontend.Variable, maxLen)
+		sums := make([]frontend.Variable, maxLen+1)
+		powers[0], sums[0] = 1, 0
+		for i := range powers {
+			if i > 0 {
+				powers[i] = api.Mul(powers[i-1], commitment)
+			}
+			sums[i+1] = api.Add(sums[i], powers[i])
+		}
+		eval := func(limbs []frontend.Variable) frontend.Variable {
+			var res frontend.Variable = 0
+			for i := range limbs {
+				res = api.MulAcc(res, limbs[i], powers[i])
+			}
+			return res
+		}
+		// the elements may be used in several multiplications, cache their
+		// evaluations.
+		evals := make(map[*Element[T]]frontend.Variable)
+		evalElement := func(e *Element[T]) frontend.Variable {
+			if v, ok := evals[e]; ok {
+				return v
+			}
+			v := eval(e.Limbs)
+			evals[e] = v
+			return v
+		}
+		pEval := evalElement(f.Modulus())
+		coef := api.Sub(new(big.Int).Lsh(big.NewInt(1), f.fParams.BitsPerLimb()), commitment)
+		for _, mc := range f.mulChecks {
+			// the carries are shifted by 2^carryBits, remove the shift.
+			shift := new(big.Int).Lsh(big.NewInt(1), mc.carryBits)
+			cEval := api.Sub(eval(mc.c), api.Mul(shift, sums[len(mc.c)]))
+			lhs := api.Add(api.Mul(evalElement(mc.a), evalElement(mc.b)), eval(mc.k))
+			rhs := api.Add(evalElement(mc.r), api.Mul(eval(mc.q), pEval), api.Mul(coef, cEval))
+			api.AssertIsEqual(lhs, rhs)
+		}
+		return nil
+	}, toCommit...)
+	return nil
+}
+
+// DeferredMulHint computes the quotient and carries of the multiplication of
+// two elements for the deferred multiplication check. If the remainder is not
+// given, then it is also computed. See internal method mulCheckDeferred for
+// the input packing.
+//
+// The carries c are computed such that
+//
+//	a(X) * b(X) + k(X) - r(X) - q(X) * p(X) = (2^w - X) * c(X)
+//
+// and they are returned shifted by 2^carryBits to be non-negative.
+func DeferredMulHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	const nbParams = 9
+	if len(inputs) < nbParams {
+		return fmt.Errorf("input must be at least %d elements", nbParams)
+	}
+	nbBits := uint(inputs[0].Uint64())
+	nbLimbs := int(inputs[1].Int64())
+	nbALimbs := int(inputs[2].Int64())
+	nbBLimbs := int(inputs[3].Int64())
+	nbRLimbs := int(inputs[4].Int64())
+	nbKLimbs := int(inputs[5].Int64())
+	nbQuoLimbs := int(inputs[6].Int64())
+	nbCarries := int(inputs[7].Int64())
+	carryBits := uint(inputs[8].Uint64())
+	if len(inputs) != nbParams+nbLimbs+nbKLimbs+nbALimbs+nbBLimbs+nbRLimbs {
+		return fmt.Errorf("input invalid")
+	}
+	nbOutputs := nbQuoLimbs + nbCarries
+	if nbRLimbs == 0 {
+		nbOutputs += nbLimbs
+	}
+	if len(outputs) != nbOutputs {
+		return fmt.Errorf("output invalid")
+	}
+	inputs = inputs[nbParams:]
+	pLimbs, inputs := inputs[:nbLimbs], inputs[nbLimbs:]
+	kLimbs, inputs := inputs[:nbKLimbs], inputs[nbKLimbs:]
+	aLimbs, inputs := inputs[:nbALimbs], inputs[nbALimbs:]
+	bLimbs, rLimbs := inputs[:nbBLimbs], inputs[nbBLimbs:]
+	if nbRLimbs == 0 {
+		rLimbs, outputs = outputs[:nbLimbs], outputs[nbLimbs:]
+	}
+	qLimbs, cLimbs := outputs[:nbQuoLimbs], outputs[nbQuoLimbs:]
+
+	p, k, a, b, r := new(big.Int), new(big.Int), new(big.Int), new(big.Int), new(big.Int)
+	if err := recompose(pLimbs, nbBits, p); err != nil {
+		return fmt.Errorf("recompose modulus: %w", err)
+	}
+	if p.Sign() == 0 {
+		return fmt.Errorf("modulus is zero")
+	}
+	if nbKLimbs > 0 {
+		if err := recompose(kLimbs, nbBits, k); err != nil {
+			return fmt.Errorf("recompose multiple of modulus: %w", err)
+		}
+	}
+	if err := recompose(aLimbs, nbBits, a); err != nil {
+		return fmt.Errorf("recompose a: %w", err)
+	}
+	if err := recompose(bLimbs, nbBits, b); err != nil {
+		return fmt.Errorf("recompose b: %w", err)
+	}
+	q := new(big.Int).Mul(a, b)
+	q.Add(q, k)
+	if nbRLimbs == 0 {
+		q.QuoRem(q, p, r)
+		if err := decompose(r, nbBits, rLimbs); err != nil {
+			return fmt.Errorf("decompose remainder: %w", err)
+		}
+	} else {
+		if err := recompose(rLimbs, nbBits, r); err != nil {
+			return fmt.Errorf("recompose r: %w", err)
+		}
+		// if a*b != r, then the carry propagation fails below.
+		q.Sub(q, r).Quo(q, p)
+		if q.Sign() < 0 {
+			return fmt.Errorf("negative quotient")
+		}
+	}
+	// the most significant limb of the quotient may be wider than nbBits
+	if err := decompose(new(big.Int).Rsh(q, nbBits*uint(nbQuoLimbs-1)), nbBits+maxQuoExcessBits, qLimbs[nbQuoLimbs-1:]); err != nil {
+		return fmt.Errorf("decompose quotient: %w", err)
+	}
+	mask := new(big.Int).Lsh(big.NewInt(1), nbBits*uint(nbQuoLimbs-1))
+	mask.Sub(mask, big.NewInt(1))
+	if err := decompose(mask.And(mask, q), nbBits, qLimbs[:nbQuoLimbs-1]); err != nil {
+		return fmt.Errorf("decompose quotient: %w", err)
+	}
+
+	// coefficients of a(X)*b(X) + k(X) - r(X) - q(X)*p(X)
+	coefs := make([]*big.Int, nbCarries+1)
+	for i := range coefs {
+		coefs[i] = new(big.Int)
+	}
+	tmp := new(big.Int)
+	for i := range aLimbs {
+		for j := range bLimbs {
+			coefs[i+j].Add(coefs[i+j], tmp.Mul(aLimbs[i], bLimbs[j]))
+		}
+	}
+	for i := range kLimbs {
+		coefs[i].Add(coefs[i], kLimbs[i])
+	}
+	for i := range rLimbs {
+		coefs[i].Sub(coefs[i], rLimbs[i])
+	}
+	for i := range qLimbs {
+		for j := range pLimbs {
+			coefs[i+j].Sub(coefs[i+j], tmp.Mul(qLimbs[i], pLimbs[j]))
+		}
+	}
+	// propagate the carries
+	carry := new(big.Int)
+	base := new(big.Int).Lsh(big.NewInt(1), nbBits)
+	shift := new(big.Int).Lsh(big.NewInt(1), carryBits)
+	rem := new(big.Int)
+	for i := range cLimbs {
+		carry.Add(carry, coefs[i])
+		carry.QuoRem(carry, base, rem)
+		if rem.Sign() != 0 {
+			return fmt.Errorf("carry %d not divisible", i)
+		}
+		cLimbs[i].Add(carry, shift)
+	}
+	if carry.Add(carry, coefs[len(coefs)-1]).Sign() != 0 {
+		return fmt.Errorf("last carry not zero")
+	}
+	return nil
+}
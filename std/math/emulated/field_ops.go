@@ -22,6 +22,10 @@ func (f *Field[T]) Div(a, b *Element[T]) *Element[T] {
 		panic(fmt.Sprintf("compute division: %v", err))
 	}
 	e := f.packLimbs(div, true)
+	if f.deferredMulChecks {
+		f.assertMulDeferred(e, b, a)
+		return e
+	}
 	res := f.Mul(e, b)
 	f.AssertIsEqual(res, a)
 	return e
@@ -38,8 +42,12 @@ func (f *Field[T]) Inverse(a *Element[T]) *Element[T] {
 		panic(fmt.Sprintf("compute inverse: %v", err))
 	}
 	e := f.packLimbs(k, true)
-	res := f.Mul(e, a)
 	one := f.One()
+	if f.deferredMulChecks {
+		f.assertMulDeferred(e, a, one)
+		return e
+	}
+	res := f.Mul(e, a)
 	f.AssertIsEqual(res, one)
 	return e
 }
@@ -54,6 +62,10 @@ func (f *Field[T]) Sqrt(a *Element[T]) *Element[T] {
 	if err != nil {
 		panic(fmt.Sprintf("compute sqrt: %v", err))
 	}
+	if f.deferredMulChecks {
+		f.assertMulDeferred(res[0], res[0], a)
+		return res[0]
+	}
 	_a := f.Mul(res[0], res[0])
 	f.AssertIsEqual(_a, a)
 	return res[0]
@@ -79,8 +91,10 @@ func (f *Field[T]) add(a, b *Element[T], nextOverflow uint) *Element[T] {
 	ba, aConst := f.constantValue(a)
 	bb, bConst := f.constantValue(b)
 	if aConst && bConst {
-		ba.Add(ba, bb).Mod(ba, f.fParams.Modulus())
-		return newConstElement[T](ba)
+		if p, ok := f.constModulus(); ok {
+			ba.Add(ba, bb).Mod(ba, p)
+			return newConstElement[T](ba)
+		}
 	}
 
 	nbLimbs := max(len(a.Limbs), len(b.Limbs))
@@ -109,8 +123,13 @@ func (f *Field[T]) add(a, b *Element[T], nextOverflow uint) *Element[T] {
 // For multiplying by a constant, use [Field[T].MulConst] method which is more
 // efficient.
 //
-// Uses [MultiplicationHint].
+// Uses [MultiplicationHint]. If the field is configured with
+// [WithDeferredMulChecks], then the result is reduced and the multiplication is
+// checked at the end of the circuit instead. See [DeferredMulHint].
 func (f *Field[T]) Mul(a, b *Element[T]) *Element[T] {
+	if f.deferredMulChecks {
+		return f.reduceAndOp(f.mulDeferred, f.mulDeferredPreCond, a, b)
+	}
 	return f.reduceAndOp(f.mul, f.mulPreCond, a, b)
 }
 
@@ -174,13 +193,28 @@ func (f *Field[T]) mul(a, b *Element[T], nextOverflow uint) *Element[T] {
 	ba, aConst := f.constantValue(a)
 	bb, bConst := f.constantValue(b)
 	if aConst && bConst {
-		ba.Mul(ba, bb).Mod(ba, f.fParams.Modulus())
-		return newConstElement[T](ba)
+		if p, ok := f.constModulus(); ok {
+			ba.Mul(ba, bb).Mod(ba, p)
+			return newConstElement[T](ba)
+		}
 	}
 
+	var mulResult []frontend.Variable
+	if f.karatsuba {
+		mulResult = f.mulLimbsKaratsuba(a.Limbs, b.Limbs, f.fParams.BitsPerLimb()+a.overflow, f.fParams.BitsPerLimb()+b.overflow)
+	} else {
+		mulResult = f.mulLimbs(a.Limbs, b.Limbs)
+	}
+	return f.newInternalElement(mulResult, nextOverflow)
+}
+
+// mulLimbs returns the limbs of the product of the polynomials with
+// coefficients a and b. The limbs are computed in a hint and checked by
+// evaluating the polynomials at len(a)+len(b)-1 distinct points.
+func (f *Field[T]) mulLimbs(a, b []frontend.Variable) []frontend.Variable {
 	// mulResult contains the result (out of circuit) of a * b school book multiplication
 	// len(mulResult) == len(a) + len(b) - 1
-	mulResult, err := f.computeMultiplicationHint(a.Limbs, b.Limbs)
+	mulResult, err := f.computeMultiplicationHint(a, b)
 	if err != nil {
 		panic(fmt.Sprintf("multiplication hint: %s", err))
 	}
@@ -193,23 +227,23 @@ func (f *Field[T]) mul(a, b *Element[T], nextOverflow uint) *Element[T] {
 	w := new(big.Int)
 	for c := 1; c <= len(mulResult); c++ {
 		w.SetInt64(1) // c^i
-		l := f.api.Mul(a.Limbs[0], 1)
-		r := f.api.Mul(b.Limbs[0], 1)
+		l := f.api.Mul(a[0], 1)
+		r := f.api.Mul(b[0], 1)
 		o := f.api.Mul(mulResult[0], 1)
 
 		for i := 1; i < len(mulResult); i++ {
 			w.Lsh(w, uint(c))
-			if i < len(a.Limbs) {
-				l = f.api.MulAcc(l, a.Limbs[i], w)
+			if i < len(a) {
+				l = f.api.MulAcc(l, a[i], w)
 			}
-			if i < len(b.Limbs) {
-				r = f.api.MulAcc(r, b.Limbs[i], w)
+			if i < len(b) {
+				r = f.api.MulAcc(r, b[i], w)
 			}
 			o = f.api.MulAcc(o, mulResult[i], w)
 		}
 		f.api.AssertIsEqual(f.api.Mul(l, r), o)
 	}
-	return f.newInternalElement(mulResult, nextOverflow)
+	return mulResult
 }
 
 // Reduce reduces a modulo the field order and returns it. Uses hint [RemHint].
@@ -224,12 +258,29 @@ func (f *Field[T]) Reduce(a *Element[T]) *Element[T] {
 		panic("trying to reduce a constant, which happen to have an overflow flag set")
 	}
 
+	if f.deferredMulChecks && f.mulCheckPreCond(a, f.One(), nil) == nil {
+		return f.mulCheckDeferred(a, f.One(), nil)
+	}
+	if f.varModulus != nil {
+		// the overflow is bounded so that this doesn't happen, see
+		// [Field.maxOverflow].
+		panic("cannot reduce element with variable modulus")
+	}
 	// slow path - use hint to reduce value
 	e, err := f.computeRemHint(a, f.Modulus())
 	if err != nil {
 		panic(fmt.Sprintf("reduction hint: %v", err))
 	}
-	f.AssertIsEqual(e, a)
+	// we cannot use AssertIsEqual here as the difference a-e does not fit into
+	// Element when a already has maximal overflow and we would have to reduce a
+	// again. Instead we assert directly that a == e + k*p.
+	k, err := f.computeQuoHint(a)
+	if err != nil {
+		panic(fmt.Sprintf("hint error: %v", err))
+	}
+	kp := f.reduceAndOp(f.mul, f.mulPreCond, k, f.Modulus())
+	ekp := f.add(e, kp, max(e.overflow, kp.overflow)+1)
+	f.AssertLimbsEquality(a, ekp)
 	return e
 }
 
@@ -239,17 +290,6 @@ func (f *Field[T]) Sub(a, b *Element[T]) *Element[T] {
 	return f.reduceAndOp(f.sub, f.subPreCond, a, b)
 }
 
-// subReduce returns a-b and returns it. Contrary to [Field[T].Sub] method this
-// method does not reduce the inputs if the result would overflow. This method
-// is currently only used as a subroutine in [Field[T].Reduce] method to avoid
-// infinite recursion when we are working exactly on the overflow limits.
-func (f *Field[T]) subNoReduce(a, b *Element[T]) *Element[T] {
-	nextOverflow, _ := f.subPreCond(a, b)
-	// we ignore error as it only indicates if we should reduce or not. But we
-	// are in non-reducing version of sub.
-	return f.sub(a, b, nextOverflow)
-}
-
 func (f *Field[T]) subPreCond(a, b *Element[T]) (nextOverflow uint, err error) {
 	reduceRight := a.overflow < (b.overflow + 1)
 	nextOverflow = max(b.overflow+1, a.overflow) + 1
@@ -263,15 +303,24 @@ func (f *Field[T]) sub(a, b *Element[T], nextOverflow uint) *Element[T] {
 	ba, aConst := f.constantValue(a)
 	bb, bConst := f.constantValue(b)
 	if aConst && bConst {
-		ba.Sub(ba, bb).Mod(ba, f.fParams.Modulus())
-		return newConstElement[T](ba)
+		if p, ok := f.constModulus(); ok {
+			ba.Sub(ba, bb).Mod(ba, p)
+			return newConstElement[T](ba)
+		}
 	}
 
 	// first we have to compute padding to ensure that the subtraction does not
 	// underflow.
 	nbLimbs := max(len(a.Limbs), len(b.Limbs))
-	limbs := make([]frontend.Variable, nbLimbs)
-	padLimbs := subPadding[T](b.overflow, uint(nbLimbs))
+	var padLimbs []frontend.Variable
+	if f.varModulus != nil {
+		padLimbs = f.subPaddingVariable(b.overflow, uint(nbLimbs)).Limbs
+	} else {
+		for _, l := range subPadding[T](b.overflow, uint(nbLimbs)) {
+			padLimbs = append(padLimbs, l)
+		}
+	}
+	limbs := make([]frontend.Variable, len(padLimbs))
 	for i := range limbs {
 		limbs[i] = padLimbs[i]
 		if i < len(a.Limbs) {
@@ -284,6 +333,31 @@ func (f *Field[T]) sub(a, b *Element[T], nextOverflow uint) *Element[T] {
 	return f.newInternalElement(limbs, nextOverflow)
 }
 
+// subPaddingVariable returns the padding for subtraction when the modulus is
+// variable. As in [subPadding], every limb of the padding is at least
+// 2^(nbBits+overflow), but the multiple of the modulus is computed in a hint
+// and checked to be zero modulo the runtime modulus.
+func (f *Field[T]) subPaddingVariable(overflow uint, nbLimbs uint) *Element[T] {
+	key := [2]uint{overflow, nbLimbs}
+	if pad, ok := f.subPaddings[key]; ok {
+		return pad
+	}
+	nbLimbs = max(nbLimbs, f.fParams.NbLimbs())
+	nLimbs := make([]frontend.Variable, nbLimbs)
+	for i := range nLimbs {
+		nLimbs[i] = new(big.Int).Lsh(big.NewInt(1), overflow+f.fParams.BitsPerLimb())
+	}
+	n := f.newInternalElement(nLimbs, overflow+1)
+	res, err := f.NewHint(SubPaddingHint, 1, n)
+	if err != nil {
+		panic(fmt.Sprintf("sub padding hint: %v", err))
+	}
+	pad := f.add(n, res[0], overflow+1)
+	f.checkZero(pad)
+	f.subPaddings[key] = pad
+	return pad
+}
+
 func (f *Field[T]) Neg(a *Element[T]) *Element[T] {
 	return f.Sub(f.Zero(), a)
 }
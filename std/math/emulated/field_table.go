@@ -0,0 +1,57 @@
+package emulated
+
+import (
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/lookup/logderivlookup"
+)
+
+// Table is a lookup table of emulated elements. It allows to query the same
+// set of elements many times with a cost which does not depend on the number
+// of entries. The queries are performed limb-wise using the log-derivative
+// lookup argument, see [logderivlookup].
+type Table[T FieldParams] struct {
+	f        *Field[T]
+	limbs    []*logderivlookup.Table
+	overflow uint
+}
+
+// NewTable returns a new lookup table containing the given entries. The
+// entries are indexed in the order they are given, starting from 0.
+func (f *Field[T]) NewTable(entries []*Element[T]) *Table[T] {
+	var nbLimbs int
+	var overflow uint
+	for i := range entries {
+		f.enforceWidthConditional(entries[i])
+		nbLimbs = max(nbLimbs, len(entries[i].Limbs))
+		overflow = max(overflow, entries[i].overflow)
+	}
+	// we use a separate table for every limb position. Compared to a single
+	// table with flattened limbs, this ensures that all the limbs of a queried
+	// element come from the same entry.
+	limbs := make([]*logderivlookup.Table, nbLimbs)
+	for i := range limbs {
+		limbs[i] = logderivlookup.New(f.api)
+		for j := range entries {
+			if i < len(entries[j].Limbs) {
+				limbs[i].Insert(entries[j].Limbs[i])
+			} else {
+				limbs[i].Insert(0)
+			}
+		}
+	}
+	return &Table[T]{
+		f:        f,
+		limbs:    limbs,
+		overflow: overflow,
+	}
+}
+
+// Lookup returns the entry at index ind. The lookup fails if ind is not a
+// valid index in the table.
+func (t *Table[T]) Lookup(ind frontend.Variable) *Element[T] {
+	res := make([]frontend.Variable, len(t.limbs))
+	for i := range t.limbs {
+		res[i] = t.limbs[i].Lookup(ind)[0]
+	}
+	return t.f.newInternalElement(res, t.overflow)
+}
@@ -25,6 +25,9 @@ func GetHints() []solver.Hint {
 		RemHint,
 		RightShift,
 		SqrtHint,
+		DeferredMulHint,
+		SubPaddingHint,
+		ModulusDiffHint,
 	}
 }
 
@@ -323,3 +326,39 @@ func SqrtHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 		return nil
 	})
 }
+
+// SubPaddingHint computes the value which added to the input gives a multiple
+// of the modulus. It is used for computing the subtraction padding when the
+// modulus is only known at runtime.
+func SubPaddingHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	return UnwrapHint(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 1 {
+			return fmt.Errorf("expecting single input")
+		}
+		if len(outputs) != 1 {
+			return fmt.Errorf("expecting single output")
+		}
+		outputs[0].Neg(inputs[0])
+		return nil
+	})
+}
+
+// ModulusDiffHint computes p-1-x for the input x. It is used for asserting that
+// the input is less than the modulus when the modulus is only known at
+// runtime.
+func ModulusDiffHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	return UnwrapHint(inputs, outputs, func(field *big.Int, inputs, outputs []*big.Int) error {
+		if len(inputs) != 1 {
+			return fmt.Errorf("expecting single input")
+		}
+		if len(outputs) != 1 {
+			return fmt.Errorf("expecting single output")
+		}
+		if inputs[0].Cmp(field) >= 0 {
+			return fmt.Errorf("input not less than modulus")
+		}
+		outputs[0].Sub(field, inputs[0])
+		outputs[0].Sub(outputs[0], big.NewInt(1))
+		return nil
+	})
+}
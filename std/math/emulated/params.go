@@ -13,10 +13,15 @@ import (
 //   - [Goldilocks]
 //   - [Secp256k1Fp] and [Secp256k1Fr]
 //   - [BN254Fp] and [BN254Fr]
-//   - [BLS12377Fp]
+//   - [BLS12377Fp] and [BLS12377Fr]
 //   - [BLS12381Fp] and [BLS12381Fr]
+//   - [BW6761Fp] and [BW6761Fr]
+//   - [BLS24315Fr]
 //   - [P256Fp] and [P256Fr]
 //   - [P384Fp] and [P384Fr]
+//   - [Ed25519Fp] and [Ed25519Fr]
+//   - [Mod1e512], [Mod1e2048] and [Mod1e4096]
+//   - [Mod2e256]
 type FieldParams interface {
 	NbLimbs() uint     // number of limbs to represent field element
 	BitsPerLimb() uint // number of bits per limb. Top limb may contain less than limbSize bits.
@@ -31,10 +36,20 @@ type (
 	BN254Fp     = emparams.BN254Fp
 	BN254Fr     = emparams.BN254Fr
 	BLS12377Fp  = emparams.BLS12377Fp
+	BLS12377Fr  = emparams.BLS12377Fr
 	BLS12381Fp  = emparams.BLS12381Fp
 	BLS12381Fr  = emparams.BLS12381Fr
+	BW6761Fp    = emparams.BW6761Fp
+	BW6761Fr    = emparams.BW6761Fr
+	BLS24315Fr  = emparams.BLS24315Fr
 	P256Fp      = emparams.P256Fp
 	P256Fr      = emparams.P256Fr
 	P384Fp      = emparams.P384Fp
 	P384Fr      = emparams.P384Fr
+	Ed25519Fp   = emparams.Ed25519Fp
+	Ed25519Fr   = emparams.Ed25519Fr
+	Mod1e512    = emparams.Mod1e512
+	Mod1e2048   = emparams.Mod1e2048
+	Mod1e4096   = emparams.Mod1e4096
+	Mod2e256    = emparams.Mod2e256
 )
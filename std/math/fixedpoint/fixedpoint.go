@@ -0,0 +1,291 @@
+// Package fixedpoint implements signed fixed-point and signed integer
+// arithmetic.
+//
+// A number x with nbFracBits fractional bits is represented by the signed
+// integer X = x*2^nbFracBits, which is stored in the native field as X mod p.
+// Thus negative values are stored as p-|X|. All the values are asserted to be
+// in the signed range [-2^(nbBits-1), 2^(nbBits-1)) and the operations assert
+// that the result doesn't overflow. With nbFracBits = 0 the package implements
+// signed integer arithmetic.
+//
+// The range of a value is checked by computing its sign bit s in a hint and
+// range checking X when s = 0 and -X-1 when s = 1 to be less than
+// 2^(nbBits-1). The range checks use [rangecheck.New], so the cost of the
+// operations depends on the capabilities of the builder.
+//
+// For converting between the stored representation and two's complement
+// representation (the integer X mod 2^nbBits), see [API.ToTwosComplement] and
+// [API.FromTwosComplement].
+package fixedpoint
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hints used in this package
+func GetHints() []solver.Hint {
+	return []solver.Hint{signHint, divHint}
+}
+
+// API performs fixed-point arithmetic with the given bit width and number of
+// fractional bits.
+type API struct {
+	api        frontend.API
+	rchecker   frontend.Rangechecker
+	nbBits     int
+	nbFracBits int
+}
+
+// New returns a new [API] for fixed-point numbers of nbBits bits in total, of
+// which nbFracBits bits are fractional. It returns an error if the products of
+// the numbers do not fit into the native field.
+func New(api frontend.API, nbBits, nbFracBits int) (*API, error) {
+	if nbBits < 2 {
+		return nil, fmt.Errorf("bit width must be at least 2")
+	}
+	if nbFracBits < 0 || nbFracBits >= nbBits {
+		return nil, fmt.Errorf("number of fractional bits must be in [0, %d)", nbBits)
+	}
+	if 2*nbBits+2 >= api.Compiler().FieldBitLen() {
+		return nil, fmt.Errorf("bit width %d too large for native field", nbBits)
+	}
+	return &API{
+		api:        api,
+		rchecker:   rangecheck.New(api),
+		nbBits:     nbBits,
+		nbFracBits: nbFracBits,
+	}, nil
+}
+
+// NewInt returns a new [API] for signed integers of nbBits bits. It is
+// equivalent to [New] with zero fractional bits.
+func NewInt(api frontend.API, nbBits int) (*API, error) {
+	return New(api, nbBits, 0)
+}
+
+// FromFloat returns the representation of x with nbFracBits fractional bits,
+// rounded to the nearest representable value. The result can be used for
+// witness assignment and as a constant in circuit.
+func FromFloat(x float64, nbFracBits int) *big.Int {
+	v, _ := new(big.Float).SetFloat64(math.Round(math.Ldexp(x, nbFracBits))).Int(nil)
+	return v
+}
+
+// ToFloat returns the fixed-point number with nbFracBits fractional bits
+// represented by the signed integer v.
+func ToFloat(v *big.Int, nbFracBits int) float64 {
+	f, _ := new(big.Float).SetInt(v).Float64()
+	return math.Ldexp(f, -nbFracBits)
+}
+
+// AssertIsInRange asserts that a is in the signed range of the API.
+func (f *API) AssertIsInRange(a frontend.Variable) {
+	f.sign(a, f.nbBits)
+}
+
+// Add returns a+b. It asserts that the result doesn't overflow.
+func (f *API) Add(a, b frontend.Variable) frontend.Variable {
+	res := f.api.Add(a, b)
+	f.sign(res, f.nbBits)
+	return res
+}
+
+// Sub returns a-b. It asserts that the result doesn't overflow.
+func (f *API) Sub(a, b frontend.Variable) frontend.Variable {
+	res := f.api.Sub(a, b)
+	f.sign(res, f.nbBits)
+	return res
+}
+
+// Neg returns -a. It asserts that the result doesn't overflow, i.e. a is not
+// the smallest value of the range.
+func (f *API) Neg(a frontend.Variable) frontend.Variable {
+	res := f.api.Neg(a)
+	f.sign(res, f.nbBits)
+	return res
+}
+
+// Mul returns a*b rounded to the nearest representable value, where the ties
+// are rounded up. It asserts that the result doesn't overflow.
+func (f *API) Mul(a, b frontend.Variable) frontend.Variable {
+	prod := f.api.Mul(a, b)
+	if f.nbFracBits == 0 {
+		f.sign(prod, f.nbBits)
+		return prod
+	}
+	// prod + 2^(nbFracBits-1) = q * 2^nbFracBits + r, where 0 <= r < 2^nbFracBits
+	num := f.api.Add(prod, new(big.Int).Lsh(big.NewInt(1), uint(f.nbFracBits-1)))
+	scale := new(big.Int).Lsh(big.NewInt(1), uint(f.nbFracBits))
+	q, r := f.div(num, scale)
+	f.sign(q, f.nbBits)
+	f.rchecker.Check(r, f.nbFracBits)
+	f.api.AssertIsEqual(num, f.api.Add(f.api.Mul(q, scale), r))
+	return q
+}
+
+// Div returns a/b. The result is rounded towards negative infinity if b is
+// positive and towards positive infinity if b is negative, i.e. the remainder
+// of the division is always non-negative. It asserts that b is non-zero and
+// that the result doesn't overflow.
+func (f *API) Div(a, b frontend.Variable) frontend.Variable {
+	// a * 2^nbFracBits = q * b + r, where 0 <= r < |b|
+	num := f.api.Mul(a, new(big.Int).Lsh(big.NewInt(1), uint(f.nbFracBits)))
+	q, r := f.div(num, b)
+	f.sign(q, f.nbBits)
+	f.rchecker.Check(r, f.nbBits)
+	f.rchecker.Check(f.api.Sub(f.abs(b), r, 1), f.nbBits)
+	f.api.AssertIsEqual(num, f.api.Add(f.api.Mul(q, b), r))
+	return q
+}
+
+// IsNeg returns 1 if a is negative and 0 otherwise.
+func (f *API) IsNeg(a frontend.Variable) frontend.Variable {
+	return f.sign(a, f.nbBits)
+}
+
+// IsLess returns 1 if a < b and 0 otherwise.
+func (f *API) IsLess(a, b frontend.Variable) frontend.Variable {
+	// the difference is in the range of nbBits+1 bits.
+	return f.sign(f.api.Sub(a, b), f.nbBits+1)
+}
+
+// Cmp returns 1 if a > b, 0 if a = b and -1 if a < b.
+func (f *API) Cmp(a, b frontend.Variable) frontend.Variable {
+	return f.api.Sub(f.IsLess(b, a), f.IsLess(a, b))
+}
+
+// Abs returns the absolute value of a. It asserts that the result doesn't
+// overflow, i.e. a is not the smallest value of the range.
+func (f *API) Abs(a frontend.Variable) frontend.Variable {
+	res := f.abs(a)
+	f.sign(res, f.nbBits)
+	return res
+}
+
+// abs returns the absolute value of a without asserting that the result is in
+// range.
+func (f *API) abs(a frontend.Variable) frontend.Variable {
+	s := f.sign(a, f.nbBits)
+	return f.api.Sub(a, f.api.Mul(2, s, a))
+}
+
+// ReLU returns a if a is positive and 0 otherwise.
+func (f *API) ReLU(a frontend.Variable) frontend.Variable {
+	s := f.sign(a, f.nbBits)
+	return f.api.Sub(a, f.api.Mul(s, a))
+}
+
+// Max returns the larger of a and b.
+func (f *API) Max(a, b frontend.Variable) frontend.Variable {
+	return f.api.Select(f.IsLess(a, b), b, a)
+}
+
+// Min returns the smaller of a and b.
+func (f *API) Min(a, b frontend.Variable) frontend.Variable {
+	return f.api.Select(f.IsLess(a, b), a, b)
+}
+
+// Clamp returns a restricted to the interval [lo, hi]. The bounds must satisfy
+// lo <= hi.
+func (f *API) Clamp(a, lo, hi frontend.Variable) frontend.Variable {
+	return f.Max(lo, f.Min(a, hi))
+}
+
+// ToTwosComplement returns the two's complement representation of a, which is
+// the integer a mod 2^nbBits.
+func (f *API) ToTwosComplement(a frontend.Variable) frontend.Variable {
+	s := f.sign(a, f.nbBits)
+	return f.api.Add(a, f.api.Mul(s, new(big.Int).Lsh(big.NewInt(1), uint(f.nbBits))))
+}
+
+// FromTwosComplement returns the value represented by the two's complement
+// representation u. It asserts that u is less than 2^nbBits.
+func (f *API) FromTwosComplement(u frontend.Variable) frontend.Variable {
+	// the value is u - 2^nbBits when the most significant bit of u is set,
+	// i.e. when 2^(nbBits-1)-1-u is negative.
+	half := new(big.Int).Lsh(big.NewInt(1), uint(f.nbBits-1))
+	res, err := f.api.Compiler().NewHint(signHint, 1, f.nbBits, f.api.Sub(new(big.Int).Sub(half, big.NewInt(1)), u))
+	if err != nil {
+		panic(fmt.Sprintf("sign hint: %v", err))
+	}
+	msb := res[0]
+	f.api.AssertIsBoolean(msb)
+	f.rchecker.Check(f.api.Sub(u, f.api.Mul(msb, half)), f.nbBits-1)
+	return f.api.Sub(u, f.api.Mul(msb, new(big.Int).Lsh(half, 1)))
+}
+
+// sign returns 1 if a is negative and 0 otherwise. It asserts that a is in the
+// signed range of nbBits bits.
+func (f *API) sign(a frontend.Variable, nbBits int) frontend.Variable {
+	res, err := f.api.Compiler().NewHint(signHint, 1, nbBits, a)
+	if err != nil {
+		panic(fmt.Sprintf("sign hint: %v", err))
+	}
+	s := res[0]
+	f.api.AssertIsBoolean(s)
+	// if s = 0, then a in [0, 2^(nbBits-1)). Otherwise -a-1 in [0,
+	// 2^(nbBits-1)).
+	f.rchecker.Check(f.api.Sub(a, f.api.Mul(s, f.api.Add(f.api.Mul(2, a), 1))), nbBits-1)
+	return s
+}
+
+// div returns the quotient and remainder of the division of the signed values
+// a and b using the hint.
+func (f *API) div(a, b frontend.Variable) (q, r frontend.Variable) {
+	res, err := f.api.Compiler().NewHint(divHint, 2, a, b)
+	if err != nil {
+		panic(fmt.Sprintf("division hint: %v", err))
+	}
+	return res[0], res[1]
+}
+
+// signed returns the signed representative of the field element v in (-p/2,
+// p/2).
+func signed(mod, v *big.Int) *big.Int {
+	res := new(big.Int).Set(v)
+	if res.Cmp(new(big.Int).Rsh(mod, 1)) > 0 {
+		res.Sub(res, mod)
+	}
+	return res
+}
+
+// signHint returns 1 if the second input interpreted as a signed integer is
+// negative and 0 otherwise. For the inputs out of range, the result is chosen
+// such that the range check fails.
+func signHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != 2 || len(outputs) != 1 {
+		return fmt.Errorf("expected 2 inputs and 1 output")
+	}
+	if signed(mod, inputs[1]).Sign() < 0 {
+		outputs[0].SetUint64(1)
+	} else {
+		outputs[0].SetUint64(0)
+	}
+	return nil
+}
+
+// divHint returns the quotient and the non-negative remainder of the Euclidean
+// division of the inputs interpreted as signed integers.
+func divHint(mod *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != 2 || len(outputs) != 2 {
+		return fmt.Errorf("expected 2 inputs and 2 outputs")
+	}
+	a, b := signed(mod, inputs[0]), signed(mod, inputs[1])
+	if b.Sign() == 0 {
+		return fmt.Errorf("division by zero")
+	}
+	q, r := new(big.Int).DivMod(a, b, new(big.Int))
+	outputs[0].Mod(q, mod)
+	outputs[1].Set(r)
+	return nil
+}
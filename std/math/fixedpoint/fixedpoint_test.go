@@ -0,0 +1,142 @@
+package fixedpoint
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+const (
+	testNbBits     = 32
+	testNbFracBits = 16
+)
+
+type FixedPointCircuit struct {
+	A, B                 frontend.Variable
+	Sum, Diff, Prod, Quo frontend.Variable
+	AbsA, ReLUA, ClampA  frontend.Variable
+	Less, Cmp            frontend.Variable
+	TwosA                frontend.Variable
+	Lo, Hi               frontend.Variable
+}
+
+func (c *FixedPointCircuit) Define(api frontend.API) error {
+	f, err := New(api, testNbBits, testNbFracBits)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(f.Add(c.A, c.B), c.Sum)
+	api.AssertIsEqual(f.Sub(c.A, c.B), c.Diff)
+	api.AssertIsEqual(f.Mul(c.A, c.B), c.Prod)
+	api.AssertIsEqual(f.Div(c.A, c.B), c.Quo)
+	api.AssertIsEqual(f.Abs(c.A), c.AbsA)
+	api.AssertIsEqual(f.ReLU(c.A), c.ReLUA)
+	api.AssertIsEqual(f.Clamp(c.A, c.Lo, c.Hi), c.ClampA)
+	api.AssertIsEqual(f.IsLess(c.A, c.B), c.Less)
+	api.AssertIsEqual(f.Cmp(c.A, c.B), c.Cmp)
+	twos := f.ToTwosComplement(c.A)
+	api.AssertIsEqual(twos, c.TwosA)
+	api.AssertIsEqual(f.FromTwosComplement(twos), c.A)
+	return nil
+}
+
+func fixedPointWitness(a, b float64) *FixedPointCircuit {
+	scale := new(big.Int).Lsh(big.NewInt(1), testNbFracBits)
+	half := new(big.Int).Rsh(scale, 1)
+	A, B := FromFloat(a, testNbFracBits), FromFloat(b, testNbFracBits)
+	lo, hi := FromFloat(-1, testNbFracBits), FromFloat(1, testNbFracBits)
+	w := &FixedPointCircuit{A: A, B: B, Lo: lo, Hi: hi}
+	w.Sum = new(big.Int).Add(A, B)
+	w.Diff = new(big.Int).Sub(A, B)
+	prod := new(big.Int).Mul(A, B)
+	prod.Add(prod, half)
+	w.Prod = new(big.Int).Div(prod, scale)
+	w.Quo = 0
+	if B.Sign() != 0 {
+		w.Quo = new(big.Int).Div(new(big.Int).Mul(A, scale), B)
+	}
+	w.AbsA = new(big.Int).Abs(A)
+	w.ReLUA, w.Less, w.Cmp = A, 0, A.Cmp(B)
+	if A.Sign() < 0 {
+		w.ReLUA = 0
+	}
+	if A.Cmp(B) < 0 {
+		w.Less = 1
+	}
+	switch {
+	case A.Cmp(lo) < 0:
+		w.ClampA = lo
+	case A.Cmp(hi) > 0:
+		w.ClampA = hi
+	default:
+		w.ClampA = A
+	}
+	w.TwosA = new(big.Int).Mod(A, new(big.Int).Lsh(big.NewInt(1), testNbBits))
+	// the test engine expects the values reduced modulo the native modulus
+	for _, v := range []*frontend.Variable{&w.A, &w.B, &w.Sum, &w.Diff, &w.Prod, &w.Quo, &w.Lo, &w.ReLUA, &w.ClampA, &w.Cmp} {
+		if b, ok := (*v).(*big.Int); ok {
+			*v = new(big.Int).Mod(b, ecc.BN254.ScalarField())
+		} else if i, ok := (*v).(int); ok {
+			*v = new(big.Int).Mod(big.NewInt(int64(i)), ecc.BN254.ScalarField())
+		}
+	}
+	return w
+}
+
+func TestFixedPoint(t *testing.T) {
+	assert := test.NewAssert(t)
+	for _, tc := range [][2]float64{
+		{1.5, 2.25},
+		{-3.75, 1.5},
+		{0.3, -0.7},
+		{-12.125, -4.5},
+		{0, 3},
+		{100.5, 100.5},
+	} {
+		err := test.IsSolved(&FixedPointCircuit{}, fixedPointWitness(tc[0], tc[1]), ecc.BN254.ScalarField())
+		assert.NoError(err, "a=%f b=%f", tc[0], tc[1])
+	}
+	assert.ProverSucceeded(&FixedPointCircuit{}, fixedPointWitness(-3.75, 1.5),
+		test.WithCurves(ecc.BN254),
+		test.WithBackends(backend.GROTH16, backend.PLONK),
+		test.NoFuzzing())
+
+	// overflowing addition must fail
+	w := fixedPointWitness(20000, 20000)
+	err := test.IsSolved(&FixedPointCircuit{}, w, ecc.BN254.ScalarField())
+	assert.Error(err)
+	// division by zero must fail
+	w = fixedPointWitness(1, 0)
+	err = test.IsSolved(&FixedPointCircuit{}, w, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type IntCircuit struct {
+	A, B frontend.Variable
+	Prod frontend.Variable
+}
+
+func (c *IntCircuit) Define(api frontend.API) error {
+	f, err := NewInt(api, 16)
+	if err != nil {
+		return err
+	}
+	api.AssertIsEqual(f.Mul(c.A, c.B), c.Prod)
+	return nil
+}
+
+func TestInt(t *testing.T) {
+	assert := test.NewAssert(t)
+	p := ecc.BN254.ScalarField()
+	neg := func(v int64) *big.Int { return new(big.Int).Sub(p, big.NewInt(v)) }
+	err := test.IsSolved(&IntCircuit{}, &IntCircuit{A: neg(100), B: 300, Prod: neg(30000)}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	err = test.IsSolved(&IntCircuit{}, &IntCircuit{A: neg(128), B: 256, Prod: neg(32768)}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	err = test.IsSolved(&IntCircuit{}, &IntCircuit{A: 1000, B: 1000, Prod: 1000000}, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
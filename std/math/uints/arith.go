@@ -0,0 +1,221 @@
+package uints
+
+import (
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark/frontend"
+)
+
+// AddCarry returns the sum a+b modulo 2^n and the carry, where n is the bit
+// length of T. The carry is 1 if the sum overflows and 0 otherwise.
+func (bf *BinaryField[T]) AddCarry(a, b T) (T, frontend.Variable) {
+	return bf.add(a, b)
+}
+
+// add returns the sum of the inputs modulo 2^n and the carry. The inputs are
+// summed in chunks of bytes which fit into the native field.
+func (bf *BinaryField[T]) add(a ...T) (T, frontend.Variable) {
+	var res T
+	if len(a) == 0 {
+		panic("zero-length input")
+	}
+	carryBits := bits.Len(uint(len(a) - 1))
+	chunk := bf.chunkLen(carryBits + 1)
+	var carry frontend.Variable = 0
+	for off := 0; off < len(res); off += chunk {
+		end := off + chunk
+		if end > len(res) {
+			end = len(res)
+		}
+		terms := make([]frontend.Variable, 0, len(a)+1)
+		terms = append(terms, carry)
+		for i := range a {
+			terms = append(terms, bf.toValue(bf.UnpackLSB(a[i])[off:end]))
+		}
+		dst := make([]U8, end-off)
+		carry = bf.split(dst, bf.sum(terms...), carryBits)
+		for i := range dst {
+			res[off+i] = dst[i]
+		}
+	}
+	return res, carry
+}
+
+// Sub returns the difference a-b modulo 2^n, where n is the bit length of T.
+func (bf *BinaryField[T]) Sub(a, b T) T {
+	res, _ := bf.SubBorrow(a, b)
+	return res
+}
+
+// SubBorrow returns the difference a-b modulo 2^n and the borrow, where n is
+// the bit length of T. The borrow is 1 if b > a and 0 otherwise.
+func (bf *BinaryField[T]) SubBorrow(a, b T) (T, frontend.Variable) {
+	var res T
+	chunk := bf.chunkLen(2)
+	ab, bb := bf.UnpackLSB(a), bf.UnpackLSB(b)
+	var borrow frontend.Variable = 0
+	for off := 0; off < len(res); off += chunk {
+		end := off + chunk
+		if end > len(res) {
+			end = len(res)
+		}
+		// we add 2^(8*(end-off)) to keep the difference non-negative. Then the
+		// upper bit is set iff there was no borrow.
+		shift := new(big.Int).Lsh(big.NewInt(1), uint(8*(end-off)))
+		d := bf.api.Sub(bf.api.Add(bf.toValue(ab[off:end]), shift), bf.toValue(bb[off:end]), borrow)
+		dst := make([]U8, end-off)
+		hi := bf.split(dst, d, 1)
+		borrow = bf.api.Sub(1, hi)
+		for i := range dst {
+			res[off+i] = dst[i]
+		}
+	}
+	return res, borrow
+}
+
+// Mul returns the product a*b modulo 2^n, where n is the bit length of T.
+func (bf *BinaryField[T]) Mul(a, b T) T {
+	lo, _ := bf.MulWide(a, b)
+	return lo
+}
+
+// MulWide returns the full product a*b as the lower and upper halves, so that
+// a*b = lo + 2^n * hi where n is the bit length of T. The upper half is zero
+// iff the product does not overflow.
+func (bf *BinaryField[T]) MulWide(a, b T) (lo, hi T) {
+	ab, bb := bf.UnpackLSB(a), bf.UnpackLSB(b)
+	n := len(ab)
+	// every column is a sum of at most n products of bytes and the carry from
+	// the previous column, thus the carry is less than n*2^9.
+	carryBits := bits.Len(uint(n)) + 9
+	var carry frontend.Variable = 0
+	res := make([]U8, 2*n)
+	for k := 0; k < 2*n; k++ {
+		terms := []frontend.Variable{carry}
+		for i := 0; i < n; i++ {
+			if j := k - i; j >= 0 && j < n {
+				terms = append(terms, bf.api.Mul(ab[i].Val, bb[j].Val))
+			}
+		}
+		carry = bf.split(res[k:k+1], bf.sum(terms...), carryBits)
+	}
+	// the product is less than 2^(2n) and the lower bytes are range checked,
+	// so the last carry is zero.
+	for i := 0; i < n; i++ {
+		lo[i] = res[i]
+		hi[i] = res[n+i]
+	}
+	return lo, hi
+}
+
+// DivMod returns the quotient and remainder of the division a/b, so that
+// a = q*b + r with r < b. If b is zero, then q is zero and r is a.
+func (bf *BinaryField[T]) DivMod(a, b T) (q, r T) {
+	ab, bb := bf.UnpackLSB(a), bf.UnpackLSB(b)
+	n := len(ab)
+	inputs := make([]frontend.Variable, 0, 2*n+1)
+	inputs = append(inputs, n)
+	for i := range ab {
+		inputs = append(inputs, ab[i].Val)
+	}
+	for i := range bb {
+		inputs = append(inputs, bb[i].Val)
+	}
+	res, err := bf.api.Compiler().NewHint(divModHint, 2*n, inputs...)
+	if err != nil {
+		panic(err)
+	}
+	for i := 0; i < n; i++ {
+		q[i] = bf.ByteValueOf(res[i])
+		r[i] = bf.ByteValueOf(res[n+i])
+	}
+	// a = q*b + r without overflow
+	lo, hi := bf.MulWide(q, b)
+	for i := 0; i < len(hi); i++ {
+		bf.api.AssertIsEqual(hi[i].Val, 0)
+	}
+	s, carry := bf.AddCarry(lo, r)
+	bf.api.AssertIsEqual(carry, 0)
+	bf.AssertEq(s, a)
+	// r < b if b is non-zero. Otherwise q = 0, which implies r = a.
+	var zero T
+	for i := 0; i < len(zero); i++ {
+		zero[i] = NewU8(0)
+	}
+	bZero := bf.IsEqual(b, zero)
+	bf.api.AssertIsEqual(bf.IsLess(r, b), bf.api.Sub(1, bZero))
+	for i := 0; i < len(q); i++ {
+		bf.api.AssertIsEqual(bf.api.Mul(q[i].Val, bZero), 0)
+	}
+	return q, r
+}
+
+// IsLess returns 1 if a < b and 0 otherwise.
+func (bf *BinaryField[T]) IsLess(a, b T) frontend.Variable {
+	_, borrow := bf.SubBorrow(a, b)
+	return borrow
+}
+
+// IsEqual returns 1 if a == b and 0 otherwise.
+func (bf *BinaryField[T]) IsEqual(a, b T) frontend.Variable {
+	chunk := bf.chunkLen(1)
+	ab, bb := bf.UnpackLSB(a), bf.UnpackLSB(b)
+	var res frontend.Variable = 1
+	for off := 0; off < len(ab); off += chunk {
+		end := off + chunk
+		if end > len(ab) {
+			end = len(ab)
+		}
+		d := bf.api.Sub(bf.toValue(ab[off:end]), bf.toValue(bb[off:end]))
+		res = bf.api.Mul(res, bf.api.IsZero(d))
+	}
+	return res
+}
+
+// chunkLen returns the number of bytes which can be accumulated in a single
+// native element together with the additional extraBits bits.
+func (bf *BinaryField[T]) chunkLen(extraBits int) int {
+	l := (bf.api.Compiler().FieldBitLen() - 1 - extraBits) / 8
+	if l < 1 {
+		panic("native field too small")
+	}
+	return l
+}
+
+// split decomposes v into len(dst) range checked bytes stored in dst and the
+// upper part, which is returned. The upper part is range checked to hiBits
+// bits.
+func (bf *BinaryField[T]) split(dst []U8, v frontend.Variable, hiBits int) frontend.Variable {
+	res, err := bf.api.Compiler().NewHint(splitHint, 2, 8*len(dst), v)
+	if err != nil {
+		panic(err)
+	}
+	hi := res[1]
+	// the bytes are computed from the lower part, the hint does not accept
+	// inputs wider than the number of bytes.
+	bts, err := bf.api.Compiler().NewHint(toBytes, len(dst), len(dst), res[0])
+	if err != nil {
+		panic(err)
+	}
+	for i := range dst {
+		dst[i] = bf.ByteValueOf(bts[i])
+	}
+	if hiBits == 0 {
+		bf.api.AssertIsEqual(hi, 0)
+	} else if hiBits == 1 {
+		bf.api.AssertIsBoolean(hi)
+	} else {
+		bf.rchecker.Check(hi, hiBits)
+	}
+	shift := new(big.Int).Lsh(big.NewInt(1), uint(8*len(dst)))
+	bf.api.AssertIsEqual(v, bf.api.Add(bf.toValue(dst), bf.api.Mul(hi, shift)))
+	return hi
+}
+
+func (bf *BinaryField[T]) sum(terms ...frontend.Variable) frontend.Variable {
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return bf.api.Add(terms[0], terms[1], terms[2:]...)
+}
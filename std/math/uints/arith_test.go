@@ -0,0 +1,130 @@
+package uints
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type arithCircuit[T Long] struct {
+	A, B          T
+	Sum, Diff     T
+	Carry, Borrow frontend.Variable
+	Lo, Hi        T
+	Q, R          T
+	Less, Eq      frontend.Variable
+	Or, Shl       T
+	Shift         int
+}
+
+func (c *arithCircuit[T]) Define(api frontend.API) error {
+	uapi, err := New[T](api)
+	if err != nil {
+		return err
+	}
+	sum, carry := uapi.AddCarry(c.A, c.B)
+	uapi.AssertEq(sum, c.Sum)
+	api.AssertIsEqual(carry, c.Carry)
+	diff, borrow := uapi.SubBorrow(c.A, c.B)
+	uapi.AssertEq(diff, c.Diff)
+	api.AssertIsEqual(borrow, c.Borrow)
+	lo, hi := uapi.MulWide(c.A, c.B)
+	uapi.AssertEq(lo, c.Lo)
+	uapi.AssertEq(hi, c.Hi)
+	q, r := uapi.DivMod(c.A, c.B)
+	uapi.AssertEq(q, c.Q)
+	uapi.AssertEq(r, c.R)
+	api.AssertIsEqual(uapi.IsLess(c.A, c.B), c.Less)
+	api.AssertIsEqual(uapi.IsEqual(c.A, c.B), c.Eq)
+	uapi.AssertEq(uapi.Or(c.A, c.B), c.Or)
+	uapi.AssertEq(uapi.Lshift(c.A, c.Shift), c.Shl)
+	return nil
+}
+
+func toLong[T Long](v *big.Int) T {
+	var r T
+	for i := 0; i < len(r); i++ {
+		r[i] = NewU8(uint8(new(big.Int).Rsh(v, uint(8*i)).Uint64()))
+	}
+	return r
+}
+
+func arithWitness[T Long](a, b *big.Int, shift int) *arithCircuit[T] {
+	var t T
+	nbBits := uint(8 * len(t))
+	mod := new(big.Int).Lsh(big.NewInt(1), nbBits)
+	w := &arithCircuit[T]{A: toLong[T](a), B: toLong[T](b), Shift: shift, Carry: 0, Borrow: 0, Less: 0, Eq: 0}
+	s := new(big.Int).Add(a, b)
+	if s.Cmp(mod) >= 0 {
+		w.Carry = 1
+	}
+	w.Sum = toLong[T](new(big.Int).Mod(s, mod))
+	d := new(big.Int).Sub(a, b)
+	if d.Sign() < 0 {
+		w.Borrow = 1
+		w.Less = 1
+	}
+	w.Diff = toLong[T](new(big.Int).Mod(d, mod))
+	p := new(big.Int).Mul(a, b)
+	w.Lo = toLong[T](new(big.Int).Mod(p, mod))
+	w.Hi = toLong[T](new(big.Int).Rsh(p, nbBits))
+	if b.Sign() == 0 {
+		w.Q, w.R = toLong[T](big.NewInt(0)), toLong[T](a)
+	} else {
+		q, r := new(big.Int).QuoRem(a, b, new(big.Int))
+		w.Q, w.R = toLong[T](q), toLong[T](r)
+	}
+	if a.Cmp(b) == 0 {
+		w.Eq = 1
+	}
+	w.Or = toLong[T](new(big.Int).Or(a, b))
+	w.Shl = toLong[T](new(big.Int).Mod(new(big.Int).Lsh(a, uint(shift)), mod))
+	return w
+}
+
+func testArith[T Long](t *testing.T) {
+	assert := test.NewAssert(t)
+	var tt T
+	mod := new(big.Int).Lsh(big.NewInt(1), uint(8*len(tt)))
+	max := new(big.Int).Sub(mod, big.NewInt(1))
+	a, _ := rand.Int(rand.Reader, mod)
+	b, _ := rand.Int(rand.Reader, mod)
+	small, _ := rand.Int(rand.Reader, big.NewInt(1<<12))
+	for _, tc := range []struct {
+		a, b  *big.Int
+		shift int
+	}{
+		{a, b, 3},
+		{b, a, 8},
+		{a, a, 13},
+		{a, small, 0},
+		{max, max, 1},
+		{a, big.NewInt(0), 9},
+		{big.NewInt(0), a, 5},
+		// shifts by at least the width give zero.
+		{a, b, 8 * len(tt)},
+		{a, b, 8*len(tt) + 5},
+	} {
+		err := test.IsSolved(&arithCircuit[T]{Shift: tc.shift}, arithWitness[T](tc.a, tc.b, tc.shift), ecc.BN254.ScalarField())
+		assert.NoError(err, "a=%s b=%s", tc.a, tc.b)
+	}
+	// wrong carry
+	w := arithWitness[T](max, big.NewInt(1), 1)
+	w.Carry = 0
+	err := test.IsSolved(&arithCircuit[T]{Shift: 1}, w, ecc.BN254.ScalarField())
+	assert.Error(err)
+	// wrong quotient
+	w = arithWitness[T](a, small, 1)
+	w.Q = toLong[T](big.NewInt(0))
+	err = test.IsSolved(&arithCircuit[T]{Shift: 1}, w, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestArithU16(t *testing.T)  { testArith[U16](t) }
+func TestArithU32(t *testing.T)  { testArith[U32](t) }
+func TestArithU128(t *testing.T) { testArith[U128](t) }
+func TestArithU256(t *testing.T) { testArith[U256](t) }
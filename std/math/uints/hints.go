@@ -15,7 +15,10 @@ func GetHints() []solver.Hint {
 	return []solver.Hint{
 		andHint,
 		xorHint,
+		orHint,
 		toBytes,
+		splitHint,
+		divModHint,
 	}
 }
 
@@ -29,6 +32,11 @@ func andHint(_ *big.Int, inputs, outputs []*big.Int) error {
 	return nil
 }
 
+func orHint(_ *big.Int, inputs, outputs []*big.Int) error {
+	outputs[0].Or(inputs[0], inputs[1])
+	return nil
+}
+
 func toBytes(m *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 	if len(inputs) != 2 {
 		return fmt.Errorf("input must be 2 elements")
@@ -40,8 +48,8 @@ func toBytes(m *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 	if len(outputs) != nbLimbs {
 		return fmt.Errorf("output must be 8 elements")
 	}
-	if !inputs[1].IsUint64() {
-		return fmt.Errorf("input must be 64 bits")
+	if inputs[1].BitLen() > 8*nbLimbs {
+		return fmt.Errorf("input must be %d bits", 8*nbLimbs)
 	}
 	base := new(big.Int).Lsh(big.NewInt(1), uint(8))
 	tmp := new(big.Int).Set(inputs[1])
@@ -51,3 +59,50 @@ func toBytes(m *big.Int, inputs []*big.Int, outputs []*big.Int) error {
 	}
 	return nil
 }
+
+// splitHint returns the lower inputs[0] bits and the remaining higher bits of
+// inputs[1].
+func splitHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != 2 || len(outputs) != 2 {
+		return fmt.Errorf("expected 2 inputs and 2 outputs")
+	}
+	if !inputs[0].IsUint64() {
+		return fmt.Errorf("first input must be uint64")
+	}
+	shift := uint(inputs[0].Uint64())
+	outputs[1].Rsh(inputs[1], shift)
+	outputs[0].Sub(inputs[1], new(big.Int).Lsh(outputs[1], shift))
+	return nil
+}
+
+// divModHint returns the quotient and remainder bytes of the division of the
+// integers given by their little-endian bytes. The first input is the number of
+// bytes n, followed by the n bytes of the dividend and n bytes of the divisor.
+// If the divisor is zero, then the quotient is zero and the remainder is the
+// dividend.
+func divModHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) == 0 || !inputs[0].IsUint64() {
+		return fmt.Errorf("first input must be uint64")
+	}
+	nbBytes := int(inputs[0].Uint64())
+	if len(inputs) != 2*nbBytes+1 || len(outputs) != 2*nbBytes {
+		return fmt.Errorf("expected %d inputs and %d outputs", 2*nbBytes+1, 2*nbBytes)
+	}
+	a, b := new(big.Int), new(big.Int)
+	for i := nbBytes - 1; i >= 0; i-- {
+		a.Lsh(a, 8).Add(a, inputs[1+i])
+		b.Lsh(b, 8).Add(b, inputs[1+nbBytes+i])
+	}
+	q, r := new(big.Int), new(big.Int).Set(a)
+	if b.Sign() != 0 {
+		q.QuoRem(a, b, r)
+	}
+	mask := big.NewInt(0xff)
+	for i := 0; i < nbBytes; i++ {
+		outputs[i].And(q, mask)
+		outputs[nbBytes+i].And(r, mask)
+		q.Rsh(q, 8)
+		r.Rsh(r, 8)
+	}
+	return nil
+}
@@ -2,7 +2,8 @@
 //
 // Usually arithmetic in a circuit is performed in the native field, which is of
 // prime order. However, for compatibility with native operations we rely on
-// operating on smaller primitive types as 8-bit, 32-bit and 64-bit integer.
+// operating on smaller primitive types as 8-bit, 16-bit, 32-bit and 64-bit
+// integers, and on wide 128-bit and 256-bit integers.
 // Naively, these operations have to be implemented bitwise as there are no
 // closed equations for boolean operations (XOR, AND, OR).
 //
@@ -17,6 +18,12 @@
 // which depending on the backend is relatively cheap (one to three
 // constraints).
 //
+// The arithmetic operations (addition, subtraction, multiplication and
+// division) are performed on chunks of bytes which fit into the native field
+// and the results are decomposed back into range checked bytes. The variants
+// [BinaryField.AddCarry], [BinaryField.SubBorrow] and [BinaryField.MulWide]
+// additionally return the overflowing part of the result.
+//
 // NB! The package is still work in progress. The interfaces and implementation
 // details most certainly changes over time. We cannot ensure the soundness of
 // the operations.
@@ -24,6 +31,7 @@ package uints
 
 import (
 	"fmt"
+	"math/big"
 
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/internal/logderivprecomp"
@@ -77,16 +85,21 @@ func (e *U8) GnarkInitHook() {
 	}
 }
 
+type U256 [32]U8
+type U128 [16]U8
 type U64 [8]U8
 type U32 [4]U8
+type U16 [2]U8
 
-type Long interface{ U32 | U64 }
+type Long interface {
+	U16 | U32 | U64 | U128 | U256
+}
 
-type BinaryField[T U32 | U64] struct {
-	api        frontend.API
-	xorT, andT *logderivprecomp.Precomputed
-	rchecker   frontend.Rangechecker
-	allOne     U8
+type BinaryField[T Long] struct {
+	api             frontend.API
+	xorT, andT, orT *logderivprecomp.Precomputed
+	rchecker        frontend.Rangechecker
+	allOne          U8
 }
 
 func New[T Long](api frontend.API) (*BinaryField[T], error) {
@@ -98,11 +111,16 @@ func New[T Long](api frontend.API) (*BinaryField[T], error) {
 	if err != nil {
 		return nil, fmt.Errorf("new and table: %w", err)
 	}
+	orT, err := logderivprecomp.New(api, orHint, []uint{8})
+	if err != nil {
+		return nil, fmt.Errorf("new or table: %w", err)
+	}
 	rchecker := rangecheck.New(api)
 	bf := &BinaryField[T]{
 		api:      api,
 		xorT:     xorT,
 		andT:     andT,
+		orT:      orT,
 		rchecker: rchecker,
 	}
 	// TODO: this is const. add way to init constants
@@ -116,6 +134,13 @@ func NewU8(v uint8) U8 {
 	return U8{Val: v, internal: true}
 }
 
+func NewU16(v uint16) U16 {
+	return [2]U8{
+		NewU8(uint8((v >> (0 * 8)) & 0xff)),
+		NewU8(uint8((v >> (1 * 8)) & 0xff)),
+	}
+}
+
 func NewU32(v uint32) U32 {
 	return [4]U8{
 		NewU8(uint8((v >> (0 * 8)) & 0xff)),
@@ -138,6 +163,32 @@ func NewU64(v uint64) U64 {
 	}
 }
 
+// NewU128 returns the constant v as U128. It panics if v is negative or does
+// not fit into 128 bits.
+func NewU128(v *big.Int) U128 {
+	var r U128
+	newLong(r[:], v)
+	return r
+}
+
+// NewU256 returns the constant v as U256. It panics if v is negative or does
+// not fit into 256 bits.
+func NewU256(v *big.Int) U256 {
+	var r U256
+	newLong(r[:], v)
+	return r
+}
+
+func newLong(r []U8, v *big.Int) {
+	if v.Sign() < 0 || v.BitLen() > 8*len(r) {
+		panic(fmt.Sprintf("value does not fit into %d bits", 8*len(r)))
+	}
+	bts := v.FillBytes(make([]byte, len(r)))
+	for i := range r {
+		r[i] = NewU8(bts[len(r)-1-i])
+	}
+}
+
 func NewU8Array(v []uint8) []U8 {
 	ret := make([]U8, len(v))
 	for i := range v {
@@ -146,6 +197,14 @@ func NewU8Array(v []uint8) []U8 {
 	return ret
 }
 
+func NewU16Array(v []uint16) []U16 {
+	ret := make([]U16, len(v))
+	for i := range v {
+		ret[i] = NewU16(v[i])
+	}
+	return ret
+}
+
 func NewU32Array(v []uint32) []U32 {
 	ret := make([]U32, len(v))
 	for i := range v {
@@ -180,13 +239,22 @@ func (bf *BinaryField[T]) ValueOf(a frontend.Variable) T {
 	return r
 }
 
+// ToValue returns the integer value of a as a native element. For types wider
+// than the native field (U256 for most fields) the value is reduced modulo the
+// native modulus.
 func (bf *BinaryField[T]) ToValue(a T) frontend.Variable {
+	return bf.toValue(bf.UnpackLSB(a))
+}
+
+func (bf *BinaryField[T]) toValue(a []U8) frontend.Variable {
+	if len(a) == 1 {
+		return a[0].Val
+	}
 	v := make([]frontend.Variable, len(a))
 	for i := range v {
-		v[i] = bf.api.Mul(a[i].Val, 1<<(i*8))
+		v[i] = bf.api.Mul(a[i].Val, new(big.Int).Lsh(big.NewInt(1), uint(i*8)))
 	}
-	vv := bf.api.Add(v[0], v[1], v[2:]...)
-	return vv
+	return bf.api.Add(v[0], v[1], v[2:]...)
 }
 
 func (bf *BinaryField[T]) PackMSB(a ...U8) T {
@@ -240,6 +308,7 @@ func (bf *BinaryField[T]) twoArgWideFn(tbl *logderivprecomp.Precomputed, a ...T)
 
 func (bf *BinaryField[T]) And(a ...T) T { return bf.twoArgWideFn(bf.andT, a...) }
 func (bf *BinaryField[T]) Xor(a ...T) T { return bf.twoArgWideFn(bf.xorT, a...) }
+func (bf *BinaryField[T]) Or(a ...T) T  { return bf.twoArgWideFn(bf.orT, a...) }
 
 func (bf *BinaryField[T]) not(a U8) U8 {
 	ret := bf.xorT.Query(a.Val, bf.allOne.Val)
@@ -254,14 +323,10 @@ func (bf *BinaryField[T]) Not(a T) T {
 	return r
 }
 
+// Add returns the sum of the inputs modulo 2^n, where n is the bit length of
+// T. See [BinaryField.AddCarry] for obtaining the carry.
 func (bf *BinaryField[T]) Add(a ...T) T {
-	va := make([]frontend.Variable, len(a))
-	for i := range a {
-		va[i] = bf.ToValue(a[i])
-	}
-	vres := bf.api.Add(va[0], va[1], va[2:]...)
-	res := bf.ValueOf(vres)
-	// TODO: should also check the that carry we omitted is correct.
+	res, _ := bf.add(a...)
 	return res
 }
 
@@ -292,7 +357,19 @@ func (bf *BinaryField[T]) Lrot(a T, c int) T {
 	return ret
 }
 
+// Rshift returns a shifted right by c bits. The result is zero if c is at
+// least the width of a.
 func (bf *BinaryField[T]) Rshift(a T, c int) T {
+	if c < 0 {
+		panic("negative shift amount")
+	}
+	if c >= 8*len(a) {
+		var zero T
+		for i := 0; i < len(zero); i++ {
+			zero[i] = NewU8(0)
+		}
+		return zero
+	}
 	shiftBl := c / 8
 	shiftBt := c % 8
 	partitioned := make([][2]frontend.Variable, len(a)-shiftBl)
@@ -315,6 +392,43 @@ func (bf *BinaryField[T]) Rshift(a T, c int) T {
 	return ret
 }
 
+// Lshift returns a shifted left by c bits, truncated to the width of a. The
+// result is zero if c is at least the width of a.
+func (bf *BinaryField[T]) Lshift(a T, c int) T {
+	if c < 0 {
+		panic("negative shift amount")
+	}
+	if c >= 8*len(a) {
+		var zero T
+		for i := 0; i < len(zero); i++ {
+			zero[i] = NewU8(0)
+		}
+		return zero
+	}
+	shiftBl := c / 8
+	shiftBt := c % 8
+	var ret T
+	for i := 0; i < shiftBl; i++ {
+		ret[i] = NewU8(0)
+	}
+	if shiftBt == 0 {
+		for i := shiftBl; i < len(ret); i++ {
+			ret[i] = a[i-shiftBl]
+		}
+		return ret
+	}
+	partitioned := make([][2]frontend.Variable, len(a)-shiftBl)
+	for i := range partitioned {
+		lower, upper := bitslice.Partition(bf.api, a[i].Val, uint(8-shiftBt), bitslice.WithNbDigits(8))
+		partitioned[i] = [2]frontend.Variable{lower, upper}
+	}
+	ret[shiftBl].Val = bf.api.Mul(1<<shiftBt, partitioned[0][0])
+	for i := 1; i < len(partitioned); i++ {
+		ret[i+shiftBl].Val = bf.api.Add(bf.api.Mul(1<<shiftBt, partitioned[i][0]), partitioned[i-1][1])
+	}
+	return ret
+}
+
 func (bf *BinaryField[T]) ByteAssertEq(a, b U8) {
 	bf.api.AssertIsEqual(a.Val, b.Val)
 }
@@ -325,7 +439,7 @@ func (bf *BinaryField[T]) AssertEq(a, b T) {
 	}
 }
 
-func reslice[T U32 | U64](in []T) [][]U8 {
+func reslice[T Long](in []T) [][]U8 {
 	if len(in) == 0 {
 		panic("zero-length input")
 	}
@@ -78,4 +78,57 @@ func TestRshift(t *testing.T) {
 	assert.NoError(err)
 	err = test.IsSolved(&rshiftCircuit{Shift: 11}, &rshiftCircuit{Shift: 11, In: NewU32(0x12345678), Expected: NewU32(0x12345678 >> 11)}, ecc.BN254.ScalarField())
 	assert.NoError(err)
+	// shifts by at least the width give zero.
+	err = test.IsSolved(&rshiftCircuit{Shift: 32}, &rshiftCircuit{Shift: 32, In: NewU32(0x12345678), Expected: NewU32(0)}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	err = test.IsSolved(&rshiftCircuit{Shift: 40}, &rshiftCircuit{Shift: 40, In: NewU32(0x12345678), Expected: NewU32(0)}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
+
+type addCircuit struct {
+	In       [3]U64
+	Expected U64
+}
+
+func (c *addCircuit) Define(api frontend.API) error {
+	uapi, err := New[U64](api)
+	if err != nil {
+		return err
+	}
+	res := uapi.Add(c.In[0], c.In[1], c.In[2])
+	uapi.AssertEq(res, c.Expected)
+	return nil
+}
+
+func TestAddU64(t *testing.T) {
+	assert := test.NewAssert(t)
+	a, b, c := uint64(0xfedcba9876543210), uint64(0xffffffffffffffff), uint64(0x0123456789abcdef)
+	err := test.IsSolved(&addCircuit{}, &addCircuit{In: [3]U64{NewU64(a), NewU64(b), NewU64(c)}, Expected: NewU64(a + b + c)}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	err = test.IsSolved(&addCircuit{}, &addCircuit{In: [3]U64{NewU64(a), NewU64(b), NewU64(c)}, Expected: NewU64(a + b + c + 1)}, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type valueOfCircuit struct {
+	In       frontend.Variable
+	Expected U32
+}
+
+func (c *valueOfCircuit) Define(api frontend.API) error {
+	uapi, err := New[U32](api)
+	if err != nil {
+		return err
+	}
+	res := uapi.ValueOf(c.In)
+	uapi.AssertEq(res, c.Expected)
+	return nil
+}
+
+func TestValueOf(t *testing.T) {
+	assert := test.NewAssert(t)
+	err := test.IsSolved(&valueOfCircuit{}, &valueOfCircuit{In: 0x12345678, Expected: NewU32(0x12345678)}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	// the input wider than 32 bits must not be silently truncated.
+	err = test.IsSolved(&valueOfCircuit{}, &valueOfCircuit{In: 0x112345678, Expected: NewU32(0x12345678)}, ecc.BN254.ScalarField())
+	assert.Error(err)
 }
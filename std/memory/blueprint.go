@@ -0,0 +1,110 @@
+package memory
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark/constraint"
+)
+
+// BlueprintMemoryHint is a blueprint for computing the value and the timestamp
+// of the last access of a memory cell. It stores the initial values and the
+// history of the memory accesses once, so that every access only needs to
+// store the address.
+//
+// The entries are the initial values of the memory, followed by the address
+// and the value of every access. The instruction calldata stores the number of
+// initial values, the number of accesses to replay and the queried address.
+type BlueprintMemoryHint struct {
+	EntriesCalldata []uint32
+}
+
+// ensures BlueprintMemoryHint implements the BlueprintSolvable interface
+var _ constraint.BlueprintSolvable = (*BlueprintMemoryHint)(nil)
+
+func (b *BlueprintMemoryHint) Solve(s constraint.Solver, inst constraint.Instruction) error {
+	nbInit := int(inst.Calldata[1])
+	nbOps := int(inst.Calldata[2])
+
+	vals := make([]constraint.Element, nbInit)
+	timestamps := make([]uint64, nbInit)
+	offset, delta := 0, 0
+	for i := range vals {
+		vals[i], delta = s.Read(b.EntriesCalldata[offset:])
+		offset += delta
+	}
+	// replay the accesses. The access number k has timestamp k+1.
+	for k := 0; k < nbOps; k++ {
+		var addrE, val constraint.Element
+		addrE, delta = s.Read(b.EntriesCalldata[offset:])
+		offset += delta
+		val, delta = s.Read(b.EntriesCalldata[offset:])
+		offset += delta
+		addr, isUint64 := s.Uint64(addrE)
+		if !isUint64 || addr >= uint64(nbInit) {
+			return fmt.Errorf("memory address out of bounds")
+		}
+		vals[addr] = val
+		timestamps[addr] = uint64(k + 1)
+	}
+
+	addrE, _ := s.Read(inst.Calldata[3:])
+	addr, isUint64 := s.Uint64(addrE)
+	if !isUint64 || addr >= uint64(nbInit) {
+		return fmt.Errorf("memory address out of bounds")
+	}
+	s.SetValue(inst.WireOffset, vals[addr])
+	s.SetValue(inst.WireOffset+1, s.FromInterface(timestamps[addr]))
+	return nil
+}
+
+func (b *BlueprintMemoryHint) CalldataSize() int {
+	// variable size
+	return -1
+}
+
+func (b *BlueprintMemoryHint) NbConstraints() int {
+	return 0
+}
+
+// NbOutputs return the number of output wires this blueprint creates. These are
+// the value and the timestamp of the last access.
+func (b *BlueprintMemoryHint) NbOutputs(inst constraint.Instruction) int {
+	return 2
+}
+
+// WireWalker returns a function that walks the wires appearing in the
+// blueprint. This is used by the level builder to build a dependency graph
+// between instructions.
+func (b *BlueprintMemoryHint) WireWalker(inst constraint.Instruction) func(cb func(wire uint32)) {
+	return func(cb func(wire uint32)) {
+		// depend on the initial values and the accesses up to the number of
+		// accesses at time of instruction creation.
+		nbEntries := int(inst.Calldata[1]) + 2*int(inst.Calldata[2])
+		j := 0
+		for i := 0; i < nbEntries; i++ {
+			j = walkLinearExpression(b.EntriesCalldata, j, cb)
+		}
+		// the queried address
+		walkLinearExpression(inst.Calldata, 3, cb)
+		// the outputs
+		cb(inst.WireOffset)
+		cb(inst.WireOffset + 1)
+	}
+}
+
+// walkLinearExpression invokes the callback on the wires of the linear
+// expression encoded in calldata starting at position j and returns the
+// position after the expression.
+func walkLinearExpression(calldata []uint32, j int, cb func(wire uint32)) int {
+	// first we have the length of the linear expression
+	n := int(calldata[j])
+	j++
+	for k := 0; k < n; k++ {
+		t := constraint.Term{CID: calldata[j], VID: calldata[j+1]}
+		if !t.IsConstant() {
+			cb(t.VID)
+		}
+		j += 2
+	}
+	return j
+}
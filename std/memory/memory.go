@@ -0,0 +1,155 @@
+// Package memory implements read-write random access memory using offline
+// memory checking.
+//
+// Every access to the memory is timestamped with a counter incremented on
+// every access. The memory keeps two multisets of tuples (address, value,
+// timestamp): the read set RS and the write set WS. Initially, for every
+// address a with initial value v we add (a, v, 0) to WS. For every access at
+// time t to address a, the prover provides the value v' and timestamp t' of
+// the previous access to the address. We assert that t' < t, add (a, v', t') to
+// RS and (a, v, t) to WS, where v is the written value for writes and v' for
+// reads. Finally, the prover provides the final value and timestamp for every
+// address, which are added to RS.
+//
+// The memory is consistent iff RS and WS are equal as multisets, which we check
+// using the log-derivative argument as described in [logderivarg]. See [BEG+91]
+// for the offline memory checking and [Spartan] for the timestamp-based
+// variant.
+//
+// The complexity of the memory checking is linear in the size of the memory
+// and the number of accesses (O(n+m)), but every access costs additionally a
+// range check for the timestamp.
+//
+// [BEG+91]: https://doi.org/10.1109/SFCS.1991.185352
+// [Spartan]: https://eprint.iacr.org/2019/550
+package memory
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark/constraint"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/internal/logderivarg"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+// Memory holds the initial values and the accesses of the memory.
+type Memory struct {
+	api      frontend.API
+	rchecker frontend.Rangechecker
+
+	size int
+	// nbOps is the number of accesses. The timestamp of the next access is
+	// nbOps+1.
+	nbOps     int
+	immutable bool
+
+	readSet, writeSet [][]frontend.Variable
+
+	// each memory has a unique blueprint which stores the initial values and
+	// the history of accesses once.
+	bID       constraint.BlueprintID
+	blueprint BlueprintMemoryHint
+}
+
+// New returns a new [*Memory] with the initial values init. The size of the
+// memory is the number of initial values. It additionally defers the memory
+// consistency check.
+func New(api frontend.API, init []frontend.Variable) *Memory {
+	if len(init) == 0 {
+		panic("empty memory")
+	}
+	m := &Memory{
+		api:      api,
+		rchecker: rangecheck.New(api),
+		size:     len(init),
+	}
+	m.bID = api.Compiler().AddBlueprint(&m.blueprint)
+	for i := range init {
+		v := api.Compiler().ToCanonicalVariable(init[i])
+		v.Compress(&m.blueprint.EntriesCalldata)
+		m.writeSet = append(m.writeSet, []frontend.Variable{i, init[i], 0})
+	}
+	api.Compiler().Defer(m.commit)
+	return m
+}
+
+// Read returns the value stored at address addr. It panics during compile time
+// when reading from a committed memory. It panics during solving time if addr
+// is out of bounds.
+func (m *Memory) Read(addr frontend.Variable) frontend.Variable {
+	val := m.access(addr)
+	m.record(addr, val)
+	return val
+}
+
+// Write stores the value val at address addr. It panics during compile time
+// when writing to a committed memory. It panics during solving time if addr is
+// out of bounds.
+func (m *Memory) Write(addr, val frontend.Variable) {
+	m.access(addr)
+	m.record(addr, val)
+}
+
+// access returns the value at addr before the current access. It records the
+// value and the timestamp of the previous access in the read set.
+func (m *Memory) access(addr frontend.Variable) frontend.Variable {
+	if m.immutable {
+		panic("accessing committed memory")
+	}
+	prev, prevTs := m.last(addr)
+	// the previous access was before the current one: prevTs < t, where t =
+	// nbOps+1. As t is constant, we range check t-1-prevTs.
+	d := m.api.Sub(m.nbOps, prevTs)
+	if nbBits := bits.Len(uint(m.nbOps)); nbBits == 0 {
+		m.api.AssertIsEqual(d, 0)
+	} else {
+		m.rchecker.Check(d, nbBits)
+	}
+	m.readSet = append(m.readSet, []frontend.Variable{addr, prev, prevTs})
+	return prev
+}
+
+// record records the access at addr with the value val in the write set and
+// increments the timestamp.
+func (m *Memory) record(addr, val frontend.Variable) {
+	m.nbOps++
+	m.writeSet = append(m.writeSet, []frontend.Variable{addr, val, m.nbOps})
+	compiler := m.api.Compiler()
+	a := compiler.ToCanonicalVariable(addr)
+	a.Compress(&m.blueprint.EntriesCalldata)
+	v := compiler.ToCanonicalVariable(val)
+	v.Compress(&m.blueprint.EntriesCalldata)
+}
+
+// last returns the value and timestamp of the last access to addr using the
+// blueprint.
+func (m *Memory) last(addr frontend.Variable) (val, ts frontend.Variable) {
+	compiler := m.api.Compiler()
+	// * calldata[0] is the length of the calldata,
+	// * calldata[1] is the size of the memory,
+	// * calldata[2] is the number of accesses to replay,
+	// * calldata[3:] is the queried address.
+	calldata := make([]uint32, 3, 3+2+2)
+	calldata[1] = uint32(m.size)
+	calldata[2] = uint32(m.nbOps)
+	v := compiler.ToCanonicalVariable(addr)
+	v.Compress(&calldata)
+	calldata[0] = uint32(len(calldata))
+
+	outputs := compiler.AddInstruction(m.bID, calldata)
+	if len(outputs) != 2 {
+		panic("sanity check")
+	}
+	return compiler.InternalVariable(outputs[0]), compiler.InternalVariable(outputs[1])
+}
+
+func (m *Memory) commit(api frontend.API) error {
+	// the final values of the memory complete the read set.
+	for i := 0; i < m.size; i++ {
+		val, ts := m.last(i)
+		m.readSet = append(m.readSet, []frontend.Variable{i, val, ts})
+	}
+	m.immutable = true
+	return logderivarg.BuildPermutation(api, m.readSet, m.writeSet)
+}
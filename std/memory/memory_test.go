@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type MemoryCircuit struct {
+	Init     [8]frontend.Variable
+	Addrs    [20]frontend.Variable
+	Vals     [20]frontend.Variable
+	IsWrite  [20]bool
+	Expected [20]frontend.Variable
+}
+
+func (c *MemoryCircuit) Define(api frontend.API) error {
+	m := New(api, c.Init[:])
+	for i := range c.Addrs {
+		if c.IsWrite[i] {
+			m.Write(c.Addrs[i], c.Vals[i])
+		} else {
+			api.AssertIsEqual(m.Read(c.Addrs[i]), c.Expected[i])
+		}
+	}
+	return nil
+}
+
+func TestMemory(t *testing.T) {
+	assert := test.NewAssert(t)
+	var circuit, witness MemoryCircuit
+	mem := make([]int, len(witness.Init))
+	for i := range witness.Init {
+		mem[i] = 100 + i
+		witness.Init[i] = mem[i]
+	}
+	for i := range witness.Addrs {
+		addr := (i * 5) % len(mem)
+		circuit.IsWrite[i] = i%3 == 1
+		witness.IsWrite[i] = circuit.IsWrite[i]
+		witness.Addrs[i] = addr
+		witness.Vals[i] = 1000 + i
+		witness.Expected[i] = 0
+		if witness.IsWrite[i] {
+			mem[addr] = 1000 + i
+		} else {
+			witness.Expected[i] = mem[addr]
+		}
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	assert.ProverSucceeded(&circuit, &witness,
+		test.WithCurves(ecc.BN254),
+		test.WithBackends(backend.GROTH16, backend.PLONK),
+		test.NoFuzzing())
+
+	// reading a stale value must fail
+	for i := range witness.Addrs {
+		if !witness.IsWrite[i] && witness.Expected[i] != witness.Init[witness.Addrs[i].(int)] {
+			witness.Expected[i] = witness.Init[witness.Addrs[i].(int)]
+			break
+		}
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
@@ -0,0 +1,97 @@
+// Package multiset implements multiset equality (permutation) checks and
+// sorting.
+//
+// The permutation check is based on the log-derivative argument as described
+// in [logderivarg]. To show that the vectors a and b are permutations of each
+// other, we check at a random challenge x derived from the commitment to the
+// vectors that
+//
+//	∑_{i} 1/(x-a_i) == ∑_{i} 1/(x-b_i).
+//
+// For the multi-column version, every row is first compressed using a random
+// linear combination. The cost of the check is linear in the length of the
+// vectors.
+//
+// Sorting is implemented by computing the sorted vector in a hint and then
+// asserting that the result is a permutation of the input and that the
+// consecutive elements are non-decreasing using range checks.
+package multiset
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/gnark/constraint/solver"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/internal/logderivarg"
+	"github.com/consensys/gnark/std/rangecheck"
+)
+
+func init() {
+	solver.RegisterHint(GetHints()...)
+}
+
+// GetHints returns all hints used in this package
+func GetHints() []solver.Hint {
+	return []solver.Hint{sortHint}
+}
+
+// AssertIsPermutation asserts that the vector b is a permutation of the vector
+// a. It panics if the lengths of the vectors differ.
+func AssertIsPermutation(api frontend.API, a, b []frontend.Variable) {
+	AssertIsPermutationRows(api, logderivarg.AsTable(a), logderivarg.AsTable(b))
+}
+
+// AssertIsPermutationRows asserts that the rows of b are a permutation of the
+// rows of a, i.e. a and b are equal as multisets of tuples. It panics if the
+// number of rows differ or the rows have different lengths.
+func AssertIsPermutationRows(api frontend.API, a, b [][]frontend.Variable) {
+	if err := logderivarg.BuildPermutation(api, a, b); err != nil {
+		panic(fmt.Sprintf("permutation: %v", err))
+	}
+}
+
+// Sort returns the elements of a sorted in non-decreasing order. All elements
+// of a must be less than 2^nbBits, which is asserted. As we check the
+// non-negativity of the differences of consecutive elements, nbBits must be
+// smaller than the bit length of the native field minus one.
+func Sort(api frontend.API, a []frontend.Variable, nbBits int) []frontend.Variable {
+	if nbBits < 1 || nbBits > api.Compiler().FieldBitLen()-2 {
+		panic(fmt.Sprintf("bit length %d not in [1, %d]", nbBits, api.Compiler().FieldBitLen()-2))
+	}
+	if len(a) == 0 {
+		return nil
+	}
+	// the range checker defers its own commitment. We initialize it before
+	// the permutation check to schedule it before closing the commitment.
+	rchecker := rangecheck.New(api)
+	sorted, err := api.Compiler().NewHint(sortHint, len(a), a...)
+	if err != nil {
+		panic(fmt.Sprintf("sort hint: %v", err))
+	}
+	AssertIsPermutation(api, a, sorted)
+	// all elements are less than 2^nbBits. As the native modulus is larger
+	// than 2^(nbBits+1), then for sorted[i-1] > sorted[i] the difference
+	// wraps around and is at least 2^nbBits.
+	for i := range sorted {
+		rchecker.Check(sorted[i], nbBits)
+		if i > 0 {
+			rchecker.Check(api.Sub(sorted[i], sorted[i-1]), nbBits)
+		}
+	}
+	return sorted
+}
+
+func sortHint(_ *big.Int, inputs []*big.Int, outputs []*big.Int) error {
+	if len(inputs) != len(outputs) {
+		return fmt.Errorf("input and output length mismatch")
+	}
+	vals := make([]*big.Int, len(inputs))
+	copy(vals, inputs)
+	sort.Slice(vals, func(i, j int) bool { return vals[i].Cmp(vals[j]) < 0 })
+	for i := range vals {
+		outputs[i].Set(vals[i])
+	}
+	return nil
+}
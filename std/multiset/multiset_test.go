@@ -0,0 +1,116 @@
+package multiset
+
+import (
+	"crypto/rand"
+	"math/big"
+	mrand "math/rand"
+	"sort"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/test"
+)
+
+type PermutationCircuit struct {
+	A, B [10]frontend.Variable
+}
+
+func (c *PermutationCircuit) Define(api frontend.API) error {
+	AssertIsPermutation(api, c.A[:], c.B[:])
+	return nil
+}
+
+func TestPermutation(t *testing.T) {
+	assert := test.NewAssert(t)
+	var witness PermutationCircuit
+	perm := mrand.Perm(len(witness.A)) //#nosec G404 -- test only
+	for i := range witness.A {
+		v, _ := rand.Int(rand.Reader, ecc.BN254.ScalarField())
+		witness.A[i] = v
+		witness.B[perm[i]] = v
+	}
+	// duplicate element
+	witness.A[1] = witness.A[0]
+	witness.B[perm[1]] = witness.A[0]
+	assert.ProverSucceeded(&PermutationCircuit{}, &witness,
+		test.WithCurves(ecc.BN254),
+		test.WithBackends(backend.GROTH16, backend.PLONK))
+
+	// changing multiplicity must fail
+	witness.B[perm[2]] = witness.A[0]
+	err := test.IsSolved(&PermutationCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type PermutationRowsCircuit struct {
+	A, B [10][3]frontend.Variable
+}
+
+func (c *PermutationRowsCircuit) Define(api frontend.API) error {
+	a := make([][]frontend.Variable, len(c.A))
+	b := make([][]frontend.Variable, len(c.B))
+	for i := range c.A {
+		a[i] = c.A[i][:]
+		b[i] = c.B[i][:]
+	}
+	AssertIsPermutationRows(api, a, b)
+	return nil
+}
+
+func TestPermutationRows(t *testing.T) {
+	assert := test.NewAssert(t)
+	var witness PermutationRowsCircuit
+	perm := mrand.Perm(len(witness.A)) //#nosec G404 -- test only
+	for i := range witness.A {
+		for j := range witness.A[i] {
+			witness.A[i][j] = i*3 + j
+			witness.B[perm[i]][j] = i*3 + j
+		}
+	}
+	err := test.IsSolved(&PermutationRowsCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// permuting the elements of a row must fail
+	witness.B[0][0], witness.B[0][1] = witness.B[0][1], witness.B[0][0]
+	err = test.IsSolved(&PermutationRowsCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type SortCircuit struct {
+	In, Expected [20]frontend.Variable
+}
+
+func (c *SortCircuit) Define(api frontend.API) error {
+	res := Sort(api, c.In[:], 64)
+	for i := range res {
+		api.AssertIsEqual(res[i], c.Expected[i])
+	}
+	return nil
+}
+
+func TestSort(t *testing.T) {
+	assert := test.NewAssert(t)
+	var witness SortCircuit
+	vals := make([]uint64, len(witness.In))
+	for i := range vals {
+		vals[i] = mrand.Uint64() //#nosec G404 -- test only
+		if i%5 == 0 {
+			vals[i] = vals[0]
+		}
+		witness.In[i] = vals[i]
+	}
+	sort.Slice(vals, func(i, j int) bool { return vals[i] < vals[j] })
+	for i := range vals {
+		witness.Expected[i] = vals[i]
+	}
+	assert.ProverSucceeded(&SortCircuit{}, &witness,
+		test.WithCurves(ecc.BN254),
+		test.WithBackends(backend.GROTH16, backend.PLONK))
+
+	// inputs out of range must fail
+	witness.In[0] = new(big.Int).Lsh(big.NewInt(1), 64)
+	err := test.IsSolved(&SortCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
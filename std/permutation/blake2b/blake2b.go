@@ -0,0 +1,121 @@
+// Package blake2b implements the BLAKE2b compression function F as defined in
+// [RFC 7693].
+//
+// Differently from the standard, the number of rounds is a parameter of the
+// function for compatibility with the BLAKE2F precompile in Ethereum (see
+// [EIP-152]). It is either fixed at compile time ([Permute]) or a variable
+// bounded at compile time ([PermuteVariable]). For the standard BLAKE2b the
+// number of rounds is 12.
+//
+// [RFC 7693]: https://www.rfc-editor.org/rfc/rfc7693
+// [EIP-152]: https://eips.ethereum.org/EIPS/eip-152
+package blake2b
+
+import (
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+var _IV = uints.NewU64Array([]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+})
+
+var _SIGMA = [10][16]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+}
+
+// mix is the mixing function G.
+func mix(uapi *uints.BinaryField[uints.U64], v *[16]uints.U64, a, b, c, d int, x, y uints.U64) {
+	v[a] = uapi.Add(v[a], v[b], x)
+	v[d] = uapi.Lrot(uapi.Xor(v[d], v[a]), -32)
+	v[c] = uapi.Add(v[c], v[d])
+	v[b] = uapi.Lrot(uapi.Xor(v[b], v[c]), -24)
+	v[a] = uapi.Add(v[a], v[b], y)
+	v[d] = uapi.Lrot(uapi.Xor(v[d], v[a]), -16)
+	v[c] = uapi.Add(v[c], v[d])
+	v[b] = uapi.Lrot(uapi.Xor(v[b], v[c]), -63)
+}
+
+// Permute applies the BLAKE2b compression function F with the given number of
+// rounds on the hash state h with the 128-byte message block m, offset counter
+// t and finalization flags f and returns the new hash state. The message block
+// is given as 64-bit little-endian words.
+//
+// The finalization flags are words which are either all zero or all one. For
+// the last block, the first flag has to be set. The second flag is only used
+// in tree hashing mode and is zero otherwise.
+func Permute(uapi *uints.BinaryField[uints.U64], rounds int, h [8]uints.U64, m [16]uints.U64, t [2]uints.U64, f [2]uints.U64) (newHash [8]uints.U64) {
+	v := initState(uapi, h, t, f)
+	for i := 0; i < rounds; i++ {
+		round(uapi, &v, m, i)
+	}
+	return finalize(uapi, h, v)
+}
+
+// PermuteVariable applies the BLAKE2b compression function F as [Permute], but
+// the number of rounds is a variable. The circuit always computes maxRounds
+// rounds and keeps the state after the given number of rounds, so the circuit
+// size depends on maxRounds and not on rounds. It asserts that rounds is at
+// most maxRounds.
+func PermuteVariable(api frontend.API, uapi *uints.BinaryField[uints.U64], maxRounds int, rounds frontend.Variable, h [8]uints.U64, m [16]uints.U64, t [2]uints.U64, f [2]uints.U64) (newHash [8]uints.U64) {
+	v := initState(uapi, h, t, f)
+	// active is 1 if the i-th round is applied, i.e. if rounds > i.
+	active := api.Sub(1, api.IsZero(rounds))
+	for i := 0; i < maxRounds; i++ {
+		nv := v
+		round(uapi, &nv, m, i)
+		for j := range v {
+			for k := range v[j] {
+				v[j][k] = uints.U8{Val: api.Select(active, nv[j][k].Val, v[j][k].Val)}
+			}
+		}
+		active = api.Mul(active, api.Sub(1, api.IsZero(api.Sub(rounds, i+1))))
+	}
+	// if rounds is larger than maxRounds, then all the rounds were active.
+	api.AssertIsEqual(active, 0)
+	return finalize(uapi, h, v)
+}
+
+// initState initializes the local work vector from the hash state h, offset
+// counter t and finalization flags f.
+func initState(uapi *uints.BinaryField[uints.U64], h [8]uints.U64, t [2]uints.U64, f [2]uints.U64) (v [16]uints.U64) {
+	copy(v[:8], h[:])
+	copy(v[8:], _IV)
+	v[12] = uapi.Xor(v[12], t[0])
+	v[13] = uapi.Xor(v[13], t[1])
+	v[14] = uapi.Xor(v[14], f[0])
+	v[15] = uapi.Xor(v[15], f[1])
+	return v
+}
+
+// round applies the i-th round of mixing on the local work vector v.
+func round(uapi *uints.BinaryField[uints.U64], v *[16]uints.U64, m [16]uints.U64, i int) {
+	s := &_SIGMA[i%10]
+	mix(uapi, v, 0, 4, 8, 12, m[s[0]], m[s[1]])
+	mix(uapi, v, 1, 5, 9, 13, m[s[2]], m[s[3]])
+	mix(uapi, v, 2, 6, 10, 14, m[s[4]], m[s[5]])
+	mix(uapi, v, 3, 7, 11, 15, m[s[6]], m[s[7]])
+	mix(uapi, v, 0, 5, 10, 15, m[s[8]], m[s[9]])
+	mix(uapi, v, 1, 6, 11, 12, m[s[10]], m[s[11]])
+	mix(uapi, v, 2, 7, 8, 13, m[s[12]], m[s[13]])
+	mix(uapi, v, 3, 4, 9, 14, m[s[14]], m[s[15]])
+}
+
+// finalize computes the new hash state from the hash state h and the local
+// work vector v.
+func finalize(uapi *uints.BinaryField[uints.U64], h [8]uints.U64, v [16]uints.U64) (newHash [8]uints.U64) {
+	for i := range newHash {
+		newHash[i] = uapi.Xor(h[i], v[i], v[i+8])
+	}
+	return newHash
+}
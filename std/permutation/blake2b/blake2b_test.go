@@ -0,0 +1,68 @@
+package blake2b_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/std/permutation/blake2b"
+	"github.com/consensys/gnark/test"
+	nativeblake2b "golang.org/x/crypto/blake2b"
+)
+
+type blake2bCircuit struct {
+	H        [8]uints.U64
+	M        [16]uints.U64
+	T        [2]uints.U64
+	F        [2]uints.U64
+	Expected [8]uints.U64
+}
+
+func (c *blake2bCircuit) Define(api frontend.API) error {
+	uapi, err := uints.New[uints.U64](api)
+	if err != nil {
+		return err
+	}
+	res := blake2b.Permute(uapi, 12, c.H, c.M, c.T, c.F)
+	for i := range res {
+		uapi.AssertEq(res[i], c.Expected[i])
+	}
+	return nil
+}
+
+func TestBlake2bSingleBlock(t *testing.T) {
+	// a message fitting into a single block is hashed with a single call of
+	// the compression function with the finalization flag set.
+	iv := []uint64{
+		0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+		0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+	}
+	for _, l := range []int{0, 3, 128} {
+		msg := make([]byte, 128)
+		for i := 0; i < l; i++ {
+			msg[i] = byte(i)
+		}
+		dgst := nativeblake2b.Sum512(msg[:l])
+		var witness blake2bCircuit
+		for i := range witness.H {
+			h := iv[i]
+			if i == 0 {
+				// parameter block: digest length 64, no key, fanout and depth 1
+				h ^= 0x01010040
+			}
+			witness.H[i] = uints.NewU64(h)
+			witness.Expected[i] = uints.NewU64(binary.LittleEndian.Uint64(dgst[8*i:]))
+		}
+		for i := range witness.M {
+			witness.M[i] = uints.NewU64(binary.LittleEndian.Uint64(msg[8*i:]))
+		}
+		witness.T = [2]uints.U64{uints.NewU64(uint64(l)), uints.NewU64(0)}
+		witness.F = [2]uints.U64{uints.NewU64(^uint64(0)), uints.NewU64(0)}
+		err := test.IsSolved(&blake2bCircuit{}, &witness, ecc.BN254.ScalarField())
+		if err != nil {
+			t.Fatalf("length %d: %v", l, err)
+		}
+	}
+}
@@ -5,6 +5,10 @@
 //   - if the backend supports creating a commitment of variables by implementing [frontend.Committer], then we use the log-derivative variant [[Haböck22]] of the product argument as in [[BCG+18]] . [r1cs.NewBuilder] returns a builder which implements this interface;
 //   - lacking these, we perform binary decomposition of variable into bits.
 //
+// The checks of all widths are collected into a single decomposition table.
+// For checking against bounds which are not powers of two, see [CheckBound]
+// and [CheckVariableBound].
+//
 // [BCG+18]: https://eprint.iacr.org/2018/380
 // [Haböck22]: https://eprint.iacr.org/2022/1530
 package rangecheck
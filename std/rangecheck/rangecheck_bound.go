@@ -0,0 +1,47 @@
+package rangecheck
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+)
+
+// CheckBound asserts that 0 <= v < bound for a positive constant bound. The
+// bound does not have to be a power of two. If it is not, then in addition to
+// range checking v we range check bound-1-v with the same width, so the cost is
+// two range checks of (bound-1).BitLen() bits. It panics if the bound is not
+// positive or if the bound is too large for the native field.
+func CheckBound(api frontend.API, v frontend.Variable, bound *big.Int) {
+	if bound.Sign() <= 0 {
+		panic("bound must be positive")
+	}
+	upper := new(big.Int).Sub(bound, big.NewInt(1))
+	nbBits := upper.BitLen()
+	// for v >= bound, the difference bound-1-v wraps around the modulus and
+	// has to be larger than 2^nbBits.
+	if nbBits+1 >= api.Compiler().FieldBitLen() {
+		panic(fmt.Sprintf("bound of %d bits too large for native field", nbBits))
+	}
+	rchecker := New(api)
+	rchecker.Check(v, nbBits)
+	if bound.Cmp(new(big.Int).Lsh(big.NewInt(1), uint(nbBits))) == 0 {
+		// the bound is a power of two, the range check is sufficient.
+		return
+	}
+	rchecker.Check(api.Sub(upper, v), nbBits)
+}
+
+// CheckVariableBound asserts that 0 <= v < bound, where the bound is a variable.
+// The caller must ensure that bound <= 2^nbBits, for example by range checking
+// it, as otherwise the assertion may fail for valid inputs. The cost is two
+// range checks of nbBits bits. It panics if nbBits is too large for the native
+// field.
+func CheckVariableBound(api frontend.API, v, bound frontend.Variable, nbBits int) {
+	if nbBits+1 >= api.Compiler().FieldBitLen() {
+		panic(fmt.Sprintf("bound of %d bits too large for native field", nbBits))
+	}
+	rchecker := New(api)
+	rchecker.Check(v, nbBits)
+	rchecker.Check(api.Sub(bound, v, 1), nbBits)
+}
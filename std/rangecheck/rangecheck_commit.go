@@ -92,6 +92,14 @@ func (c *commitChecker) commit(api frontend.API) error {
 			composed = api.Add(composed, api.Mul(limbs[j], new(big.Int).Exp(base, big.NewInt(int64(j)), nil)))
 		}
 		api.AssertIsEqual(composed, c.collected[i].v)
+		// the decomposition only ensures that the value is less than
+		// 2^(nbLimbs*baseLength). If the width is not a multiple of the base
+		// length, then we additionally check the shifted most significant limb
+		// to be in range.
+		if rem := c.collected[i].bits % baseLength; rem != 0 {
+			shift := new(big.Int).Lsh(big.NewInt(1), uint(baseLength-rem))
+			decomposed = append(decomposed, api.Mul(limbs[nbLimbs-1], shift))
+		}
 	}
 	nbTable := 1 << baseLength
 	return logderivarg.Build(api, logderivarg.AsTable(c.buildTable(nbTable)), logderivarg.AsTable(decomposed))
@@ -101,6 +109,17 @@ func decompSize(varSize int, limbSize int) int {
 	return (varSize + limbSize - 1) / limbSize
 }
 
+// nbQueries returns the number of table queries for range checking a value of
+// varSize bits. It is the number of limbs and one additional query for the
+// shifted most significant limb if it is not full width.
+func nbQueries(varSize int, limbSize int) int {
+	res := decompSize(varSize, limbSize)
+	if varSize%limbSize != 0 {
+		res++
+	}
+	return res
+}
+
 // DecomposeHint is a hint used for range checking with commitment. It
 // decomposes large variables into chunks which can be individually range-check
 // in the native range.
@@ -155,7 +174,7 @@ func optimalWidth(countFn func(baseLength int, collected []checkedVariable) int,
 func nbR1CSConstraints(baseLength int, collected []checkedVariable) int {
 	nbDecomposed := 0
 	for i := range collected {
-		nbDecomposed += int(decompSize(collected[i].bits, baseLength))
+		nbDecomposed += nbQueries(collected[i].bits, baseLength)
 	}
 	eqs := len(collected)       // correctness of decomposition
 	nbRight := nbDecomposed     // inverse per decomposed
@@ -166,7 +185,7 @@ func nbR1CSConstraints(baseLength int, collected []checkedVariable) int {
 func nbPLONKConstraints(baseLength int, collected []checkedVariable) int {
 	nbDecomposed := 0
 	for i := range collected {
-		nbDecomposed += int(decompSize(collected[i].bits, baseLength))
+		nbDecomposed += nbQueries(collected[i].bits, baseLength)
 	}
 	eqs := nbDecomposed               // check correctness of every decomposition. this is nbDecomp adds + eq cost per collected
 	nbRight := 3 * nbDecomposed       // denominator sub, inv and large sum per table entry
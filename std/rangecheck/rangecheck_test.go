@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/backend"
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/frontend/cs/r1cs"
 	"github.com/consensys/gnark/test"
@@ -44,3 +45,46 @@ func TestCheck(t *testing.T) {
 	_, err = frontend.Compile(ecc.BN254.ScalarField(), r1cs.NewBuilder, &circuit, frontend.WithCompressThreshold(100))
 	assert.NoError(err)
 }
+
+func TestCheckUnaligned(t *testing.T) {
+	assert := test.NewAssert(t)
+	bits := 13
+	bound := new(big.Int).Lsh(big.NewInt(1), uint(bits))
+	upper := new(big.Int).Sub(bound, big.NewInt(1))
+	circuit := CheckCircuit{Vals: make([]frontend.Variable, 2), bits: bits}
+	err := test.IsSolved(&circuit, &CheckCircuit{Vals: []frontend.Variable{0, upper}, bits: bits}, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	err = test.IsSolved(&circuit, &CheckCircuit{Vals: []frontend.Variable{0, bound}, bits: bits}, ecc.BN254.ScalarField())
+	assert.Error(err)
+	assert.ProverFailed(&circuit, &CheckCircuit{Vals: []frontend.Variable{0, bound}, bits: bits},
+		test.WithCurves(ecc.BN254), test.WithBackends(backend.GROTH16, backend.PLONK))
+}
+
+type BoundCircuit struct {
+	V, Bound frontend.Variable
+	bound    *big.Int
+}
+
+func (c *BoundCircuit) Define(api frontend.API) error {
+	CheckBound(api, c.V, c.bound)
+	CheckVariableBound(api, c.V, c.Bound, c.bound.BitLen())
+	return nil
+}
+
+func TestCheckBound(t *testing.T) {
+	assert := test.NewAssert(t)
+	bound := big.NewInt(1000)
+	circuit := BoundCircuit{bound: bound}
+	for _, tc := range []struct {
+		v       int64
+		success bool
+	}{{0, true}, {999, true}, {1000, false}, {1023, false}, {1024, false}, {-1, false}} {
+		v := new(big.Int).Mod(big.NewInt(tc.v), ecc.BN254.ScalarField())
+		err := test.IsSolved(&circuit, &BoundCircuit{V: v, Bound: bound, bound: bound}, ecc.BN254.ScalarField())
+		if tc.success {
+			assert.NoError(err, tc.v)
+		} else {
+			assert.Error(err, tc.v)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+/*
+Package bls implements BLS signature verification.
+
+BLS signatures are verified using a pairing check. The messages are hashed
+using package [github.com/consensys/gnark/std/hash/hashtocurve]. The
+verification for specific curves is implemented in the sub-packages:
+  - [github.com/consensys/gnark/std/signature/bls/bls12381] for signatures over
+    BLS12-381 using field emulation (as used in the Ethereum beacon chain);
+  - [github.com/consensys/gnark/std/signature/bls/bls12377] for signatures over
+    BLS12-377 in a BW6-761 circuit (2-chain).
+
+See [draft-irtf-cfrg-bls-signature] for the description of the scheme.
+
+[draft-irtf-cfrg-bls-signature]: https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature-05
+*/
+package bls
@@ -0,0 +1,239 @@
+/*
+Package bls12377 implements BLS signature verification over the BLS12-377
+curve.
+
+The signatures and hashed messages are in G1 and the public keys are in G2
+(minimal-signature-size variant). As the base field of BLS12-377 is the scalar
+field of BW6-761, the group and pairing operations are performed natively in
+package [github.com/consensys/gnark/std/algebra/native/sw_bls12377] and the
+verification circuit must be defined over BW6-761 (2-chain).
+
+The messages are hashed to G1 in circuit following RFC 9380 using the suite
+BLS12377G1_XMD:SHA-256_SSWU_RO_.
+
+The package provides verification of single signatures ([Verifier.Verify]),
+multi-signatures on a single message with public keys aggregated under a
+participation bitmask ([Verifier.AggregatePublicKeys] and
+[Verifier.FastAggregateVerify]) and aggregate signatures on distinct messages
+([Verifier.AggregateVerify]).
+
+The public keys are not checked to be in G2 as we assume that they have been
+validated when registered (for example with a proof of possession). The
+signatures are checked to be in G1.
+*/
+package bls12377
+
+import (
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/sw_bls12377"
+	"github.com/consensys/gnark/std/hash/hashtocurve"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// DST is the default domain separation tag used for hashing the messages.
+const DST = "BLS_SIG_BLS12377G1_XMD:SHA-256_SSWU_RO_POP_"
+
+type (
+	// PublicKey is a BLS public key in G2.
+	PublicKey = sw_bls12377.G2Affine
+	// Signature is a BLS signature in G1.
+	Signature = sw_bls12377.G1Affine
+)
+
+// NewPublicKey returns the witness value of the public key pk.
+func NewPublicKey(pk bls12377.G2Affine) PublicKey {
+	var res PublicKey
+	res.Assign(&pk)
+	return res
+}
+
+// NewSignature returns the witness value of the signature sig.
+func NewSignature(sig bls12377.G1Affine) Signature {
+	var res Signature
+	res.Assign(&sig)
+	return res
+}
+
+// Option allows to configure the [Verifier].
+type Option func(*Verifier) error
+
+// WithDST sets the domain separation tag used for hashing the messages. If not
+// set, then [DST] is used.
+func WithDST(dst []byte) Option {
+	return func(v *Verifier) error {
+		if len(dst) > 255 {
+			return fmt.Errorf("domain separation tag too long")
+		}
+		v.dst = dst
+		return nil
+	}
+}
+
+// Verifier verifies BLS signatures over BLS12-377.
+type Verifier struct {
+	api   frontend.API
+	dst   []byte
+	negG2 sw_bls12377.G2Affine
+}
+
+// NewVerifier returns a new [Verifier]. It returns an error if the native field
+// is not the scalar field of BW6-761 or if applying the options fails.
+func NewVerifier(api frontend.API, opts ...Option) (*Verifier, error) {
+	if api.Compiler().Field().Cmp(ecc.BW6_761.ScalarField()) != 0 {
+		return nil, fmt.Errorf("native field must be the scalar field of BW6-761")
+	}
+	_, _, _, g2 := bls12377.Generators()
+	g2.Neg(&g2)
+	v := &Verifier{
+		api: api,
+		dst: []byte(DST),
+	}
+	v.negG2.Assign(&g2)
+	for _, opt := range opts {
+		if err := opt(v); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// HashToG1 hashes the message msg to a point in G1 as defined in RFC 9380
+// using the domain separation tag of the verifier.
+func (v *Verifier) HashToG1(msg []uints.U8) (*sw_bls12377.G1Affine, error) {
+	u, err := v.hashToField(msg, 2)
+	if err != nil {
+		return nil, err
+	}
+	res := sw_bls12377.MapToG1(v.api, u[0], u[1])
+	return &res, nil
+}
+
+// hashToField hashes the message msg to count base field elements as defined in
+// RFC 9380 Section 5.2. As the base field is the native field, the reduction
+// is implicit.
+func (v *Verifier) hashToField(msg []uints.U8, count int) ([]frontend.Variable, error) {
+	// L = ceil((ceil(log2(p)) + k) / 8) for k = 128
+	const L = 64
+	uniformBytes, err := hashtocurve.ExpandMsgXMD(v.api, msg, v.dst, count*L)
+	if err != nil {
+		return nil, fmt.Errorf("expand message: %w", err)
+	}
+	res := make([]frontend.Variable, count)
+	for i := range res {
+		// the bytes are interpreted as a big-endian integer.
+		var e frontend.Variable = 0
+		for j := i * L; j < (i+1)*L; j++ {
+			e = v.api.Add(v.api.Mul(e, 256), uniformBytes[j].Val)
+		}
+		res[i] = e
+	}
+	return res, nil
+}
+
+// Verify asserts that the signature sig is valid for the message msg and the
+// public key pk. It asserts that the signature is in G1.
+//
+// It returns an error if the hashing of the message or the pairing
+// computation fails.
+func (v *Verifier) Verify(pk *PublicKey, sig *Signature, msg []uints.U8) error {
+	h, err := v.HashToG1(msg)
+	if err != nil {
+		return fmt.Errorf("hash to G1: %w", err)
+	}
+	sig.AssertIsOnG1(v.api)
+
+	// e(H(m), pk) * e(sig, -g2) == 1
+	return v.pairingCheck(
+		[]sw_bls12377.G1Affine{*h, *sig},
+		[]sw_bls12377.G2Affine{*pk, v.negG2},
+	)
+}
+
+// pairingCheck asserts that ∏ᵢ e(Pᵢ, Qᵢ) == 1.
+func (v *Verifier) pairingCheck(P []sw_bls12377.G1Affine, Q []sw_bls12377.G2Affine) error {
+	res, err := sw_bls12377.Pair(v.api, P, Q)
+	if err != nil {
+		return fmt.Errorf("pair: %w", err)
+	}
+	var one sw_bls12377.GT
+	one.SetOne()
+	res.AssertIsEqual(v.api, one)
+	return nil
+}
+
+// AggregatePublicKeys returns the sum of the public keys pks for which the
+// corresponding bit in participation is set. It asserts that every element of
+// participation is boolean and that at least one bit is set.
+//
+// The public keys may repeat. It returns an error if the lengths of the inputs
+// do not match.
+func (v *Verifier) AggregatePublicKeys(pks []PublicKey, participation []frontend.Variable) (*PublicKey, error) {
+	if len(pks) != len(participation) {
+		return nil, fmt.Errorf("got %d public keys and %d participation bits", len(pks), len(participation))
+	}
+	if len(pks) == 0 {
+		return nil, fmt.Errorf("no public keys")
+	}
+	// (0,0) is the point at infinity for the unified addition.
+	var res PublicKey
+	res.X.SetZero()
+	res.Y.SetZero()
+	var nbParticipants frontend.Variable = 0
+	for i := range pks {
+		v.api.AssertIsBoolean(participation[i])
+		nbParticipants = v.api.Add(nbParticipants, participation[i])
+		sum := res
+		sum.AddUnified(v.api, pks[i])
+		res.Select(v.api, participation[i], sum, res)
+	}
+	v.api.AssertIsDifferent(nbParticipants, 0)
+	return &res, nil
+}
+
+// FastAggregateVerify asserts that the signature sig is a valid multi-signature
+// on the message msg by the public keys pks for which the corresponding bit in
+// participation is set. See [Verifier.AggregatePublicKeys].
+func (v *Verifier) FastAggregateVerify(pks []PublicKey, participation []frontend.Variable, sig *Signature, msg []uints.U8) error {
+	apk, err := v.AggregatePublicKeys(pks, participation)
+	if err != nil {
+		return fmt.Errorf("aggregate public keys: %w", err)
+	}
+	return v.Verify(apk, sig, msg)
+}
+
+// AggregateVerify asserts that the signature sig is a valid aggregate signature
+// on the messages msgs, where the message msgs[i] is signed by the public key
+// pks[i]. It asserts that the signature is in G1.
+//
+// The messages are assumed to be distinct (or signed with a proof of
+// possession of the public keys). It returns an error if the lengths of the
+// inputs do not match or if the hashing of the messages or the pairing
+// computation fails.
+func (v *Verifier) AggregateVerify(pks []PublicKey, sig *Signature, msgs [][]uints.U8) error {
+	if len(pks) != len(msgs) {
+		return fmt.Errorf("got %d public keys and %d messages", len(pks), len(msgs))
+	}
+	if len(pks) == 0 {
+		return fmt.Errorf("no public keys")
+	}
+	sig.AssertIsOnG1(v.api)
+
+	// ∏ᵢ e(H(mᵢ), pkᵢ) * e(sig, -g2) == 1
+	P := make([]sw_bls12377.G1Affine, 0, len(pks)+1)
+	Q := make([]sw_bls12377.G2Affine, 0, len(pks)+1)
+	for i := range pks {
+		h, err := v.HashToG1(msgs[i])
+		if err != nil {
+			return fmt.Errorf("hash to G1: %w", err)
+		}
+		P = append(P, *h)
+		Q = append(Q, pks[i])
+	}
+	P = append(P, *sig)
+	Q = append(Q, v.negG2)
+	return v.pairingCheck(P, Q)
+}
@@ -0,0 +1,154 @@
+package bls12377
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+func keyGen(assert *test.Assert) (*big.Int, bls12377.G2Affine) {
+	sk, err := rand.Int(rand.Reader, fr.Modulus())
+	assert.NoError(err)
+	_, _, _, g2 := bls12377.Generators()
+	var pk bls12377.G2Affine
+	pk.ScalarMultiplication(&g2, sk)
+	return sk, pk
+}
+
+func sign(assert *test.Assert, sks []*big.Int, msg []byte) bls12377.G1Affine {
+	h, err := bls12377.HashToG1(msg, []byte(DST))
+	assert.NoError(err)
+	sk := new(big.Int)
+	for i := range sks {
+		sk.Add(sk, sks[i])
+	}
+	var sig bls12377.G1Affine
+	sig.ScalarMultiplication(&h, sk)
+	return sig
+}
+
+type verifyCircuit struct {
+	PublicKey PublicKey
+	Signature Signature
+	Msg       []uints.U8
+}
+
+func (c *verifyCircuit) Define(api frontend.API) error {
+	v, err := NewVerifier(api)
+	if err != nil {
+		return err
+	}
+	return v.Verify(&c.PublicKey, &c.Signature, c.Msg)
+}
+
+func TestVerify(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("a message to sign")
+	sk, pk := keyGen(assert)
+	sig := sign(assert, []*big.Int{sk}, msg)
+	circuit := verifyCircuit{Msg: make([]uints.U8, len(msg))}
+	witness := verifyCircuit{
+		PublicKey: NewPublicKey(pk),
+		Signature: NewSignature(sig),
+		Msg:       uints.NewU8Array(msg),
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BW6_761.ScalarField())
+	assert.NoError(err)
+
+	wrongMsg := []byte("a message to sigN")
+	witness.Msg = uints.NewU8Array(wrongMsg)
+	err = test.IsSolved(&circuit, &witness, ecc.BW6_761.ScalarField())
+	assert.Error(err)
+}
+
+type fastAggregateVerifyCircuit struct {
+	PublicKeys    []PublicKey
+	Participation []frontend.Variable
+	Signature     Signature
+	Msg           []uints.U8
+}
+
+func (c *fastAggregateVerifyCircuit) Define(api frontend.API) error {
+	v, err := NewVerifier(api)
+	if err != nil {
+		return err
+	}
+	return v.FastAggregateVerify(c.PublicKeys, c.Participation, &c.Signature, c.Msg)
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("sync committee signing root")
+	sk0, pk0 := keyGen(assert)
+	sk1, pk1 := keyGen(assert)
+	_, pk2 := keyGen(assert)
+	// the first key appears twice and the third key does not participate.
+	pks := []bls12377.G2Affine{pk0, pk1, pk2, pk0}
+	participation := []frontend.Variable{1, 1, 0, 1}
+	sig := sign(assert, []*big.Int{sk0, sk1, sk0}, msg)
+
+	circuit := fastAggregateVerifyCircuit{
+		PublicKeys:    make([]PublicKey, len(pks)),
+		Participation: make([]frontend.Variable, len(pks)),
+		Msg:           make([]uints.U8, len(msg)),
+	}
+	witness := fastAggregateVerifyCircuit{
+		PublicKeys:    make([]PublicKey, len(pks)),
+		Participation: participation,
+		Signature:     NewSignature(sig),
+		Msg:           uints.NewU8Array(msg),
+	}
+	for i := range pks {
+		witness.PublicKeys[i] = NewPublicKey(pks[i])
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BW6_761.ScalarField())
+	assert.NoError(err)
+
+	witness.Participation = []frontend.Variable{1, 1, 1, 1}
+	err = test.IsSolved(&circuit, &witness, ecc.BW6_761.ScalarField())
+	assert.Error(err)
+}
+
+type aggregateVerifyCircuit struct {
+	PublicKeys []PublicKey
+	Signature  Signature
+	Msgs       [][]uints.U8
+}
+
+func (c *aggregateVerifyCircuit) Define(api frontend.API) error {
+	v, err := NewVerifier(api)
+	if err != nil {
+		return err
+	}
+	return v.AggregateVerify(c.PublicKeys, &c.Signature, c.Msgs)
+}
+
+func TestAggregateVerify(t *testing.T) {
+	assert := test.NewAssert(t)
+	msgs := [][]byte{[]byte("first message"), []byte("second message")}
+	sk0, pk0 := keyGen(assert)
+	sk1, pk1 := keyGen(assert)
+	sig0 := sign(assert, []*big.Int{sk0}, msgs[0])
+	sig1 := sign(assert, []*big.Int{sk1}, msgs[1])
+	var sig bls12377.G1Affine
+	sig.Add(&sig0, &sig1)
+
+	circuit := aggregateVerifyCircuit{
+		PublicKeys: make([]PublicKey, 2),
+		Msgs:       [][]uints.U8{make([]uints.U8, len(msgs[0])), make([]uints.U8, len(msgs[1]))},
+	}
+	witness := aggregateVerifyCircuit{
+		PublicKeys: []PublicKey{NewPublicKey(pk0), NewPublicKey(pk1)},
+		Signature:  NewSignature(sig),
+		Msgs:       [][]uints.U8{uints.NewU8Array(msgs[0]), uints.NewU8Array(msgs[1])},
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BW6_761.ScalarField())
+	assert.NoError(err)
+}
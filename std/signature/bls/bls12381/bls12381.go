@@ -0,0 +1,229 @@
+/*
+Package bls12381 implements BLS signature verification over the BLS12-381
+curve.
+
+The public keys are in G1 and the signatures and hashed messages are in G2
+(minimal-pubkey-size variant), as used in the Ethereum beacon chain. As the
+curve is not defined over the native field of any SNARK curve, the group and
+pairing operations are performed using field emulation in package
+[github.com/consensys/gnark/std/algebra/emulated/sw_bls12381].
+
+The messages are hashed to G2 in circuit following RFC 9380 using the suite
+BLS12381G2_XMD:SHA-256_SSWU_RO_.
+
+The package provides verification of single signatures ([Verifier.Verify]),
+multi-signatures on a single message with public keys aggregated under a
+participation bitmask ([Verifier.AggregatePublicKeys] and
+[Verifier.FastAggregateVerify]) and aggregate signatures on distinct messages
+([Verifier.AggregateVerify]).
+
+The public keys used in the pairing check are validated as in KeyValidate of
+the BLS signature draft: they are asserted to be in G1 and not the point at
+infinity. For [Verifier.FastAggregateVerify] only the aggregated key is
+validated, which also rejects participating keys cancelling each other. The
+individual keys are assumed to have a proof of possession verified when
+registered. The signatures are checked to be in G2.
+*/
+package bls12381
+
+import (
+	"fmt"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_bls12381"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/hash/hashtocurve"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// DST is the default domain separation tag used for hashing the messages. It
+// corresponds to the proof-of-possession ciphersuite used in Ethereum.
+const DST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+
+type (
+	// PublicKey is a BLS public key in G1.
+	PublicKey = sw_bls12381.G1Affine
+	// Signature is a BLS signature in G2.
+	Signature = sw_bls12381.G2Affine
+)
+
+// NewPublicKey returns the witness value of the public key pk.
+func NewPublicKey(pk bls12381.G1Affine) PublicKey {
+	return sw_bls12381.NewG1Affine(pk)
+}
+
+// NewSignature returns the witness value of the signature sig.
+func NewSignature(sig bls12381.G2Affine) Signature {
+	return sw_bls12381.NewG2Affine(sig)
+}
+
+// Option allows to configure the [Verifier].
+type Option func(*Verifier) error
+
+// WithDST sets the domain separation tag used for hashing the messages. If not
+// set, then [DST] is used.
+func WithDST(dst []byte) Option {
+	return func(v *Verifier) error {
+		if len(dst) > 255 {
+			return fmt.Errorf("domain separation tag too long")
+		}
+		v.dst = dst
+		return nil
+	}
+}
+
+// Verifier verifies BLS signatures over BLS12-381.
+type Verifier struct {
+	api     frontend.API
+	dst     []byte
+	fp      *emulated.Field[emulated.BLS12381Fp]
+	curve   *sw_emulated.Curve[emulated.BLS12381Fp, emulated.BLS12381Fr]
+	pairing *sw_bls12381.Pairing
+}
+
+// NewVerifier returns a new [Verifier]. It returns an error if initialising the
+// field emulation or applying the options fails.
+func NewVerifier(api frontend.API, opts ...Option) (*Verifier, error) {
+	fp, err := emulated.NewField[emulated.BLS12381Fp](api)
+	if err != nil {
+		return nil, fmt.Errorf("new base field: %w", err)
+	}
+	curve, err := sw_emulated.New[emulated.BLS12381Fp, emulated.BLS12381Fr](api, sw_emulated.GetBLS12381Params())
+	if err != nil {
+		return nil, fmt.Errorf("new curve: %w", err)
+	}
+	pairing, err := sw_bls12381.NewPairing(api)
+	if err != nil {
+		return nil, fmt.Errorf("new pairing: %w", err)
+	}
+	v := &Verifier{
+		api:     api,
+		dst:     []byte(DST),
+		fp:      fp,
+		curve:   curve,
+		pairing: pairing,
+	}
+	for _, opt := range opts {
+		if err := opt(v); err != nil {
+			return nil, fmt.Errorf("apply option: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// HashToG2 hashes the message msg to a point in G2 as defined in RFC 9380
+// using the domain separation tag of the verifier.
+func (v *Verifier) HashToG2(msg []uints.U8) (*sw_bls12381.G2Affine, error) {
+	return hashtocurve.HashToBLS12381G2(v.api, msg, v.dst)
+}
+
+// Verify asserts that the signature sig is valid for the message msg and the
+// public key pk. It asserts that the public key is in G1 and is not the point
+// at infinity and that the signature is in G2.
+//
+// It returns an error if the hashing of the message or the pairing
+// computation fails.
+func (v *Verifier) Verify(pk *PublicKey, sig *Signature, msg []uints.U8) error {
+	h, err := v.HashToG2(msg)
+	if err != nil {
+		return fmt.Errorf("hash to G2: %w", err)
+	}
+	v.assertPublicKey(pk)
+	v.pairing.AssertIsOnG2(sig)
+
+	// e(pk, H(m)) * e(-g1, sig) == 1
+	if err := v.pairing.PairingCheck(
+		[]*sw_bls12381.G1Affine{pk, v.curve.Neg(v.curve.Generator())},
+		[]*sw_bls12381.G2Affine{h, sig},
+	); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
+
+// assertPublicKey asserts that the public key pk is in G1 and is not the point
+// at infinity. The latter is needed as (0,0) satisfies the curve and subgroup
+// checks, but the pairing with it is always one.
+func (v *Verifier) assertPublicKey(pk *PublicKey) {
+	v.pairing.AssertIsOnG1(pk)
+	isInfinity := v.api.And(v.fp.IsZero(&pk.X), v.fp.IsZero(&pk.Y))
+	v.api.AssertIsEqual(isInfinity, 0)
+}
+
+// AggregatePublicKeys returns the sum of the public keys pks for which the
+// corresponding bit in participation is set. It asserts that every element of
+// participation is boolean and that at least one bit is set.
+//
+// The public keys may repeat. It returns an error if the lengths of the inputs
+// do not match.
+func (v *Verifier) AggregatePublicKeys(pks []PublicKey, participation []frontend.Variable) (*PublicKey, error) {
+	if len(pks) != len(participation) {
+		return nil, fmt.Errorf("got %d public keys and %d participation bits", len(pks), len(participation))
+	}
+	if len(pks) == 0 {
+		return nil, fmt.Errorf("no public keys")
+	}
+	// (0,0) is the point at infinity for the unified addition.
+	zero := v.fp.Zero()
+	res := &PublicKey{X: *zero, Y: *zero}
+	var nbParticipants frontend.Variable = 0
+	for i := range pks {
+		v.api.AssertIsBoolean(participation[i])
+		nbParticipants = v.api.Add(nbParticipants, participation[i])
+		sum := v.curve.AddUnified(res, &pks[i])
+		res = v.curve.Select(participation[i], sum, res)
+	}
+	v.api.AssertIsDifferent(nbParticipants, 0)
+	return res, nil
+}
+
+// FastAggregateVerify asserts that the signature sig is a valid multi-signature
+// on the message msg by the public keys pks for which the corresponding bit in
+// participation is set. See [Verifier.AggregatePublicKeys].
+func (v *Verifier) FastAggregateVerify(pks []PublicKey, participation []frontend.Variable, sig *Signature, msg []uints.U8) error {
+	apk, err := v.AggregatePublicKeys(pks, participation)
+	if err != nil {
+		return fmt.Errorf("aggregate public keys: %w", err)
+	}
+	return v.Verify(apk, sig, msg)
+}
+
+// AggregateVerify asserts that the signature sig is a valid aggregate signature
+// on the messages msgs, where the message msgs[i] is signed by the public key
+// pks[i]. It asserts that every public key is in G1 and is not the point at
+// infinity and that the signature is in G2.
+//
+// The messages are assumed to be distinct (or signed with a proof of
+// possession of the public keys). It returns an error if the lengths of the
+// inputs do not match or if the hashing of the messages or the pairing
+// computation fails.
+func (v *Verifier) AggregateVerify(pks []PublicKey, sig *Signature, msgs [][]uints.U8) error {
+	if len(pks) != len(msgs) {
+		return fmt.Errorf("got %d public keys and %d messages", len(pks), len(msgs))
+	}
+	if len(pks) == 0 {
+		return fmt.Errorf("no public keys")
+	}
+	v.pairing.AssertIsOnG2(sig)
+
+	// ∏ᵢ e(pkᵢ, H(mᵢ)) * e(-g1, sig) == 1
+	P := make([]*sw_bls12381.G1Affine, 0, len(pks)+1)
+	Q := make([]*sw_bls12381.G2Affine, 0, len(pks)+1)
+	for i := range pks {
+		h, err := v.HashToG2(msgs[i])
+		if err != nil {
+			return fmt.Errorf("hash to G2: %w", err)
+		}
+		v.assertPublicKey(&pks[i])
+		P = append(P, &pks[i])
+		Q = append(Q, h)
+	}
+	P = append(P, v.curve.Neg(v.curve.Generator()))
+	Q = append(Q, sig)
+	if err := v.pairing.PairingCheck(P, Q); err != nil {
+		return fmt.Errorf("pairing check: %w", err)
+	}
+	return nil
+}
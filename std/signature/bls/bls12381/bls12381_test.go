@@ -0,0 +1,245 @@
+package bls12381
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+func keyGen(assert *test.Assert) (*big.Int, bls12381.G1Affine) {
+	sk, err := rand.Int(rand.Reader, fr.Modulus())
+	assert.NoError(err)
+	var pk bls12381.G1Affine
+	pk.ScalarMultiplicationBase(sk)
+	return sk, pk
+}
+
+func sign(assert *test.Assert, sks []*big.Int, msg []byte) bls12381.G2Affine {
+	h, err := bls12381.HashToG2(msg, []byte(DST))
+	assert.NoError(err)
+	sk := new(big.Int)
+	for i := range sks {
+		sk.Add(sk, sks[i])
+	}
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&h, sk)
+	return sig
+}
+
+type verifyCircuit struct {
+	PublicKey PublicKey
+	Signature Signature
+	Msg       []uints.U8
+}
+
+func (c *verifyCircuit) Define(api frontend.API) error {
+	v, err := NewVerifier(api)
+	if err != nil {
+		return err
+	}
+	return v.Verify(&c.PublicKey, &c.Signature, c.Msg)
+}
+
+func TestVerify(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("a message to sign")
+	sk, pk := keyGen(assert)
+	sig := sign(assert, []*big.Int{sk}, msg)
+	circuit := verifyCircuit{Msg: make([]uints.U8, len(msg))}
+	witness := verifyCircuit{
+		PublicKey: NewPublicKey(pk),
+		Signature: NewSignature(sig),
+		Msg:       uints.NewU8Array(msg),
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	wrongMsg := []byte("a message to sigN")
+	witness.Msg = uints.NewU8Array(wrongMsg)
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestVerifyKeyNotInG1(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("a message to sign")
+	sk, pk := keyGen(assert)
+	sig := sign(assert, []*big.Int{sk}, msg)
+	// the pairing of a point of order 3 with G2 is one, so adding it to the
+	// public key does not change the pairing check. The cofactor of G1 is
+	// divisible by 3, so we find such a point by clearing the other factors
+	// of the order of a point on the curve.
+	cofactor, _ := new(big.Int).SetString("396c8c005555e1568c00aaab0000aaab", 16)
+	k := new(big.Int).Mul(cofactor, fr.Modulus())
+	k.Div(k, big.NewInt(3))
+	var R, T bls12381.G1Affine
+	var b fp.Element
+	b.SetUint64(4)
+	for x := uint64(1); T.IsInfinity(); x++ {
+		var y2 fp.Element
+		R.X.SetUint64(x)
+		y2.Square(&R.X).Mul(&y2, &R.X).Add(&y2, &b)
+		if R.Y.Sqrt(&y2) == nil {
+			continue
+		}
+		// double-and-add as the scalar multiplication of gnark-crypto
+		// assumes the point to be in G1.
+		var RJac, TJac bls12381.G1Jac
+		RJac.FromAffine(&R)
+		for i := k.BitLen() - 1; i >= 0; i-- {
+			TJac.DoubleAssign()
+			if k.Bit(i) == 1 {
+				TJac.AddAssign(&RJac)
+			}
+		}
+		T.FromJacobian(&TJac)
+	}
+	var badPk bls12381.G1Affine
+	badPk.Add(&pk, &T)
+	assert.True(badPk.IsOnCurve())
+	assert.False(badPk.IsInSubGroup())
+
+	circuit := verifyCircuit{Msg: make([]uints.U8, len(msg))}
+	witness := verifyCircuit{
+		PublicKey: NewPublicKey(badPk),
+		Signature: NewSignature(sig),
+		Msg:       uints.NewU8Array(msg),
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestVerifyInfinityKey(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("a message to sign")
+	// the pairing check holds for any message with the public key and the
+	// signature at infinity.
+	var pk bls12381.G1Affine
+	var sig bls12381.G2Affine
+	circuit := verifyCircuit{Msg: make([]uints.U8, len(msg))}
+	witness := verifyCircuit{
+		PublicKey: NewPublicKey(pk),
+		Signature: NewSignature(sig),
+		Msg:       uints.NewU8Array(msg),
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type fastAggregateVerifyCircuit struct {
+	PublicKeys    []PublicKey
+	Participation []frontend.Variable
+	Signature     Signature
+	Msg           []uints.U8
+}
+
+func (c *fastAggregateVerifyCircuit) Define(api frontend.API) error {
+	v, err := NewVerifier(api)
+	if err != nil {
+		return err
+	}
+	return v.FastAggregateVerify(c.PublicKeys, c.Participation, &c.Signature, c.Msg)
+}
+
+func TestFastAggregateVerify(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("sync committee signing root")
+	sk0, pk0 := keyGen(assert)
+	sk1, pk1 := keyGen(assert)
+	sk2, pk2 := keyGen(assert)
+	// the first key appears twice and the third key does not participate.
+	pks := []bls12381.G1Affine{pk0, pk1, pk2, pk0}
+	participation := []frontend.Variable{1, 1, 0, 1}
+	sig := sign(assert, []*big.Int{sk0, sk1, sk0}, msg)
+	_ = sk2
+
+	circuit := fastAggregateVerifyCircuit{
+		PublicKeys:    make([]PublicKey, len(pks)),
+		Participation: make([]frontend.Variable, len(pks)),
+		Msg:           make([]uints.U8, len(msg)),
+	}
+	witness := fastAggregateVerifyCircuit{
+		PublicKeys:    make([]PublicKey, len(pks)),
+		Participation: participation,
+		Signature:     NewSignature(sig),
+		Msg:           uints.NewU8Array(msg),
+	}
+	for i := range pks {
+		witness.PublicKeys[i] = NewPublicKey(pks[i])
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	witness.Participation = []frontend.Variable{1, 1, 1, 1}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestFastAggregateVerifyCancellingKeys(t *testing.T) {
+	assert := test.NewAssert(t)
+	msg := []byte("sync committee signing root")
+	_, pk := keyGen(assert)
+	var negPk bls12381.G1Affine
+	negPk.Neg(&pk)
+	// the aggregated key is at infinity, so the signature at infinity would
+	// verify any message.
+	var sig bls12381.G2Affine
+	circuit := fastAggregateVerifyCircuit{
+		PublicKeys:    make([]PublicKey, 2),
+		Participation: make([]frontend.Variable, 2),
+		Msg:           make([]uints.U8, len(msg)),
+	}
+	witness := fastAggregateVerifyCircuit{
+		PublicKeys:    []PublicKey{NewPublicKey(pk), NewPublicKey(negPk)},
+		Participation: []frontend.Variable{1, 1},
+		Signature:     NewSignature(sig),
+		Msg:           uints.NewU8Array(msg),
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+type aggregateVerifyCircuit struct {
+	PublicKeys []PublicKey
+	Signature  Signature
+	Msgs       [][]uints.U8
+}
+
+func (c *aggregateVerifyCircuit) Define(api frontend.API) error {
+	v, err := NewVerifier(api)
+	if err != nil {
+		return err
+	}
+	return v.AggregateVerify(c.PublicKeys, &c.Signature, c.Msgs)
+}
+
+func TestAggregateVerify(t *testing.T) {
+	assert := test.NewAssert(t)
+	msgs := [][]byte{[]byte("first message"), []byte("second message")}
+	sk0, pk0 := keyGen(assert)
+	sk1, pk1 := keyGen(assert)
+	sig0 := sign(assert, []*big.Int{sk0}, msgs[0])
+	sig1 := sign(assert, []*big.Int{sk1}, msgs[1])
+	var sig bls12381.G2Affine
+	sig.Add(&sig0, &sig1)
+
+	circuit := aggregateVerifyCircuit{
+		PublicKeys: make([]PublicKey, 2),
+		Msgs:       [][]uints.U8{make([]uints.U8, len(msgs[0])), make([]uints.U8, len(msgs[1]))},
+	}
+	witness := aggregateVerifyCircuit{
+		PublicKeys: []PublicKey{NewPublicKey(pk0), NewPublicKey(pk1)},
+		Signature:  NewSignature(sig),
+		Msgs:       [][]uints.U8{uints.NewU8Array(msgs[0]), uints.NewU8Array(msgs[1])},
+	}
+	err := test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+}
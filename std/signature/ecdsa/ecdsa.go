@@ -4,9 +4,12 @@ Package ecdsa implements ECDSA signature verification over any elliptic curve.
 The package depends on the [emulated/sw_emulated] package for elliptic curve group
 operations using non-native arithmetic. Thus we can verify ECDSA signatures over
 any curve. The cost for a single secp256k1 signature verification is
-approximately 4M constraints in R1CS and 10M constraints in PLONKish.
+approximately 260k constraints in R1CS and 1.1M constraints in PLONKish.
 
-See [ECDSA] for the signature verification algorithm.
+See [ECDSA] for the signature verification algorithm. The package also provides
+public key recovery from the signature with [Recover] and additional checks
+against signature malleability with the options [WithRangeChecks] and
+[WithLowS].
 
 [ECDSA]:
 https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
@@ -14,8 +17,12 @@ https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
 package ecdsa
 
 import (
+	"fmt"
+	"math/big"
+
 	"github.com/consensys/gnark/frontend"
 	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/bits"
 	"github.com/consensys/gnark/std/math/emulated"
 )
 
@@ -28,37 +35,176 @@ type Signature[Scalar emulated.FieldParams] struct {
 type PublicKey[Base, Scalar emulated.FieldParams] sw_emulated.AffinePoint[Base]
 
 // Verify asserts that the signature sig verifies for the message msg and public
-// key pk. The curve parameters params define the elliptic curve.
+// key pk. The curve parameters params define the elliptic curve. The options
+// opts allow to enforce additional checks on the signature, see
+// [WithRangeChecks] and [WithLowS].
+//
+// On curves with cofactor 1 and an efficient endomorphism (for example
+// secp256k1), the scalar multiplications use the GLV method. In that case the
+// public keys for which the accumulator of the multi-scalar multiplication
+// collides with a point to add are rejected, see
+// [sw_emulated.Curve.MultiScalarMul].
 //
-// We assume that the message msg is already hashed to the scalar field.
-func (pk PublicKey[T, S]) Verify(api frontend.API, params sw_emulated.CurveParams, msg *emulated.Element[S], sig *Signature[S]) {
+// We assume that the message msg is already hashed to the scalar field. It
+// returns an error if initialising the curve or fields fails.
+func (pk PublicKey[T, S]) Verify(api frontend.API, params sw_emulated.CurveParams, msg *emulated.Element[S], sig *Signature[S], opts ...Option) error {
+	cfg, err := parseOpts(opts...)
+	if err != nil {
+		return fmt.Errorf("parse options: %w", err)
+	}
 	cr, err := sw_emulated.New[T, S](api, params)
 	if err != nil {
-		// TODO: softer handling.
-		panic(err)
+		return fmt.Errorf("new curve: %w", err)
 	}
 	scalarApi, err := emulated.NewField[S](api)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("new scalar field: %w", err)
 	}
 	baseApi, err := emulated.NewField[T](api)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("new base field: %w", err)
 	}
+	checkSignature(api, scalarApi, sig, cfg)
 	pkpt := sw_emulated.AffinePoint[T](pk)
 	sInv := scalarApi.Inverse(&sig.S)
 	msInv := scalarApi.MulMod(msg, sInv)
 	rsInv := scalarApi.MulMod(&sig.R, sInv)
 
 	// q = [rsInv]pkpt + [msInv]g
-	q := cr.JointScalarMulBase(&pkpt, rsInv, msInv)
+	q := jointScalarMulBase(cr, &pkpt, rsInv, msInv)
 	qx := baseApi.Reduce(&q.X)
 	qxBits := baseApi.ToBits(qx)
 	rbits := scalarApi.ToBits(&sig.R)
 	if len(rbits) != len(qxBits) {
-		panic("non-equal lengths")
+		return fmt.Errorf("non-equal lengths")
 	}
 	for i := range rbits {
 		api.AssertIsEqual(rbits[i], qxBits[i])
 	}
+	return nil
+}
+
+// Recover recovers the public key from the signature sig on the message msg and
+// returns it. The curve parameters params define the elliptic curve. The
+// recovery identifier v is in [0, 3], its least significant bit is the parity
+// of the y-coordinate of the signature point R and its second bit indicates
+// that the x-coordinate of R is r+n instead of r, where n is the order of the
+// scalar field. The options opts allow to enforce additional checks on the
+// signature, see [WithRangeChecks] and [WithLowS].
+//
+// The method asserts that r is less than n and, if the range checks are
+// enabled, that r+n is less than the base field modulus when the second bit of
+// v is set. We assume that the message msg is already hashed to the scalar
+// field and is non-zero. As in [PublicKey.Verify], the scalar multiplications
+// use the GLV method on curves with cofactor 1 and an efficient endomorphism.
+// It returns an error if initialising the curve or
+// fields fails.
+func Recover[T, S emulated.FieldParams](api frontend.API, params sw_emulated.CurveParams, msg *emulated.Element[S], v frontend.Variable, sig *Signature[S], opts ...Option) (*PublicKey[T, S], error) {
+	cfg, err := parseOpts(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("parse options: %w", err)
+	}
+	cr, err := sw_emulated.New[T, S](api, params)
+	if err != nil {
+		return nil, fmt.Errorf("new curve: %w", err)
+	}
+	scalarApi, err := emulated.NewField[S](api)
+	if err != nil {
+		return nil, fmt.Errorf("new scalar field: %w", err)
+	}
+	baseApi, err := emulated.NewField[T](api)
+	if err != nil {
+		return nil, fmt.Errorf("new base field: %w", err)
+	}
+	var fp T
+	var fr S
+	if fr.Modulus().BitLen() > fp.Modulus().BitLen() {
+		return nil, fmt.Errorf("scalar field larger than base field not supported")
+	}
+	checkSignature(api, scalarApi, sig, cfg)
+	vBits := bits.ToBinary(api, v, bits.WithNbDigits(2))
+	r := scalarApi.Reduce(&sig.R)
+	scalarApi.AssertIsInRange(r)
+	if cfg.rangeChecks {
+		// when R.x = r+n, then r+n < p, i.e. r <= p-n-1.
+		bound := new(big.Int).Sub(fp.Modulus(), fr.Modulus())
+		bound.Sub(bound, big.NewInt(1))
+		if bound.Sign() < 0 {
+			api.AssertIsEqual(vBits[1], 0)
+		} else if bound.Cmp(fr.Modulus()) < 0 {
+			nMinusOne := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+			scalarApi.AssertIsLessOrEqual(r, scalarApi.Select(vBits[1], scalarApi.NewElement(bound), scalarApi.NewElement(nMinusOne)))
+		}
+	}
+
+	// R.x = r + v[1]*n
+	rBits := scalarApi.ToBits(r)[:fr.Modulus().BitLen()]
+	x := baseApi.FromBits(rBits...)
+	x = baseApi.Add(x, baseApi.Select(vBits[1], baseApi.NewElement(fr.Modulus()), baseApi.Zero()))
+	// R.y = ±sqrt(x³ + ax + b) with parity v[0]
+	yy := baseApi.Mul(x, baseApi.Mul(x, x))
+	if params.A.Sign() != 0 {
+		yy = baseApi.Add(yy, baseApi.Mul(x, baseApi.NewElement(params.A)))
+	}
+	yy = baseApi.Add(yy, baseApi.NewElement(params.B))
+	y := baseApi.Sqrt(yy)
+	yr := baseApi.Reduce(y)
+	baseApi.AssertIsInRange(yr)
+	yBits := baseApi.ToBits(yr)
+	y = baseApi.Select(api.Xor(yBits[0], vBits[0]), baseApi.Neg(y), y)
+	R := sw_emulated.AffinePoint[T]{X: *x, Y: *y}
+
+	// P = [-msg/r]G + [s/r]R
+	rInv := scalarApi.Inverse(r)
+	u1 := scalarApi.Neg(scalarApi.MulMod(msg, rInv))
+	u2 := scalarApi.MulMod(&sig.S, rInv)
+	P := jointScalarMulBase(cr, &R, u2, u1)
+	res := PublicKey[T, S](*P)
+	return &res, nil
+}
+
+// jointScalarMulBase computes s2 * p + s1 * g using the endomorphism of the
+// curve when it is defined and the curve has cofactor 1, so that every point on
+// the curve is in the prime order subgroup as required by
+// [sw_emulated.Curve.JointScalarMulBaseGLV]. Otherwise it uses
+// [sw_emulated.Curve.JointScalarMulBase].
+func jointScalarMulBase[T, S emulated.FieldParams](cr *sw_emulated.Curve[T, S], p *sw_emulated.AffinePoint[T], s2, s1 *emulated.Element[S]) *sw_emulated.AffinePoint[T] {
+	if hasCofactorOne[T, S]() {
+		return cr.JointScalarMulBaseGLV(p, s2, s1)
+	}
+	return cr.JointScalarMulBase(p, s2, s1)
+}
+
+// hasCofactorOne returns true if the order of the curve over the base field T
+// equals the modulus of the scalar field S. By the Hasse bound, the order of
+// the curve is at most p+1+2√p, so it is a multiple of n which is less than 2n
+// only when it is equal to n.
+func hasCofactorOne[T, S emulated.FieldParams]() bool {
+	var fp T
+	var fr S
+	p := fp.Modulus()
+	bound := new(big.Int).Sqrt(p)
+	bound.Add(bound, big.NewInt(1)).Lsh(bound, 1)
+	bound.Add(bound, p).Add(bound, big.NewInt(1))
+	twoN := new(big.Int).Lsh(fr.Modulus(), 1)
+	return twoN.Cmp(bound) > 0
+}
+
+// checkSignature asserts that the signature values are in the ranges defined
+// by the configuration cfg.
+func checkSignature[S emulated.FieldParams](api frontend.API, scalarApi *emulated.Field[S], sig *Signature[S], cfg *opt) {
+	if !cfg.rangeChecks {
+		return
+	}
+	var fr S
+	for _, v := range []*emulated.Element[S]{&sig.R, &sig.S} {
+		vr := scalarApi.Reduce(v)
+		scalarApi.AssertIsInRange(vr)
+		api.AssertIsEqual(scalarApi.IsZero(vr), 0)
+	}
+	if cfg.lowS {
+		halfN := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+		halfN.Rsh(halfN, 1)
+		scalarApi.AssertIsLessOrEqual(scalarApi.Reduce(&sig.S), scalarApi.NewElement(halfN))
+	}
 }
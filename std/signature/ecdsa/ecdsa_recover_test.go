@@ -0,0 +1,193 @@
+package ecdsa
+
+import (
+	cryptoecdsa "crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/ecdsa"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/test"
+)
+
+type RecoverCircuit[T, S emulated.FieldParams] struct {
+	Sig      Signature[S]
+	Msg      emulated.Element[S]
+	V        frontend.Variable
+	Expected PublicKey[T, S]
+
+	lowS bool
+}
+
+func (c *RecoverCircuit[T, S]) Define(api frontend.API) error {
+	params := sw_emulated.GetCurveParams[T]()
+	curve, err := sw_emulated.New[T, S](api, params)
+	if err != nil {
+		return err
+	}
+	opts := []Option{WithRangeChecks()}
+	if c.lowS {
+		opts = append(opts, WithLowS())
+	}
+	pk, err := Recover[T, S](api, params, &c.Msg, c.V, &c.Sig, opts...)
+	if err != nil {
+		return err
+	}
+	expected := sw_emulated.AffinePoint[T](c.Expected)
+	res := sw_emulated.AffinePoint[T](*pk)
+	curve.AssertIsEqual(&res, &expected)
+	return nil
+}
+
+func TestRecoverSecp256k1(t *testing.T) {
+	assert := test.NewAssert(t)
+	privKey, err := ecdsa.GenerateKey(rand.Reader)
+	assert.NoError(err)
+	msg := []byte("testing ECDSA recovery")
+	v, r, s, err := privKey.SignForRecover(msg, nil)
+	assert.NoError(err)
+	hash := ecdsa.HashToInt(msg)
+
+	circuit := RecoverCircuit[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{}
+	witness := RecoverCircuit[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+		Sig: Signature[emulated.Secp256k1Fr]{
+			R: emulated.ValueOf[emulated.Secp256k1Fr](r),
+			S: emulated.ValueOf[emulated.Secp256k1Fr](s),
+		},
+		Msg: emulated.ValueOf[emulated.Secp256k1Fr](hash),
+		V:   v,
+		Expected: PublicKey[emulated.Secp256k1Fp, emulated.Secp256k1Fr]{
+			X: emulated.ValueOf[emulated.Secp256k1Fp](privKey.PublicKey.A.X),
+			Y: emulated.ValueOf[emulated.Secp256k1Fp](privKey.PublicKey.A.Y),
+		},
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// the other parity of R recovers a different public key.
+	witness.V = v ^ 1
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+// recoveryID returns the recovery identifier of the signature (r, s) on the
+// message hash for the public key pub.
+func recoveryID(pub *cryptoecdsa.PublicKey, hash []byte, r, s *big.Int) (uint, error) {
+	params := pub.Curve.Params()
+	m := new(big.Int).SetBytes(hash)
+	m.Mod(m, params.N)
+	rInv := new(big.Int).ModInverse(r, params.N)
+	u1 := new(big.Int).Mul(m, rInv)
+	u1.Neg(u1).Mod(u1, params.N)
+	u2 := new(big.Int).Mul(s, rInv)
+	u2.Mod(u2, params.N)
+	// y² = x³ - 3x + b
+	yy := new(big.Int).Exp(r, big.NewInt(3), params.P)
+	yy.Sub(yy, new(big.Int).Mul(big.NewInt(3), r))
+	yy.Add(yy, params.B).Mod(yy, params.P)
+	y := new(big.Int).ModSqrt(yy, params.P)
+	if y == nil {
+		return 0, fmt.Errorf("no square root")
+	}
+	for v := uint(0); v < 2; v++ {
+		if y.Bit(0) != v {
+			y.Sub(params.P, y)
+		}
+		x1, y1 := pub.Curve.ScalarBaseMult(u1.Bytes())
+		x2, y2 := pub.Curve.ScalarMult(r, y, u2.Bytes())
+		x, y := pub.Curve.Add(x1, y1, x2, y2)
+		if x.Cmp(pub.X) == 0 && y.Cmp(pub.Y) == 0 {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("public key not recovered")
+}
+
+func TestRecoverP256(t *testing.T) {
+	assert := test.NewAssert(t)
+	privKey, err := cryptoecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(err)
+	msg := []byte("testing ECDSA recovery")
+	msgHash := sha256.Sum256(msg)
+	r, s, err := cryptoecdsa.Sign(rand.Reader, privKey, msgHash[:])
+	assert.NoError(err)
+	// normalise to low-S, the high-S signature is obtained by negating s and
+	// flipping the parity of R.
+	n := elliptic.P256().Params().N
+	if s.Cmp(new(big.Int).Rsh(n, 1)) > 0 {
+		s.Sub(n, s)
+	}
+	v, err := recoveryID(&privKey.PublicKey, msgHash[:], r, s)
+	assert.NoError(err)
+
+	circuit := RecoverCircuit[emulated.P256Fp, emulated.P256Fr]{lowS: true}
+	witness := RecoverCircuit[emulated.P256Fp, emulated.P256Fr]{
+		Sig: Signature[emulated.P256Fr]{
+			R: emulated.ValueOf[emulated.P256Fr](r),
+			S: emulated.ValueOf[emulated.P256Fr](s),
+		},
+		Msg: emulated.ValueOf[emulated.P256Fr](msgHash[:]),
+		V:   v,
+		Expected: PublicKey[emulated.P256Fp, emulated.P256Fr]{
+			X: emulated.ValueOf[emulated.P256Fp](privKey.PublicKey.X),
+			Y: emulated.ValueOf[emulated.P256Fp](privKey.PublicKey.Y),
+		},
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// the high-S signature recovers the same public key, but is rejected when
+	// enforcing low-S.
+	witness.Sig.S = emulated.ValueOf[emulated.P256Fr](new(big.Int).Sub(n, s))
+	witness.V = 1 - v
+	err = test.IsSolved(&RecoverCircuit[emulated.P256Fp, emulated.P256Fr]{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestRecoverP384(t *testing.T) {
+	assert := test.NewAssert(t)
+	privKey, err := cryptoecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	assert.NoError(err)
+	msg := []byte("testing ECDSA recovery")
+	msgHash := sha512.Sum384(msg)
+	r, s, err := cryptoecdsa.Sign(rand.Reader, privKey, msgHash[:])
+	assert.NoError(err)
+	v, err := recoveryID(&privKey.PublicKey, msgHash[:], r, s)
+	assert.NoError(err)
+
+	circuit := RecoverCircuit[emulated.P384Fp, emulated.P384Fr]{}
+	witness := RecoverCircuit[emulated.P384Fp, emulated.P384Fr]{
+		Sig: Signature[emulated.P384Fr]{
+			R: emulated.ValueOf[emulated.P384Fr](r),
+			S: emulated.ValueOf[emulated.P384Fr](s),
+		},
+		Msg: emulated.ValueOf[emulated.P384Fr](msgHash[:]),
+		V:   v,
+		Expected: PublicKey[emulated.P384Fp, emulated.P384Fr]{
+			X: emulated.ValueOf[emulated.P384Fp](privKey.PublicKey.X),
+			Y: emulated.ValueOf[emulated.P384Fp](privKey.PublicKey.Y),
+		},
+	}
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// the other parity of R recovers a different public key.
+	witness.V = v ^ 1
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+
+	// r+n is larger than the base field modulus.
+	witness.V = v | 2
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
@@ -21,8 +21,7 @@ type EcdsaCircuit[T, S emulated.FieldParams] struct {
 }
 
 func (c *EcdsaCircuit[T, S]) Define(api frontend.API) error {
-	c.Pub.Verify(api, sw_emulated.GetCurveParams[T](), &c.Msg, &c.Sig)
-	return nil
+	return c.Pub.Verify(api, sw_emulated.GetCurveParams[T](), &c.Msg, &c.Sig)
 }
 
 func TestEcdsaPreHashed(t *testing.T) {
@@ -158,3 +157,12 @@ func ExamplePublicKey_Verify_create() {
 	// can continue in the PublicKey Verify example
 	_, _, _, _, _ = sig.R, sig.S, msg, pubx, puby
 }
+
+func TestHasCofactorOne(t *testing.T) {
+	assert := test.NewAssert(t)
+	assert.True(hasCofactorOne[emulated.Secp256k1Fp, emulated.Secp256k1Fr]())
+	assert.True(hasCofactorOne[emulated.BN254Fp, emulated.BN254Fr]())
+	assert.True(hasCofactorOne[emulated.P256Fp, emulated.P256Fr]())
+	assert.False(hasCofactorOne[emulated.BLS12381Fp, emulated.BLS12381Fr]())
+	assert.False(hasCofactorOne[emulated.BW6761Fp, emulated.BW6761Fr]())
+}
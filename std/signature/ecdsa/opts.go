@@ -0,0 +1,41 @@
+package ecdsa
+
+type opt struct {
+	rangeChecks bool
+	lowS        bool
+}
+
+func parseOpts(opts ...Option) (*opt, error) {
+	o := new(opt)
+	for _, apply := range opts {
+		if err := apply(o); err != nil {
+			return nil, err
+		}
+	}
+	return o, nil
+}
+
+// Option allows to configure the signature verification and public key
+// recovery.
+type Option func(*opt) error
+
+// WithRangeChecks asserts that the signature values r and s are in the range
+// [1, n-1], where n is the order of the scalar field.
+func WithRangeChecks() Option {
+	return func(o *opt) error {
+		o.rangeChecks = true
+		return nil
+	}
+}
+
+// WithLowS asserts that the signature value s is in the range [1, (n-1)/2],
+// where n is the order of the scalar field. This prevents signature
+// malleability as only one of s and n-s is accepted. The option implies
+// [WithRangeChecks].
+func WithLowS() Option {
+	return func(o *opt) error {
+		o.rangeChecks = true
+		o.lowS = true
+		return nil
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Package ed25519 implements Ed25519 signature verification.
+
+Ed25519 is the EdDSA signature scheme instantiated over the twisted Edwards
+curve edwards25519 with SHA-512 as the hash function, as defined in [RFC 8032].
+As the curve is defined over the prime field of order 2^255-19, which differs
+from the native field of any SNARK curve, the group operations are performed
+using field emulation in package
+[github.com/consensys/gnark/std/algebra/emulated/te_emulated]. For EdDSA
+signatures over twisted Edwards curves defined over the native field, see
+package [github.com/consensys/gnark/std/signature/eddsa].
+
+The verification equation is the cofactorless one
+
+	[S]B = R + [H(R || A || M)]A
+
+which is compatible with the verification in the Go standard library package
+[crypto/ed25519].
+
+[RFC 8032]: https://datatracker.ietf.org/doc/html/rfc8032
+*/
+package ed25519
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/te_emulated"
+	"github.com/consensys/gnark/std/hash/sha512"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+type (
+	// Fp is the emulation parameter for the base field of edwards25519.
+	Fp = emulated.Ed25519Fp
+	// Fr is the emulation parameter for the prime order subgroup of
+	// edwards25519.
+	Fr = emulated.Ed25519Fr
+)
+
+// PublicKey stores an Ed25519 public key (to be used in gnark circuit). The
+// public key is stored in uncompressed form, use [PublicKey.Assign] to assign
+// it from its encoded form.
+type PublicKey struct {
+	A te_emulated.AffinePoint[Fp]
+}
+
+// Signature stores an Ed25519 signature (to be used in gnark circuit). The
+// signature point R is stored in uncompressed form, use [Signature.Assign] to
+// assign it from its encoded form.
+type Signature struct {
+	R te_emulated.AffinePoint[Fp]
+	S emulated.Element[Fr]
+}
+
+// Verify verifies an Ed25519 signature sig on the message msg for the public
+// key pubKey. The message is given as bytes and the length of the message is
+// fixed at circuit compile time.
+//
+// In addition to the verification equation, the method asserts that the
+// public key is on the curve and that the scalar S of the signature is
+// canonical (less than the order of the prime order subgroup). It returns an
+// error if initialising the curve or hash function fails.
+func Verify(api frontend.API, sig Signature, msg []uints.U8, pubKey PublicKey) error {
+	curve, err := te_emulated.New[Fp, Fr](api, te_emulated.GetEd25519Params())
+	if err != nil {
+		return fmt.Errorf("new curve: %w", err)
+	}
+	baseApi, err := emulated.NewField[Fp](api)
+	if err != nil {
+		return fmt.Errorf("new base field: %w", err)
+	}
+	scalarApi, err := emulated.NewField[Fr](api)
+	if err != nil {
+		return fmt.Errorf("new scalar field: %w", err)
+	}
+	h, err := sha512.New(api)
+	if err != nil {
+		return fmt.Errorf("new hash: %w", err)
+	}
+
+	curve.AssertIsOnCurve(&pubKey.A)
+	s := scalarApi.Reduce(&sig.S)
+	scalarApi.AssertIsInRange(s)
+
+	// k = H(R || A || M) mod ℓ
+	h.Write(encodePoint(api, baseApi, &sig.R))
+	h.Write(encodePoint(api, baseApi, &pubKey.A))
+	h.Write(msg)
+	k := digestToScalar(api, scalarApi, h.Sum())
+
+	// [S]B - [k]A == R
+	q := curve.DoubleBaseScalarMul(curve.Generator(), curve.Neg(&pubKey.A), s, k)
+	curve.AssertIsEqual(q, &sig.R)
+	return nil
+}
+
+// encodePoint returns the canonical encoding of p as defined in RFC 8032
+// Section 5.1.2. The y-coordinate is encoded as 255-bit little-endian integer
+// and the most significant bit of the last byte is the least significant bit
+// of the x-coordinate.
+func encodePoint(api frontend.API, baseApi *emulated.Field[Fp], p *te_emulated.AffinePoint[Fp]) []uints.U8 {
+	x := baseApi.Reduce(&p.X)
+	baseApi.AssertIsInRange(x)
+	y := baseApi.Reduce(&p.Y)
+	baseApi.AssertIsInRange(y)
+	xBits := baseApi.ToBits(x)
+	yBits := baseApi.ToBits(y)
+	encBits := make([]frontend.Variable, 256)
+	copy(encBits, yBits[:255])
+	encBits[255] = xBits[0]
+	res := make([]uints.U8, 32)
+	for i := range res {
+		res[i] = uints.U8{Val: bits.FromBinary(api, encBits[8*i:8*(i+1)], bits.WithUnconstrainedInputs())}
+	}
+	return res
+}
+
+// digestToScalar interprets the 64-byte digest as a little-endian integer and
+// returns it reduced modulo the order of the prime order subgroup. The result
+// is asserted to be strictly less than the order.
+func digestToScalar(api frontend.API, scalarApi *emulated.Field[Fr], digest []uints.U8) *emulated.Element[Fr] {
+	dBits := make([]frontend.Variable, 0, 8*len(digest))
+	for i := range digest {
+		dBits = append(dBits, bits.ToBinary(api, digest[i].Val, bits.WithNbDigits(8))...)
+	}
+	// k = lo + hi * 2^256 mod ℓ
+	lo := scalarApi.FromBits(dBits[:256]...)
+	hi := scalarApi.FromBits(dBits[256:]...)
+	shift := new(big.Int).Lsh(big.NewInt(1), 256)
+	shiftEl := emulated.ValueOf[Fr](shift)
+	k := scalarApi.Add(scalarApi.Mul(hi, &shiftEl), lo)
+	k = scalarApi.Reduce(k)
+	scalarApi.AssertIsInRange(k)
+	return k
+}
+
+// Assign is a helper to assign an encoded public key (as defined in RFC 8032
+// Section 5.1.5) into its uncompressed form. It panics if the encoding is
+// invalid.
+func (p *PublicKey) Assign(buf []byte) {
+	x, y, err := decodePoint(buf)
+	if err != nil {
+		panic(err)
+	}
+	p.A.X = emulated.ValueOf[Fp](x)
+	p.A.Y = emulated.ValueOf[Fp](y)
+}
+
+// Assign is a helper to assign an encoded signature (as defined in RFC 8032
+// Section 5.1.6) into its uncompressed form. It panics if the encoding is
+// invalid.
+func (s *Signature) Assign(buf []byte) {
+	if len(buf) != 64 {
+		panic(fmt.Sprintf("invalid signature length %d", len(buf)))
+	}
+	x, y, err := decodePoint(buf[:32])
+	if err != nil {
+		panic(err)
+	}
+	// S must be canonical, otherwise S+ℓ would be a valid encoding of the same
+	// signature.
+	var fr Fr
+	sc := leToInt(buf[32:])
+	if sc.Cmp(fr.Modulus()) >= 0 {
+		panic("non-canonical S")
+	}
+	s.R.X = emulated.ValueOf[Fp](x)
+	s.R.Y = emulated.ValueOf[Fp](y)
+	s.S = emulated.ValueOf[Fr](sc)
+}
+
+// decodePoint decodes an encoded point as defined in RFC 8032 Section 5.1.3.
+func decodePoint(buf []byte) (x, y *big.Int, err error) {
+	if len(buf) != 32 {
+		return nil, nil, fmt.Errorf("invalid point encoding length %d", len(buf))
+	}
+	var fp Fp
+	p := fp.Modulus()
+	params := te_emulated.GetEd25519Params()
+	enc := make([]byte, 32)
+	copy(enc, buf)
+	odd := enc[31]>>7 == 1
+	enc[31] &= 0x7f
+	y = leToInt(enc)
+	if y.Cmp(p) >= 0 {
+		return nil, nil, fmt.Errorf("non-canonical y-coordinate")
+	}
+	// x² = (y²-1)/(dy²-a)
+	yy := new(big.Int).Mul(y, y)
+	num := new(big.Int).Sub(yy, big.NewInt(1))
+	den := new(big.Int).Mul(params.D, yy)
+	den.Sub(den, params.A)
+	den.Mod(den, p)
+	if den.ModInverse(den, p) == nil {
+		return nil, nil, fmt.Errorf("invalid y-coordinate")
+	}
+	xx := num.Mul(num, den)
+	xx.Mod(xx, p)
+	x = new(big.Int)
+	if x.ModSqrt(xx, p) == nil {
+		return nil, nil, fmt.Errorf("point not on curve")
+	}
+	if x.Sign() == 0 && odd {
+		return nil, nil, fmt.Errorf("invalid x-coordinate sign")
+	}
+	if (x.Bit(0) == 1) != odd {
+		x.Sub(p, x)
+	}
+	return x, y, nil
+}
+
+// leToInt interprets buf as a little-endian integer.
+func leToInt(buf []byte) *big.Int {
+	be := make([]byte, len(buf))
+	for i := range buf {
+		be[len(buf)-1-i] = buf[i]
+	}
+	return new(big.Int).SetBytes(be)
+}
@@ -0,0 +1,103 @@
+package ed25519
+
+import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type ed25519Circuit struct {
+	PublicKey PublicKey
+	Signature Signature
+	Message   []uints.U8
+}
+
+func (c *ed25519Circuit) Define(api frontend.API) error {
+	return Verify(api, c.Signature, c.Message, c.PublicKey)
+}
+
+func assignment(pub, msg, sig []byte) (circuit, witness *ed25519Circuit) {
+	circuit = &ed25519Circuit{Message: make([]uints.U8, len(msg))}
+	witness = &ed25519Circuit{Message: uints.NewU8Array(msg)}
+	witness.PublicKey.Assign(pub)
+	witness.Signature.Assign(sig)
+	return
+}
+
+// TestEd25519Vectors tests the verification against the test vectors of the Go
+// standard library crypto/ed25519 package (originally from
+// https://ed25519.cr.yp.to/python/sign.input).
+func TestEd25519Vectors(t *testing.T) {
+	assert := test.NewAssert(t)
+	f, err := os.Open("testdata/sign.input")
+	assert.NoError(err)
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for i := 0; scanner.Scan(); i++ {
+		parts := strings.Split(scanner.Text(), ":")
+		assert.Equal(5, len(parts), "bad number of parts on line %d", i)
+		pub, _ := hex.DecodeString(parts[1])
+		msg, _ := hex.DecodeString(parts[2])
+		sig, _ := hex.DecodeString(parts[3])
+		// the signatures in the test vectors also include the message at the
+		// end, but we just want R and S.
+		sig = sig[:ed25519.SignatureSize]
+		assert.True(ed25519.Verify(pub, msg, sig), "native verification line %d", i)
+
+		circuit, witness := assignment(pub, msg, sig)
+		err = test.IsSolved(circuit, witness, ecc.BN254.ScalarField())
+		assert.NoError(err, "line %d", i)
+	}
+	assert.NoError(scanner.Err())
+}
+
+func TestEd25519(t *testing.T) {
+	assert := test.NewAssert(t)
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(err)
+	// message longer than a single SHA-512 block
+	msg := make([]byte, 150)
+	_, err = rand.Read(msg)
+	assert.NoError(err)
+	sig := ed25519.Sign(priv, msg)
+
+	circuit, witness := assignment(pub, msg, sig)
+	err = test.IsSolved(circuit, witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// tampered message
+	msg[0] ^= 1
+	circuit, witness = assignment(pub, msg, sig)
+	err = test.IsSolved(circuit, witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestEd25519NonCanonicalS(t *testing.T) {
+	assert := test.NewAssert(t)
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(err)
+	msg := []byte("hello")
+	sig := ed25519.Sign(priv, msg)
+
+	// S+ℓ fits in 32 bytes and is rejected by RFC 8032 Section 5.1.7.
+	var fr Fr
+	sc := leToInt(sig[32:])
+	sc.Add(sc, fr.Modulus())
+	malleable := make([]byte, ed25519.SignatureSize)
+	copy(malleable, sig[:32])
+	for i := 0; i < 32; i++ {
+		malleable[32+i] = byte(sc.Uint64())
+		sc.Rsh(sc, 8)
+	}
+	assert.False(ed25519.Verify(pub, msg, malleable))
+	assert.Panics(func() { assignment(pub, msg, malleable) })
+}
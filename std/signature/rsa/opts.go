@@ -0,0 +1,59 @@
+package rsa
+
+import "fmt"
+
+type opt struct {
+	e          int
+	nbBits     int
+	saltLength int
+}
+
+func parseOpts(opts ...Option) (*opt, error) {
+	o := &opt{e: 65537, saltLength: hashLength}
+	for _, apply := range opts {
+		if err := apply(o); err != nil {
+			return nil, err
+		}
+	}
+	return o, nil
+}
+
+// Option allows to configure the signature verification.
+type Option func(*opt) error
+
+// WithPublicExponent sets the public exponent e of the key. The exponent is
+// fixed at circuit compile time. If not set, then e = 65537 is used.
+func WithPublicExponent(e int) Option {
+	return func(o *opt) error {
+		if e < 3 || e%2 == 0 {
+			return fmt.Errorf("public exponent must be odd and at least 3")
+		}
+		o.e = e
+		return nil
+	}
+}
+
+// WithModulusBitLength sets the bit length of the modulus. The verification
+// asserts that the modulus has exactly the given bit length. If not set, then
+// the bit length of the modulus of the type parameter is used.
+func WithModulusBitLength(nbBits int) Option {
+	return func(o *opt) error {
+		if nbBits <= 0 {
+			return fmt.Errorf("modulus bit length must be positive")
+		}
+		o.nbBits = nbBits
+		return nil
+	}
+}
+
+// WithSaltLength sets the length of the salt for PSS signatures. If not set,
+// then the salt length is the length of the hash digest.
+func WithSaltLength(saltLength int) Option {
+	return func(o *opt) error {
+		if saltLength < 0 {
+			return fmt.Errorf("salt length must be non-negative")
+		}
+		o.saltLength = saltLength
+		return nil
+	}
+}
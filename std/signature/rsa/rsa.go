@@ -0,0 +1,232 @@
+/*
+Package rsa implements RSA signature verification.
+
+The package depends on the [emulated] package for the arithmetic modulo the
+RSA modulus. The modulus is a part of the witness and the arithmetic is
+performed using [emulated.NewVariableModulusField], where the type parameter
+bounds the modulus. For example, for RSA-2048 use [emulated.Mod1e2048].
+
+The package implements signature verification for the [PKCS #1 v1.5] and
+[PSS] encoding schemes with SHA-256 as the hash function. The message digest
+is computed by the caller, for example using [sha2].
+
+[PKCS #1 v1.5]: https://www.rfc-editor.org/rfc/rfc8017#section-8.2
+[PSS]: https://www.rfc-editor.org/rfc/rfc8017#section-8.1
+[sha2]: https://pkg.go.dev/github.com/consensys/gnark/std/hash/sha2
+*/
+package rsa
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+// hashLength is the length of the SHA-256 digest.
+const hashLength = 32
+
+// sha256DigestInfo is the DER encoding of the DigestInfo prefix for SHA-256.
+var sha256DigestInfo = []byte{0x30, 0x31, 0x30, 0x0d, 0x06, 0x09, 0x60, 0x86, 0x48, 0x01, 0x65, 0x03, 0x04, 0x02, 0x01, 0x05, 0x00, 0x04, 0x20}
+
+// Signature represents the signature for some message.
+type Signature[T emulated.FieldParams] struct {
+	S emulated.Element[T]
+}
+
+// PublicKey represents the public key to verify the signature for. The public
+// exponent is fixed at circuit compile time, see [WithPublicExponent].
+type PublicKey[T emulated.FieldParams] struct {
+	N emulated.Element[T]
+}
+
+// VerifyPKCS1v15 asserts that the signature sig verifies for the SHA-256
+// digest hashed using the PKCS #1 v1.5 encoding. The options opts allow to
+// configure the public exponent and the bit length of the modulus.
+//
+// It returns an error if the digest has invalid length or the modulus is too
+// short for the encoding.
+func (pk PublicKey[T]) VerifyPKCS1v15(api frontend.API, hashed []uints.U8, sig *Signature[T], opts ...Option) error {
+	cfg, f, m, err := pk.open(api, sig, opts...)
+	if err != nil {
+		return err
+	}
+	if len(hashed) != hashLength {
+		return fmt.Errorf("digest length %d, expected %d", len(hashed), hashLength)
+	}
+	// EM = 0x00 || 0x01 || PS || 0x00 || T, where T = DigestInfo || H and PS
+	// is the padding of 0xff bytes.
+	emLen := (cfg.nbBits + 7) / 8
+	tLen := len(sha256DigestInfo) + hashLength
+	if emLen < tLen+11 {
+		return fmt.Errorf("modulus too short")
+	}
+	prefix := make([]byte, emLen-hashLength)
+	prefix[1] = 0x01
+	for i := 2; i < emLen-tLen-1; i++ {
+		prefix[i] = 0xff
+	}
+	copy(prefix[emLen-tLen:], sha256DigestInfo)
+	bPrefix := new(big.Int).SetBytes(prefix)
+	// the bits of EM in little-endian order. The least significant bits
+	// correspond to the digest.
+	emBits := make([]frontend.Variable, 8*emLen)
+	for i := 0; i < hashLength; i++ {
+		bBits := bits.ToBinary(api, hashed[hashLength-1-i].Val, bits.WithNbDigits(8))
+		copy(emBits[8*i:8*(i+1)], bBits)
+	}
+	for i := 8 * hashLength; i < len(emBits); i++ {
+		emBits[i] = bPrefix.Bit(i - 8*hashLength)
+	}
+	// EM < N, so it is sufficient to check the equality modulo N.
+	f.AssertIsEqual(m, f.FromBits(emBits...))
+	return nil
+}
+
+// VerifyPSS asserts that the signature sig verifies for the SHA-256 digest
+// hashed using the PSS encoding with MGF1 using SHA-256. The options opts allow
+// to configure the public exponent, the bit length of the modulus and the salt
+// length.
+//
+// It returns an error if the digest has invalid length or the modulus is too
+// short for the encoding.
+func (pk PublicKey[T]) VerifyPSS(api frontend.API, hashed []uints.U8, sig *Signature[T], opts ...Option) error {
+	cfg, f, m, err := pk.open(api, sig, opts...)
+	if err != nil {
+		return err
+	}
+	if len(hashed) != hashLength {
+		return fmt.Errorf("digest length %d, expected %d", len(hashed), hashLength)
+	}
+	uapi, err := uints.New[uints.U32](api)
+	if err != nil {
+		return fmt.Errorf("new uints: %w", err)
+	}
+	// EM = maskedDB || H || 0xbc, where the length of EM in bits is emBits.
+	nbEmBits := cfg.nbBits - 1
+	emLen := (nbEmBits + 7) / 8
+	if emLen < hashLength+cfg.saltLength+2 {
+		return fmt.Errorf("modulus too short")
+	}
+	dbLen := emLen - hashLength - 1
+	psLen := dbLen - cfg.saltLength - 1
+
+	f.AssertIsInRange(m)
+	mBits := f.ToBits(m)
+	// the leftmost 8*emLen-emBits bits of EM must be zero.
+	for i := nbEmBits; i < len(mBits); i++ {
+		api.AssertIsEqual(mBits[i], 0)
+	}
+	// emByteBits returns the bits of the i-th byte of EM in big-endian order
+	// of the bytes, least significant bit first.
+	emByteBits := func(i int) []frontend.Variable {
+		return mBits[8*(emLen-1-i) : 8*(emLen-i)]
+	}
+	lastBits := emByteBits(emLen - 1)
+	for i := 0; i < 8; i++ {
+		api.AssertIsEqual(lastBits[i], (0xbc>>i)&1)
+	}
+	h := make([]uints.U8, hashLength)
+	for i := range h {
+		h[i] = uapi.ByteValueOf(bits.FromBinary(api, emByteBits(dbLen+i)))
+	}
+
+	// DB = maskedDB XOR MGF1(H). We have DB = PS || 0x01 || salt where PS is
+	// zero bytes.
+	dbMask, err := mgf1(api, h, dbLen)
+	if err != nil {
+		return err
+	}
+	salt := make([]uints.U8, cfg.saltLength)
+	for i := 0; i < dbLen; i++ {
+		maskedBits := emByteBits(i)
+		maskBits := bits.ToBinary(api, dbMask[i].Val, bits.WithNbDigits(8))
+		nbBits := 8
+		if i == 0 {
+			// the leftmost bits of DB are cleared
+			nbBits = 8 - (8*emLen - nbEmBits)
+		}
+		switch {
+		case i < psLen:
+			for j := 0; j < nbBits; j++ {
+				api.AssertIsEqual(maskedBits[j], maskBits[j])
+			}
+		case i == psLen:
+			for j := 0; j < nbBits; j++ {
+				api.AssertIsEqual(api.Xor(maskedBits[j], maskBits[j]), (0x01>>j)&1)
+			}
+		default:
+			dbBits := make([]frontend.Variable, 8)
+			for j := range dbBits {
+				dbBits[j] = 0
+				if j < nbBits {
+					dbBits[j] = api.Xor(maskedBits[j], maskBits[j])
+				}
+			}
+			salt[i-psLen-1] = uapi.ByteValueOf(bits.FromBinary(api, dbBits))
+		}
+	}
+
+	// H = Hash(0x00 x 8 || mHash || salt)
+	hasher, err := sha2.New(api)
+	if err != nil {
+		return fmt.Errorf("new sha2: %w", err)
+	}
+	hasher.Write(uints.NewU8Array(make([]byte, 8)))
+	hasher.Write(hashed)
+	hasher.Write(salt)
+	hPrime := hasher.Sum()
+	for i := range h {
+		uapi.ByteAssertEq(h[i], hPrime[i])
+	}
+	return nil
+}
+
+// open asserts that the modulus has the expected bit length and the signature
+// is less than the modulus. It returns the parsed options, the field modulo
+// the modulus and s^e, which is reduced but may not be less than the modulus.
+func (pk PublicKey[T]) open(api frontend.API, sig *Signature[T], opts ...Option) (*opt, *emulated.Field[T], *emulated.Element[T], error) {
+	cfg, err := parseOpts(opts...)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("parse options: %w", err)
+	}
+	var fp T
+	if cfg.nbBits == 0 {
+		cfg.nbBits = fp.Modulus().BitLen()
+	}
+	if cfg.nbBits > fp.Modulus().BitLen() {
+		return nil, nil, nil, fmt.Errorf("modulus bit length %d exceeds bound %d", cfg.nbBits, fp.Modulus().BitLen())
+	}
+	f, err := emulated.NewVariableModulusField[T](api, &pk.N)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("new field: %w", err)
+	}
+	nBits := f.ToBits(&pk.N)
+	api.AssertIsEqual(nBits[cfg.nbBits-1], 1)
+	for i := cfg.nbBits; i < len(nBits); i++ {
+		api.AssertIsEqual(nBits[i], 0)
+	}
+	f.AssertIsInRange(&sig.S)
+	m := f.ExpConst(&sig.S, big.NewInt(int64(cfg.e)))
+	return cfg, f, m, nil
+}
+
+// mgf1 returns the mask of the given length generated from seed using MGF1
+// with SHA-256.
+func mgf1(api frontend.API, seed []uints.U8, length int) ([]uints.U8, error) {
+	var res []uints.U8
+	for counter := 0; len(res) < length; counter++ {
+		h, err := sha2.New(api)
+		if err != nil {
+			return nil, fmt.Errorf("new sha2: %w", err)
+		}
+		h.Write(seed)
+		h.Write(uints.NewU8Array([]byte{byte(counter >> 24), byte(counter >> 16), byte(counter >> 8), byte(counter)}))
+		res = append(res, h.Sum()...)
+	}
+	return res[:length], nil
+}
@@ -0,0 +1,73 @@
+package rsa
+
+import (
+	"crypto"
+	"crypto/rand"
+	nativersa "crypto/rsa"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+type RsaCircuit[T emulated.FieldParams] struct {
+	Sig    Signature[T]
+	Hashed [hashLength]uints.U8
+	Pub    PublicKey[T]
+
+	pss bool
+}
+
+func (c *RsaCircuit[T]) Define(api frontend.API) error {
+	if c.pss {
+		return c.Pub.VerifyPSS(api, c.Hashed[:], &c.Sig)
+	}
+	return c.Pub.VerifyPKCS1v15(api, c.Hashed[:], &c.Sig)
+}
+
+func newWitness(key *nativersa.PrivateKey, hashed, sig []byte) *RsaCircuit[emulated.Mod1e2048] {
+	var witness RsaCircuit[emulated.Mod1e2048]
+	witness.Sig.S = emulated.ValueOf[emulated.Mod1e2048](new(big.Int).SetBytes(sig))
+	witness.Pub.N = emulated.ValueOf[emulated.Mod1e2048](key.N)
+	copy(witness.Hashed[:], uints.NewU8Array(hashed))
+	return &witness
+}
+
+func TestRsaPKCS1v15(t *testing.T) {
+	assert := test.NewAssert(t)
+	key, err := nativersa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(err)
+	hashed := sha256.Sum256([]byte("testing RSA (PKCS #1 v1.5)"))
+	sig, err := nativersa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	assert.NoError(err)
+
+	circuit := RsaCircuit[emulated.Mod1e2048]{}
+	err = test.IsSolved(&circuit, newWitness(key, hashed[:], sig), ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	wrong := sha256.Sum256([]byte("wrong message"))
+	err = test.IsSolved(&circuit, newWitness(key, wrong[:], sig), ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+func TestRsaPSS(t *testing.T) {
+	assert := test.NewAssert(t)
+	key, err := nativersa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(err)
+	hashed := sha256.Sum256([]byte("testing RSA (PSS)"))
+	sig, err := nativersa.SignPSS(rand.Reader, key, crypto.SHA256, hashed[:], &nativersa.PSSOptions{SaltLength: nativersa.PSSSaltLengthEqualsHash})
+	assert.NoError(err)
+
+	circuit := RsaCircuit[emulated.Mod1e2048]{pss: true}
+	err = test.IsSolved(&circuit, newWitness(key, hashed[:], sig), ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	wrong := sha256.Sum256([]byte("wrong message"))
+	err = test.IsSolved(&circuit, newWitness(key, wrong[:], sig), ecc.BN254.ScalarField())
+	assert.Error(err)
+}
@@ -0,0 +1,187 @@
+/*
+Package bip340 implements Schnorr signature verification over secp256k1 as
+defined in [BIP-340].
+
+The package depends on the [emulated/sw_emulated] package for elliptic curve
+group operations using non-native arithmetic and on the [hash/sha2] package for
+computing the tagged challenge hash.
+
+The public key is given in circuit in uncompressed form (both coordinates),
+use [PublicKey.Assign] to assign it from its 32-byte x-only encoding. In
+circuit we check that the public key is on the curve and that its
+y-coordinate is even, so that it corresponds to the x-only encoding.
+
+[BIP-340]: https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki
+*/
+package bip340
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/emulated/sw_emulated"
+	"github.com/consensys/gnark/std/hash/sha2"
+	"github.com/consensys/gnark/std/math/bits"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+)
+
+type (
+	// Fp is the emulation parameter for the base field of secp256k1.
+	Fp = emulated.Secp256k1Fp
+	// Fr is the emulation parameter for the scalar field of secp256k1.
+	Fr = emulated.Secp256k1Fr
+)
+
+// challengeTag is the tag of the hash used for computing the challenge.
+const challengeTag = "BIP0340/challenge"
+
+// Signature represents the signature for some message.
+type Signature struct {
+	R emulated.Element[Fp]
+	S emulated.Element[Fr]
+}
+
+// PublicKey represents the public key to verify the signature for.
+type PublicKey sw_emulated.AffinePoint[Fp]
+
+// Verify asserts that the signature sig verifies for the message msg and public
+// key pk as defined in BIP-340. The length of the message is fixed at circuit
+// compile time.
+//
+// In addition to the verification equation, the method asserts that the public
+// key is on the curve and has even y-coordinate and that the signature values
+// r and s are canonical (less than the base field and scalar field moduli
+// respectively). It returns an error if initialising the curve, fields or hash
+// function fails.
+func (pk PublicKey) Verify(api frontend.API, msg []uints.U8, sig *Signature) error {
+	cr, err := sw_emulated.New[Fp, Fr](api, sw_emulated.GetSecp256k1Params())
+	if err != nil {
+		return fmt.Errorf("new curve: %w", err)
+	}
+	baseApi, err := emulated.NewField[Fp](api)
+	if err != nil {
+		return fmt.Errorf("new base field: %w", err)
+	}
+	scalarApi, err := emulated.NewField[Fr](api)
+	if err != nil {
+		return fmt.Errorf("new scalar field: %w", err)
+	}
+	h, err := sha2.New(api)
+	if err != nil {
+		return fmt.Errorf("new hash: %w", err)
+	}
+
+	pkpt := sw_emulated.AffinePoint[Fp](pk)
+	cr.AssertIsOnCurve(&pkpt)
+	pxBits := canonicalBits(baseApi, &pkpt.X)
+	pyBits := canonicalBits(baseApi, &pkpt.Y)
+	api.AssertIsEqual(pyBits[0], 0)
+	rBits := canonicalBits(baseApi, &sig.R)
+	s := scalarApi.Reduce(&sig.S)
+	scalarApi.AssertIsInRange(s)
+
+	// e = int(hash_{BIP0340/challenge}(bytes(r) || bytes(P) || m)) mod n
+	tag := sha256.Sum256([]byte(challengeTag))
+	h.Write(uints.NewU8Array(tag[:]))
+	h.Write(uints.NewU8Array(tag[:]))
+	h.Write(bitsToBytes(api, rBits))
+	h.Write(bitsToBytes(api, pxBits))
+	h.Write(msg)
+	digest := h.Sum()
+	eBits := make([]frontend.Variable, 0, 8*len(digest))
+	for i := len(digest) - 1; i >= 0; i-- {
+		eBits = append(eBits, bits.ToBinary(api, digest[i].Val, bits.WithNbDigits(8))...)
+	}
+	// the scalar multiplication decomposes e in binary, so e does not have to
+	// be reduced modulo n.
+	e := scalarApi.FromBits(eBits...)
+
+	// R = [s]G - [e]P
+	q := cr.JointScalarMulBase(cr.Neg(&pkpt), e, s)
+	qxBits := canonicalBits(baseApi, &q.X)
+	qyBits := canonicalBits(baseApi, &q.Y)
+	api.AssertIsEqual(qyBits[0], 0)
+	for i := range rBits {
+		api.AssertIsEqual(rBits[i], qxBits[i])
+	}
+	return nil
+}
+
+// canonicalBits returns the bits of the canonical representation of v in
+// little-endian order.
+func canonicalBits(f *emulated.Field[Fp], v *emulated.Element[Fp]) []frontend.Variable {
+	var fp Fp
+	vr := f.Reduce(v)
+	f.AssertIsInRange(vr)
+	return f.ToBits(vr)[:fp.Modulus().BitLen()]
+}
+
+// bitsToBytes returns the 32-byte big-endian encoding of the integer given by
+// its 256 little-endian bits.
+func bitsToBytes(api frontend.API, vBits []frontend.Variable) []uints.U8 {
+	res := make([]uints.U8, 32)
+	for i := range res {
+		res[len(res)-1-i] = uints.U8{Val: bits.FromBinary(api, vBits[8*i:8*(i+1)], bits.WithUnconstrainedInputs())}
+	}
+	return res
+}
+
+// Assign is a helper to assign the 32-byte x-only encoding of a public key into
+// its uncompressed form. It panics if the encoding is invalid.
+func (pk *PublicKey) Assign(buf []byte) {
+	x, y, err := liftX(buf)
+	if err != nil {
+		panic(err)
+	}
+	pk.X = emulated.ValueOf[Fp](x)
+	pk.Y = emulated.ValueOf[Fp](y)
+}
+
+// Assign is a helper to assign the 64-byte encoding of a signature. It panics
+// if the encoding has invalid length or if r is not less than the base field
+// modulus or s is not less than the scalar field modulus.
+func (sig *Signature) Assign(buf []byte) {
+	if len(buf) != 64 {
+		panic(fmt.Sprintf("invalid signature length %d", len(buf)))
+	}
+	var fp Fp
+	var fr Fr
+	r := new(big.Int).SetBytes(buf[:32])
+	if r.Cmp(fp.Modulus()) >= 0 {
+		panic("non-canonical r")
+	}
+	s := new(big.Int).SetBytes(buf[32:])
+	if s.Cmp(fr.Modulus()) >= 0 {
+		panic("non-canonical s")
+	}
+	sig.R = emulated.ValueOf[Fp](r)
+	sig.S = emulated.ValueOf[Fr](s)
+}
+
+// liftX returns the point with the x-coordinate encoded in buf and even
+// y-coordinate as defined in BIP-340.
+func liftX(buf []byte) (x, y *big.Int, err error) {
+	if len(buf) != 32 {
+		return nil, nil, fmt.Errorf("invalid public key length %d", len(buf))
+	}
+	var fp Fp
+	p := fp.Modulus()
+	x = new(big.Int).SetBytes(buf)
+	if x.Cmp(p) >= 0 {
+		return nil, nil, fmt.Errorf("non-canonical x-coordinate")
+	}
+	// y² = x³ + 7
+	yy := new(big.Int).Exp(x, big.NewInt(3), p)
+	yy.Add(yy, big.NewInt(7)).Mod(yy, p)
+	y = new(big.Int)
+	if y.ModSqrt(yy, p) == nil {
+		return nil, nil, fmt.Errorf("point not on curve")
+	}
+	if y.Bit(0) == 1 {
+		y.Sub(p, y)
+	}
+	return x, y, nil
+}
@@ -0,0 +1,163 @@
+package bip340
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/math/emulated"
+	"github.com/consensys/gnark/std/math/uints"
+	"github.com/consensys/gnark/test"
+)
+
+func taggedHash(tag string, data ...[]byte) []byte {
+	t := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(t[:])
+	h.Write(t[:])
+	for i := range data {
+		h.Write(data[i])
+	}
+	return h.Sum(nil)
+}
+
+func bytes32(v *big.Int) []byte {
+	res := make([]byte, 32)
+	v.FillBytes(res)
+	return res
+}
+
+// sign signs msg as defined in BIP-340 and returns the x-only public key and
+// the signature.
+func sign(sk *big.Int, aux, msg []byte) (pk, sig []byte) {
+	n := fr.Modulus()
+	_, g := secp256k1.Generators()
+	var P secp256k1.G1Affine
+	P.ScalarMultiplication(&g, sk)
+	d := new(big.Int).Set(sk)
+	py := P.Y.BigInt(new(big.Int))
+	if py.Bit(0) == 1 {
+		d.Sub(n, d)
+	}
+	px := bytes32(P.X.BigInt(new(big.Int)))
+	t := new(big.Int).SetBytes(taggedHash("BIP0340/aux", aux))
+	t.Xor(t, d)
+	k := new(big.Int).SetBytes(taggedHash("BIP0340/nonce", bytes32(t), px, msg))
+	k.Mod(k, n)
+	var R secp256k1.G1Affine
+	R.ScalarMultiplication(&g, k)
+	if R.Y.BigInt(new(big.Int)).Bit(0) == 1 {
+		k.Sub(n, k)
+	}
+	rx := bytes32(R.X.BigInt(new(big.Int)))
+	e := new(big.Int).SetBytes(taggedHash(challengeTag, rx, px, msg))
+	e.Mod(e, n)
+	s := e.Mul(e, d)
+	s.Add(s, k).Mod(s, n)
+	return px, append(rx, bytes32(s)...)
+}
+
+type verifyCircuit struct {
+	PublicKey PublicKey
+	Signature Signature
+	Msg       []uints.U8
+}
+
+func (c *verifyCircuit) Define(api frontend.API) error {
+	return c.PublicKey.Verify(api, c.Msg, &c.Signature)
+}
+
+func TestSign(t *testing.T) {
+	assert := test.NewAssert(t)
+	// test vector 0 from BIP-340
+	pk, sig := sign(big.NewInt(3), make([]byte, 32), make([]byte, 32))
+	assert.Equal("f9308a019258c31049344f85f89d5229b531c845836f99b08601f113bce036f9", hex.EncodeToString(pk))
+	assert.Equal("e907831f80848d1069a5371b402410364bdf1c5f8307b0084c55f1ce2dca821525f66a4a85ea8b71e482a74f382d2ce5ebeee8fdb2172f477df4900d310536c0", hex.EncodeToString(sig))
+}
+
+func TestVerify(t *testing.T) {
+	assert := test.NewAssert(t)
+	sk, err := rand.Int(rand.Reader, fr.Modulus())
+	assert.NoError(err)
+	aux := make([]byte, 32)
+	_, err = rand.Read(aux)
+	assert.NoError(err)
+	msg := []byte("a message to sign")
+	pk, sig := sign(sk, aux, msg)
+
+	circuit := verifyCircuit{Msg: make([]uints.U8, len(msg))}
+	witness := verifyCircuit{Msg: uints.NewU8Array(msg)}
+	witness.PublicKey.Assign(pk)
+	witness.Signature.Assign(sig)
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	wrongMsg := []byte("a message to sigN")
+	witness.Msg = uints.NewU8Array(wrongMsg)
+	err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}
+
+// TestVerifyVectors tests the verification against the test vectors of
+// BIP-340 (https://github.com/bitcoin/bips/blob/master/bip-0340/test-vectors.csv).
+func TestVerifyVectors(t *testing.T) {
+	assert := test.NewAssert(t)
+	f, err := os.Open("testdata/test-vectors.csv")
+	assert.NoError(err)
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	assert.NoError(err)
+	// skip the header
+	for _, rec := range records[1:] {
+		index, comment := rec[0], rec[7]
+		sk, _ := new(big.Int).SetString(rec[1], 16)
+		pk, _ := hex.DecodeString(rec[2])
+		aux, _ := hex.DecodeString(rec[3])
+		msg, _ := hex.DecodeString(rec[4])
+		sig, _ := hex.DecodeString(rec[5])
+		valid := rec[6] == "TRUE"
+		if sk != nil {
+			pk2, sig2 := sign(sk, aux, msg)
+			assert.Equal(pk, pk2, "public key vector %s", index)
+			assert.Equal(sig, sig2, "signature vector %s", index)
+		}
+
+		circuit := verifyCircuit{Msg: make([]uints.U8, len(msg))}
+		witness := verifyCircuit{Msg: uints.NewU8Array(msg)}
+		if _, _, err := liftX(pk); err != nil {
+			// the public key cannot be given in uncompressed form.
+			assert.False(valid, "vector %s", index)
+			assert.Panics(func() { witness.PublicKey.Assign(pk) }, "vector %s: %s", index, comment)
+			continue
+		}
+		witness.PublicKey.Assign(pk)
+		var fp Fp
+		var fr Fr
+		r := new(big.Int).SetBytes(sig[:32])
+		s := new(big.Int).SetBytes(sig[32:])
+		if r.Cmp(fp.Modulus()) >= 0 || s.Cmp(fr.Modulus()) >= 0 {
+			// check that the circuit also rejects out-of-range values when
+			// they are not assigned through [Signature.Assign].
+			assert.False(valid, "vector %s", index)
+			assert.Panics(func() { witness.Signature.Assign(sig) }, "vector %s: %s", index, comment)
+			witness.Signature.R = emulated.ValueOf[Fp](r)
+			witness.Signature.S = emulated.ValueOf[Fr](s)
+		} else {
+			witness.Signature.Assign(sig)
+		}
+		err = test.IsSolved(&circuit, &witness, ecc.BN254.ScalarField())
+		if valid {
+			assert.NoError(err, "vector %s", index)
+		} else {
+			assert.Error(err, "vector %s: %s", index, comment)
+		}
+	}
+}
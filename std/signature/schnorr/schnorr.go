@@ -0,0 +1,75 @@
+/*
+Package schnorr implements Schnorr signature verification over twisted Edwards
+curves defined over the native field.
+
+The group operations are performed natively using package
+[github.com/consensys/gnark/std/algebra/native/twistededwards] and the
+challenge is computed using a SNARK-friendly [hash.FieldHasher] (for example
+MiMC), so that the verification costs only a few thousand constraints. For
+Schnorr signatures over secp256k1 as defined in BIP-340, see package
+[github.com/consensys/gnark/std/signature/schnorr/bip340].
+
+A signature on a message m for the public key A = [sk]G is the tuple (e, s)
+where
+
+	R = [k]G for a random nonce k,
+	e = H(R.X, R.Y, A.X, A.Y, m),
+	s = k + e*sk mod ℓ,
+
+and ℓ is the order of the prime order subgroup generated by G. The signature is
+valid if e = H(R', A, m) for R' = [s]G - [e]A. Compared to EdDSA, the
+signature does not include the point R and the verification doesn't need to
+clear the cofactor.
+*/
+package schnorr
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	"github.com/consensys/gnark/std/hash"
+)
+
+// PublicKey stores a Schnorr public key (to be used in gnark circuit).
+type PublicKey struct {
+	A twistededwards.Point
+}
+
+// Signature stores a Schnorr signature (to be used in gnark circuit). The
+// challenge E is a native field element and the response S is a scalar less
+// than the order of the prime order subgroup.
+type Signature struct {
+	E, S frontend.Variable
+}
+
+// Verify asserts that the signature sig verifies for the message msg and public
+// key pk. The hash function hash is reset before computing the challenge.
+//
+// In addition to the verification equation, the method asserts that the public
+// key is on the curve and that the response S of the signature is canonical
+// (less than the order of the prime order subgroup). It returns an error if
+// the order of the subgroup is not set.
+func (pk PublicKey) Verify(curve twistededwards.Curve, hash hash.FieldHasher, msg frontend.Variable, sig *Signature) error {
+	api := curve.API()
+	params := curve.Params()
+	if params.Order == nil || params.Order.Sign() <= 0 {
+		return fmt.Errorf("invalid subgroup order")
+	}
+	curve.AssertIsOnCurve(pk.A)
+	api.AssertIsLessOrEqual(sig.S, new(big.Int).Sub(params.Order, big.NewInt(1)))
+
+	base := twistededwards.Point{
+		X: params.Base[0],
+		Y: params.Base[1],
+	}
+	// R = [S]G - [E]A
+	R := curve.DoubleBaseScalarMul(base, curve.Neg(pk.A), sig.S, sig.E)
+
+	// E == H(R, A, M)
+	hash.Reset()
+	hash.Write(R.X, R.Y, pk.A.X, pk.A.Y, msg)
+	api.AssertIsEqual(hash.Sum(), sig.E)
+	return nil
+}
@@ -0,0 +1,87 @@
+package schnorr
+
+import (
+	"crypto/rand"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr/mimc"
+	edwardsbn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	tedwards "github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/consensys/gnark/frontend"
+	"github.com/consensys/gnark/std/algebra/native/twistededwards"
+	stdmimc "github.com/consensys/gnark/std/hash/mimc"
+	"github.com/consensys/gnark/test"
+)
+
+type verifyCircuit struct {
+	PublicKey PublicKey
+	Signature Signature
+	Message   frontend.Variable
+}
+
+func (c *verifyCircuit) Define(api frontend.API) error {
+	curve, err := twistededwards.NewEdCurve(api, tedwards.BN254)
+	if err != nil {
+		return err
+	}
+	h, err := stdmimc.NewMiMC(api)
+	if err != nil {
+		return err
+	}
+	return c.PublicKey.Verify(curve, &h, c.Message, &c.Signature)
+}
+
+// sign returns the public key and the Schnorr signature (e, s) on msg.
+func sign(assert *test.Assert, msg *fr.Element) (edwardsbn254.PointAffine, *big.Int, *big.Int) {
+	params := edwardsbn254.GetEdwardsCurve()
+	sk, err := rand.Int(rand.Reader, &params.Order)
+	assert.NoError(err)
+	k, err := rand.Int(rand.Reader, &params.Order)
+	assert.NoError(err)
+	var A, R edwardsbn254.PointAffine
+	A.ScalarMultiplication(&params.Base, sk)
+	R.ScalarMultiplication(&params.Base, k)
+
+	h := mimc.NewMiMC()
+	for _, v := range []fr.Element{R.X, R.Y, A.X, A.Y, *msg} {
+		b := v.Bytes()
+		h.Write(b[:])
+	}
+	e := new(big.Int).SetBytes(h.Sum(nil))
+	s := new(big.Int).Mod(e, &params.Order)
+	s.Mul(s, sk).Add(s, k).Mod(s, &params.Order)
+	return A, e, s
+}
+
+func TestVerify(t *testing.T) {
+	assert := test.NewAssert(t)
+	var msg fr.Element
+	_, err := msg.SetRandom()
+	assert.NoError(err)
+	A, e, s := sign(assert, &msg)
+
+	witness := verifyCircuit{
+		PublicKey: PublicKey{A: twistededwards.Point{X: A.X, Y: A.Y}},
+		Signature: Signature{E: e, S: s},
+		Message:   msg,
+	}
+	err = test.IsSolved(&verifyCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.NoError(err)
+
+	// non-canonical response
+	params := edwardsbn254.GetEdwardsCurve()
+	witness.Signature.S = new(big.Int).Add(s, &params.Order)
+	err = test.IsSolved(&verifyCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+
+	// wrong message
+	witness.Signature.S = s
+	var wrongMsg fr.Element
+	wrongMsg.Add(&msg, new(fr.Element).SetOne())
+	witness.Message = wrongMsg
+	err = test.IsSolved(&verifyCircuit{}, &witness, ecc.BN254.ScalarField())
+	assert.Error(err)
+}